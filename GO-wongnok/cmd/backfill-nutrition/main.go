@@ -0,0 +1,45 @@
+package main
+
+import (
+	"log"
+	"wongnok/internal/config"
+	"wongnok/internal/ingredient"
+
+	"github.com/caarlos0/env/v11"
+	_ "github.com/joho/godotenv/autoload"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// คำนวณค่าโภชนาการให้สูตรที่สร้างก่อนมีตาราง ingredient_nutritions
+// รันหลัง goose up สูตรที่คำนวณแล้วจะถูกข้าม จึงรันซ้ำทุกครั้งที่ deploy ได้
+func main() {
+	// Load configuration
+	var conf config.Config
+
+	if err := env.Parse(&conf); err != nil {
+		log.Fatal("Error when decoding configuration:", err)
+	}
+
+	// Database connection
+	db, err := gorm.Open(postgres.Open(conf.Database.URL), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Warn),
+	})
+	if err != nil {
+		log.Fatal("Error when connect to database:", err)
+	}
+	// Ensure close connection when terminated
+	defer func() {
+		sqldb, _ := db.DB()
+		sqldb.Close()
+	}()
+
+	count, err := ingredient.NewService(db).BackfillNutrition()
+	if err != nil {
+		log.Fatal("Error when backfill nutrition:", err)
+	}
+
+	log.Printf("Backfilled nutrition of %d recipes", count)
+}
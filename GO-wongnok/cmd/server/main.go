@@ -4,12 +4,20 @@ import (
 	"context"
 	"log"
 	"wongnok/internal/auth"
+	"wongnok/internal/collection"
+	"wongnok/internal/comment"
 	"wongnok/internal/config"
+	"wongnok/internal/cooklog"
 	"wongnok/internal/favorite"
 	"wongnok/internal/foodrecipe"
 	"wongnok/internal/global"
+	"wongnok/internal/ingredient"
+	"wongnok/internal/mealplan"
 	"wongnok/internal/middleware"
+	"wongnok/internal/pantry"
 	"wongnok/internal/rating"
+	"wongnok/internal/shoppinglist"
+	"wongnok/internal/tag"
 	"wongnok/internal/user"
 
 	"github.com/caarlos0/env/v11"
@@ -75,6 +83,7 @@ func main() {
 	verifierSkipClientIDCheck := provider.Verifier(&oidc.Config{SkipClientIDCheck: true, SkipIssuerCheck: true})
 	// กำหนดค่า global.Verifier ให้สอดคล้องกัน (skip issuer check เช่นเดียวกัน)
 	global.Verifier = provider.Verifier(&oidc.Config{SkipClientIDCheck: true, SkipIssuerCheck: true})
+	global.Ranking = conf.Ranking
 
 	// Handler
 	foodRecipeHandler := foodrecipe.NewHandler(db)
@@ -100,6 +109,14 @@ func main() {
 		}),
 	)
 	userHandler := user.NewHandler(db)
+	tagHandler := tag.NewHandler(db)
+	commentHandler := comment.NewHandler(db)
+	cookLogHandler := cooklog.NewHandler(db)
+	collectionHandler := collection.NewHandler(db)
+	mealPlanHandler := mealplan.NewHandler(db)
+	shoppingListHandler := shoppinglist.NewHandler(db)
+	pantryHandler := pantry.NewHandler(db)
+	ingredientHandler := ingredient.NewHandler(db)
 
 	// Router
 	router := gin.Default()
@@ -123,6 +140,9 @@ func main() {
 	// Food recipe
 	group.POST("/food-recipes", middleware.Authorize(verifierSkipClientIDCheck), foodRecipeHandler.Create)
 	group.GET("/food-recipes", foodRecipeHandler.Get)
+	group.GET("/food-recipes/suggest", foodRecipeHandler.Suggest)
+	group.GET("/food-recipes/top", foodRecipeHandler.GetTop)
+	group.GET("/food-recipes/match-pantry", middleware.Authorize(verifierSkipClientIDCheck), foodRecipeHandler.MatchPantry)
 	group.GET("/food-recipes/:id", foodRecipeHandler.GetByID)
 	group.PUT("/food-recipes/:id", middleware.Authorize(verifierSkipClientIDCheck), foodRecipeHandler.Update)
 	group.DELETE("/food-recipes/:id", middleware.Authorize(verifierSkipClientIDCheck), foodRecipeHandler.Delete)
@@ -130,6 +150,22 @@ func main() {
 	// Rating
 	group.GET("/food-recipes/:id/ratings", ratingHandler.Get)
 	group.POST("/food-recipes/:id/ratings", middleware.Authorize(verifierSkipClientIDCheck), ratingHandler.Create)
+	group.PUT("/food-recipes/:id/ratings/mine", middleware.Authorize(verifierSkipClientIDCheck), ratingHandler.Update)
+	group.DELETE("/food-recipes/:id/ratings/mine", middleware.Authorize(verifierSkipClientIDCheck), ratingHandler.Delete)
+	group.POST("/ratings/:id/votes", middleware.Authorize(verifierSkipClientIDCheck), ratingHandler.Vote)
+	group.DELETE("/ratings/:id/votes", middleware.Authorize(verifierSkipClientIDCheck), ratingHandler.Unvote)
+
+	// Comment
+	group.GET("/food-recipes/:id/comments", commentHandler.Get)
+	group.POST("/food-recipes/:id/comments", middleware.Authorize(verifierSkipClientIDCheck), commentHandler.Create)
+	group.PUT("/comments/:id", middleware.Authorize(verifierSkipClientIDCheck), commentHandler.Update)
+	group.DELETE("/comments/:id", middleware.Authorize(verifierSkipClientIDCheck), commentHandler.Delete)
+
+	// Cook log
+	group.GET("/food-recipes/:id/cook-logs", cookLogHandler.GetByRecipe)
+	group.POST("/food-recipes/:id/cook-logs", middleware.Authorize(verifierSkipClientIDCheck), cookLogHandler.Create)
+	group.PUT("/cook-logs/:id", middleware.Authorize(verifierSkipClientIDCheck), cookLogHandler.Update)
+	group.DELETE("/cook-logs/:id", middleware.Authorize(verifierSkipClientIDCheck), cookLogHandler.Delete)
 
 	// Favorite
 	// get all fav by user
@@ -138,6 +174,46 @@ func main() {
 	group.POST("/food-recipes/:id/favorites", middleware.Authorize(verifierSkipClientIDCheck), favoriteHandler.Create)
 	group.DELETE("/food-recipes/:id/favorites", middleware.Authorize(verifierSkipClientIDCheck), favoriteHandler.Delete)
 
+	// Collection
+	group.GET("/collections", middleware.Authorize(verifierSkipClientIDCheck), collectionHandler.Get)
+	group.POST("/collections", middleware.Authorize(verifierSkipClientIDCheck), collectionHandler.Create)
+	group.PUT("/collections/order", middleware.Authorize(verifierSkipClientIDCheck), collectionHandler.Reorder)
+	group.GET("/collections/:id", collectionHandler.GetByID)
+	group.PUT("/collections/:id", middleware.Authorize(verifierSkipClientIDCheck), collectionHandler.Update)
+	group.DELETE("/collections/:id", middleware.Authorize(verifierSkipClientIDCheck), collectionHandler.Delete)
+	group.POST("/collections/:id/recipes", middleware.Authorize(verifierSkipClientIDCheck), collectionHandler.AddRecipe)
+	group.DELETE("/collections/:id/recipes/:recipeId", middleware.Authorize(verifierSkipClientIDCheck), collectionHandler.RemoveRecipe)
+
+	// Meal plan
+	group.GET("/meal-plans", middleware.Authorize(verifierSkipClientIDCheck), mealPlanHandler.GetWeek)
+	group.POST("/meal-plans", middleware.Authorize(verifierSkipClientIDCheck), mealPlanHandler.Create)
+	group.POST("/meal-plans/copy", middleware.Authorize(verifierSkipClientIDCheck), mealPlanHandler.CopyWeek)
+	group.PUT("/meal-plans/:id", middleware.Authorize(verifierSkipClientIDCheck), mealPlanHandler.Update)
+	group.DELETE("/meal-plans/:id", middleware.Authorize(verifierSkipClientIDCheck), mealPlanHandler.Delete)
+	group.DELETE("/meal-plans/days/:date", middleware.Authorize(verifierSkipClientIDCheck), mealPlanHandler.ClearDay)
+
+	// Shopping list
+	group.GET("/shopping-lists", middleware.Authorize(verifierSkipClientIDCheck), shoppingListHandler.Get)
+	group.POST("/shopping-lists", middleware.Authorize(verifierSkipClientIDCheck), shoppingListHandler.Create)
+	group.GET("/shopping-lists/:id", middleware.Authorize(verifierSkipClientIDCheck), shoppingListHandler.GetByID)
+	group.DELETE("/shopping-lists/:id", middleware.Authorize(verifierSkipClientIDCheck), shoppingListHandler.Delete)
+	group.PATCH("/shopping-lists/:id/items/:itemId", middleware.Authorize(verifierSkipClientIDCheck), shoppingListHandler.UpdateItem)
+
+	// Tag
+	group.GET("/tags", tagHandler.Get)
+	group.POST("/tags", middleware.Authorize(verifierSkipClientIDCheck), tagHandler.Create)
+	group.PUT("/tags/:id", middleware.Authorize(verifierSkipClientIDCheck), tagHandler.Update)
+	group.DELETE("/tags/:id", middleware.Authorize(verifierSkipClientIDCheck), tagHandler.Delete)
+
+	// Ingredient
+	group.GET("/ingredients", ingredientHandler.Get)
+	group.POST("/ingredients", middleware.Authorize(verifierSkipClientIDCheck), ingredientHandler.Create)
+	group.PUT("/ingredients/:id", middleware.Authorize(verifierSkipClientIDCheck), ingredientHandler.Update)
+	group.DELETE("/ingredients/:id", middleware.Authorize(verifierSkipClientIDCheck), ingredientHandler.Delete)
+	group.GET("/ingredients/unlinked", middleware.Authorize(verifierSkipClientIDCheck), ingredientHandler.GetUnlinked)
+	group.POST("/ingredients/unlinked/:id/link", middleware.Authorize(verifierSkipClientIDCheck), ingredientHandler.LinkUnlinked)
+	group.DELETE("/ingredients/unlinked/:id", middleware.Authorize(verifierSkipClientIDCheck), ingredientHandler.DismissUnlinked)
+
 	// Auth
 	group.GET("/login", authHandler.Login)
 	group.GET("/callback", authHandler.Callback)
@@ -145,6 +221,10 @@ func main() {
 
 	// User
 	group.GET("/users/:id/food-recipes", middleware.Authorize(verifierSkipClientIDCheck), userHandler.GetRecipes)
+	group.GET("/users/self/cook-logs", middleware.Authorize(verifierSkipClientIDCheck), cookLogHandler.GetByUser)
+	group.GET("/users/self/pantry", middleware.Authorize(verifierSkipClientIDCheck), pantryHandler.Get)
+	group.POST("/users/self/pantry", middleware.Authorize(verifierSkipClientIDCheck), pantryHandler.Create)
+	group.DELETE("/users/self/pantry/:id", middleware.Authorize(verifierSkipClientIDCheck), pantryHandler.Delete)
 	group.GET("/users/", middleware.Authorize(verifierSkipClientIDCheck), userHandler.Get)
 	group.POST("/users/", middleware.Authorize(verifierSkipClientIDCheck), userHandler.Create)
 	group.PUT("/users/", middleware.Authorize(verifierSkipClientIDCheck), userHandler.Update)
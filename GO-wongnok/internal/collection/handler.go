@@ -0,0 +1,354 @@
+package collection
+
+import (
+	"net/http"
+	"strconv"
+	"wongnok/internal/global"
+	"wongnok/internal/helper"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IHandler interface {
+	Get(ctx *gin.Context)
+	GetByID(ctx *gin.Context)
+	Create(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	Reorder(ctx *gin.Context)
+	AddRecipe(ctx *gin.Context)
+	RemoveRecipe(ctx *gin.Context)
+}
+
+type Handler struct {
+	Service IService
+}
+
+func NewHandler(db *gorm.DB) *Handler {
+	return &Handler{
+		Service: NewService(db),
+	}
+}
+
+// Get godoc
+// @Summary Get my collections
+// @Description Get the logged-in user's collections in their chosen order, including the default "Favorites" collection
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.CollectionsResponse
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/collections [get]
+func (handler Handler) Get(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	collections, err := handler.Service.Get(claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, collections.ToResponse())
+}
+
+// GetByID godoc
+// @Summary Get a collection
+// @Description Get a collection with its recipes. Private collections are only visible to their owner
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Param id path int true "Collection ID"
+// @Success 200 {object} dto.CollectionResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/collections/{id} [get]
+func (handler Handler) GetByID(ctx *gin.Context) {
+	claims, err := helper.DecodeClaimsFromHeader(ctx, global.Verifier)
+	if err != nil {
+		claims.ID = ""
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid collection id"})
+		return
+	}
+
+	collection, recipes, err := handler.Service.GetByID(id, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	response := collection.ToResponse()
+	response.Recipes = recipes.ToResponse(0).Results
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Create godoc
+// @Summary Create a collection
+// @Description Create a named collection, added after the user's existing collections
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Param collection body dto.CollectionRequest true "Collection data"
+// @Success 201 {object} dto.CollectionResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/collections [post]
+func (handler Handler) Create(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var request dto.CollectionRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	collection, err := handler.Service.Create(request, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, collection.ToResponse())
+}
+
+// Update godoc
+// @Summary Update a collection
+// @Description Rename a collection or make it public/private
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Param id path int true "Collection ID"
+// @Param collection body dto.CollectionRequest true "Collection data"
+// @Success 200 {object} dto.CollectionResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/collections/{id} [put]
+func (handler Handler) Update(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid collection id"})
+		return
+	}
+
+	var request dto.CollectionRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	collection, err := handler.Service.Update(request, id, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, collection.ToResponse())
+}
+
+// Delete godoc
+// @Summary Delete a collection
+// @Description Delete a collection (the recipes themselves are kept). The default collection cannot be deleted
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Param id path int true "Collection ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/collections/{id} [delete]
+func (handler Handler) Delete(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid collection id"})
+		return
+	}
+
+	if err := handler.Service.Delete(id, claims); err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Collection deleted successfully"})
+}
+
+// Reorder godoc
+// @Summary Reorder my collections
+// @Description Set the order of the logged-in user's collections, collections not listed keep their order after the listed ones
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Param order body dto.CollectionOrderRequest true "Collection IDs in the new order"
+// @Success 200 {object} dto.CollectionsResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/collections/order [put]
+func (handler Handler) Reorder(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var request dto.CollectionOrderRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	collections, err := handler.Service.Reorder(request, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, collections.ToResponse())
+}
+
+// AddRecipe godoc
+// @Summary Add a recipe to a collection
+// @Description Adding to the default collection also favorites the recipe
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Param id path int true "Collection ID"
+// @Param item body dto.CollectionItemRequest true "Recipe to add"
+// @Success 200 {object} dto.CollectionResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/collections/{id}/recipes [post]
+func (handler Handler) AddRecipe(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid collection id"})
+		return
+	}
+
+	var request dto.CollectionItemRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	collection, err := handler.Service.AddRecipe(request, id, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, collection.ToResponse())
+}
+
+// RemoveRecipe godoc
+// @Summary Remove a recipe from a collection
+// @Description Removing from the default collection also unfavorites the recipe
+// @Tags collections
+// @Accept json
+// @Produce json
+// @Param id path int true "Collection ID"
+// @Param recipeId path int true "Food Recipe ID"
+// @Success 200 {object} dto.CollectionResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/collections/{id}/recipes/{recipeId} [delete]
+func (handler Handler) RemoveRecipe(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid collection id"})
+		return
+	}
+
+	recipeID, err := strconv.Atoi(ctx.Param("recipeId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid food recipe id"})
+		return
+	}
+
+	collection, err := handler.Service.RemoveRecipe(id, recipeID, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, collection.ToResponse())
+}
+
+func statusCode(err error) int {
+	switch {
+	case errors.As(err, &validator.ValidationErrors{}):
+		return http.StatusBadRequest
+	case errors.Is(err, global.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, global.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
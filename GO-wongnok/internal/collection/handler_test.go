@@ -0,0 +1,292 @@
+package collection_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"wongnok/internal/collection"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewHandler(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		handler := collection.NewHandler(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(handler))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler collection.IHandler
+	service *MockIService
+
+	// Mock data
+	weeknight model.Collection
+
+	// Helper
+	server func(method string, url string, payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+// This will run once before all tests in the suite
+func (suite *HandlerTestSuite) SetupSuite() {
+	// Gin testing mode
+	gin.SetMode(gin.TestMode)
+}
+
+// This will run before each test
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = collection.Handler{
+		Service: suite.service,
+	}
+
+	suite.weeknight = model.Collection{Model: gorm.Model{ID: 2}, UserID: "UID", Name: "Weeknight", Position: 2}
+
+	suite.server = func(method string, url string, payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder {
+		// Create router
+		router := gin.Default()
+
+		// Set context
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+
+		router.GET("/api/v1/collections", suite.handler.Get)
+		router.POST("/api/v1/collections", suite.handler.Create)
+		router.PUT("/api/v1/collections/order", suite.handler.Reorder)
+		router.GET("/api/v1/collections/:id", suite.handler.GetByID)
+		router.PUT("/api/v1/collections/:id", suite.handler.Update)
+		router.DELETE("/api/v1/collections/:id", suite.handler.Delete)
+		router.POST("/api/v1/collections/:id/recipes", suite.handler.AddRecipe)
+		router.DELETE("/api/v1/collections/:id/recipes/:recipeId", suite.handler.RemoveRecipe)
+
+		recorder := httptest.NewRecorder()
+
+		// Create request
+		request, err := http.NewRequest(method, url, payload)
+		suite.NoError(err)
+
+		// Start testing server
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+}
+
+// Extend
+type HandlerGetTestSuite struct {
+	HandlerTestSuite
+}
+
+func (suite *HandlerGetTestSuite) TestResponseCollections() {
+	collections := model.Collections{suite.weeknight}
+	suite.service.On("Get", model.Claims{ID: "UID"}).Return(collections, nil)
+
+	response := suite.server(http.MethodGet, "/api/v1/collections", nil, &model.Claims{ID: "UID"})
+
+	expectedJson, _ := json.Marshal(collections.ToResponse())
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+}
+
+func (suite *HandlerGetTestSuite) TestErrorWhenNoClaims() {
+	response := suite.server(http.MethodGet, "/api/v1/collections", nil, nil)
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+}
+
+func (suite *HandlerGetTestSuite) TestResponseCollectionWithRecipes() {
+	recipes := model.FoodRecipes{{Model: gorm.Model{ID: 10}, Name: "Omelet"}}
+	suite.service.On("GetByID", 2, model.Claims{}).Return(suite.weeknight, recipes, nil)
+
+	response := suite.server(http.MethodGet, "/api/v1/collections/2", nil, nil)
+
+	expected := suite.weeknight.ToResponse()
+	expected.Recipes = recipes.ToResponse(0).Results
+	expectedJson, _ := json.Marshal(expected)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+}
+
+func (suite *HandlerGetTestSuite) TestErrorWhenPrivateCollection() {
+	suite.service.On("GetByID", 2, model.Claims{}).Return(model.Collection{}, nil, errors.Wrap(gorm.ErrRecordNotFound, "find collection"))
+
+	response := suite.server(http.MethodGet, "/api/v1/collections/2", nil, nil)
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func TestHandlerGet(t *testing.T) {
+	suite.Run(t, new(HandlerGetTestSuite))
+}
+
+// Extend
+type HandlerCreateTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	errServiceCreate error
+}
+
+// This will run before each test
+func (suite *HandlerCreateTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.errServiceCreate = nil
+
+	suite.service.On("Create", mock.Anything, mock.Anything).Return(func(dto.CollectionRequest, model.Claims) (model.Collection, error) {
+		return suite.weeknight, suite.errServiceCreate
+	})
+}
+
+func (suite *HandlerCreateTestSuite) TestResponseCollectionWithStatusCode201() {
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(http.MethodPost, "/api/v1/collections", strings.NewReader(`{"name": "Weeknight"}`), &claims)
+
+	expectedJson, _ := json.Marshal(suite.weeknight.ToResponse())
+
+	suite.Equal(http.StatusCreated, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Create", dto.CollectionRequest{Name: "Weeknight"}, claims)
+}
+
+func (suite *HandlerCreateTestSuite) TestErrorWhenNameReserved() {
+	suite.errServiceCreate = errors.Wrapf(global.ErrConflict, "collection name %q is reserved", model.DefaultCollectionName)
+
+	response := suite.server(http.MethodPost, "/api/v1/collections", strings.NewReader(`{"name": "Favorites"}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusConflict, response.Code)
+}
+
+func TestHandlerCreate(t *testing.T) {
+	suite.Run(t, new(HandlerCreateTestSuite))
+}
+
+// Extend
+type HandlerOwnershipTestSuite struct {
+	HandlerTestSuite
+}
+
+// This will run before each test
+func (suite *HandlerOwnershipTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.service.On("Update", mock.Anything, mock.Anything, mock.Anything).Return(model.Collection{}, global.ErrForbidden)
+	suite.service.On("Delete", mock.Anything, mock.Anything).Return(global.ErrForbidden)
+	suite.service.On("Reorder", mock.Anything, mock.Anything).Return(nil, global.ErrForbidden)
+	suite.service.On("AddRecipe", mock.Anything, mock.Anything, mock.Anything).Return(model.Collection{}, global.ErrForbidden)
+	suite.service.On("RemoveRecipe", mock.Anything, mock.Anything, mock.Anything).Return(model.Collection{}, global.ErrForbidden)
+}
+
+func (suite *HandlerOwnershipTestSuite) TestErrorWhenUpdateOtherCollection() {
+	response := suite.server(http.MethodPut, "/api/v1/collections/2", strings.NewReader(`{"name": "Mine"}`), &model.Claims{ID: "OTHER"})
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func (suite *HandlerOwnershipTestSuite) TestErrorWhenDeleteOtherCollection() {
+	response := suite.server(http.MethodDelete, "/api/v1/collections/2", nil, &model.Claims{ID: "OTHER"})
+
+	suite.Equal(http.StatusForbidden, response.Code)
+	suite.service.AssertCalled(suite.T(), "Delete", 2, model.Claims{ID: "OTHER"})
+}
+
+func (suite *HandlerOwnershipTestSuite) TestErrorWhenReorderOtherCollection() {
+	response := suite.server(http.MethodPut, "/api/v1/collections/order", strings.NewReader(`{"ids": [2, 7]}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusForbidden, response.Code)
+	suite.service.AssertCalled(suite.T(), "Reorder", dto.CollectionOrderRequest{IDs: []uint{2, 7}}, model.Claims{ID: "UID"})
+}
+
+func (suite *HandlerOwnershipTestSuite) TestErrorWhenAddToOtherCollection() {
+	response := suite.server(http.MethodPost, "/api/v1/collections/2/recipes", strings.NewReader(`{"foodRecipeID": 10}`), &model.Claims{ID: "OTHER"})
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func (suite *HandlerOwnershipTestSuite) TestErrorWhenRemoveFromOtherCollection() {
+	response := suite.server(http.MethodDelete, "/api/v1/collections/2/recipes/10", nil, &model.Claims{ID: "OTHER"})
+
+	suite.Equal(http.StatusForbidden, response.Code)
+	suite.service.AssertCalled(suite.T(), "RemoveRecipe", 2, 10, model.Claims{ID: "OTHER"})
+}
+
+func TestHandlerOwnership(t *testing.T) {
+	suite.Run(t, new(HandlerOwnershipTestSuite))
+}
+
+// Extend
+type HandlerAddRecipeTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	errServiceAddRecipe error
+}
+
+// This will run before each test
+func (suite *HandlerAddRecipeTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.errServiceAddRecipe = nil
+
+	suite.service.On("AddRecipe", mock.Anything, mock.Anything, mock.Anything).Return(func(dto.CollectionItemRequest, int, model.Claims) (model.Collection, error) {
+		return suite.weeknight, suite.errServiceAddRecipe
+	})
+}
+
+func (suite *HandlerAddRecipeTestSuite) TestResponseCollection() {
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(http.MethodPost, "/api/v1/collections/2/recipes", strings.NewReader(`{"foodRecipeID": 10}`), &claims)
+
+	expectedJson, _ := json.Marshal(suite.weeknight.ToResponse())
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "AddRecipe", dto.CollectionItemRequest{FoodRecipeID: 10}, 2, claims)
+}
+
+func (suite *HandlerAddRecipeTestSuite) TestErrorWhenRecipeNotFound() {
+	suite.errServiceAddRecipe = errors.Wrap(gorm.ErrRecordNotFound, "find recipe")
+
+	response := suite.server(http.MethodPost, "/api/v1/collections/2/recipes", strings.NewReader(`{"foodRecipeID": 99}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerAddRecipeTestSuite) TestErrorWhenCollectionIDInvalid() {
+	response := suite.server(http.MethodPost, "/api/v1/collections/abc/recipes", strings.NewReader(`{"foodRecipeID": 10}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.Equal(`{"message":"invalid collection id"}`, response.Body.String())
+}
+
+func TestHandlerAddRecipe(t *testing.T) {
+	suite.Run(t, new(HandlerAddRecipeTestSuite))
+}
@@ -0,0 +1,2845 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package collection_test
+
+import (
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIHandler creates a new instance of MockIHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIHandler {
+	mock := &MockIHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIHandler is an autogenerated mock type for the IHandler type
+type MockIHandler struct {
+	mock.Mock
+}
+
+type MockIHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIHandler) EXPECT() *MockIHandler_Expecter {
+	return &MockIHandler_Expecter{mock: &_m.Mock}
+}
+
+// AddRecipe provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) AddRecipe(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_AddRecipe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRecipe'
+type MockIHandler_AddRecipe_Call struct {
+	*mock.Call
+}
+
+// AddRecipe is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) AddRecipe(ctx interface{}) *MockIHandler_AddRecipe_Call {
+	return &MockIHandler_AddRecipe_Call{Call: _e.mock.On("AddRecipe", ctx)}
+}
+
+func (_c *MockIHandler_AddRecipe_Call) Run(run func(ctx *gin.Context)) *MockIHandler_AddRecipe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_AddRecipe_Call) Return() *MockIHandler_AddRecipe_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_AddRecipe_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_AddRecipe_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Create(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIHandler_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Create(ctx interface{}) *MockIHandler_Create_Call {
+	return &MockIHandler_Create_Call{Call: _e.mock.On("Create", ctx)}
+}
+
+func (_c *MockIHandler_Create_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Create_Call) Return() *MockIHandler_Create_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Create_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Create_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Delete(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIHandler_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Delete(ctx interface{}) *MockIHandler_Delete_Call {
+	return &MockIHandler_Delete_Call{Call: _e.mock.On("Delete", ctx)}
+}
+
+func (_c *MockIHandler_Delete_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Delete_Call) Return() *MockIHandler_Delete_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Delete_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Delete_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Get(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIHandler_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Get(ctx interface{}) *MockIHandler_Get_Call {
+	return &MockIHandler_Get_Call{Call: _e.mock.On("Get", ctx)}
+}
+
+func (_c *MockIHandler_Get_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Get_Call) Return() *MockIHandler_Get_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Get_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Get_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) GetByID(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIHandler_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) GetByID(ctx interface{}) *MockIHandler_GetByID_Call {
+	return &MockIHandler_GetByID_Call{Call: _e.mock.On("GetByID", ctx)}
+}
+
+func (_c *MockIHandler_GetByID_Call) Run(run func(ctx *gin.Context)) *MockIHandler_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_GetByID_Call) Return() *MockIHandler_GetByID_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_GetByID_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_GetByID_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RemoveRecipe provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) RemoveRecipe(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_RemoveRecipe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveRecipe'
+type MockIHandler_RemoveRecipe_Call struct {
+	*mock.Call
+}
+
+// RemoveRecipe is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) RemoveRecipe(ctx interface{}) *MockIHandler_RemoveRecipe_Call {
+	return &MockIHandler_RemoveRecipe_Call{Call: _e.mock.On("RemoveRecipe", ctx)}
+}
+
+func (_c *MockIHandler_RemoveRecipe_Call) Run(run func(ctx *gin.Context)) *MockIHandler_RemoveRecipe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_RemoveRecipe_Call) Return() *MockIHandler_RemoveRecipe_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_RemoveRecipe_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_RemoveRecipe_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Reorder provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Reorder(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Reorder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reorder'
+type MockIHandler_Reorder_Call struct {
+	*mock.Call
+}
+
+// Reorder is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Reorder(ctx interface{}) *MockIHandler_Reorder_Call {
+	return &MockIHandler_Reorder_Call{Call: _e.mock.On("Reorder", ctx)}
+}
+
+func (_c *MockIHandler_Reorder_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Reorder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Reorder_Call) Return() *MockIHandler_Reorder_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Reorder_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Reorder_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Update(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIHandler_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Update(ctx interface{}) *MockIHandler_Update_Call {
+	return &MockIHandler_Update_Call{Call: _e.mock.On("Update", ctx)}
+}
+
+func (_c *MockIHandler_Update_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Update_Call) Return() *MockIHandler_Update_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Update_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Update_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockIRepository creates a new instance of MockIRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIRepository {
+	mock := &MockIRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIRepository is an autogenerated mock type for the IRepository type
+type MockIRepository struct {
+	mock.Mock
+}
+
+type MockIRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIRepository) EXPECT() *MockIRepository_Expecter {
+	return &MockIRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddRecipe provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) AddRecipe(collection model.Collection, recipeID uint) error {
+	ret := _mock.Called(collection, recipeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRecipe")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(model.Collection, uint) error); ok {
+		r0 = returnFunc(collection, recipeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_AddRecipe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRecipe'
+type MockIRepository_AddRecipe_Call struct {
+	*mock.Call
+}
+
+// AddRecipe is a helper method to define mock.On call
+//   - collection model.Collection
+//   - recipeID uint
+func (_e *MockIRepository_Expecter) AddRecipe(collection interface{}, recipeID interface{}) *MockIRepository_AddRecipe_Call {
+	return &MockIRepository_AddRecipe_Call{Call: _e.mock.On("AddRecipe", collection, recipeID)}
+}
+
+func (_c *MockIRepository_AddRecipe_Call) Run(run func(collection model.Collection, recipeID uint)) *MockIRepository_AddRecipe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Collection
+		if args[0] != nil {
+			arg0 = args[0].(model.Collection)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_AddRecipe_Call) Return(err error) *MockIRepository_AddRecipe_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_AddRecipe_Call) RunAndReturn(run func(collection model.Collection, recipeID uint) error) *MockIRepository_AddRecipe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Create(collection *model.Collection) error {
+	ret := _mock.Called(collection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Collection) error); ok {
+		r0 = returnFunc(collection)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - collection *model.Collection
+func (_e *MockIRepository_Expecter) Create(collection interface{}) *MockIRepository_Create_Call {
+	return &MockIRepository_Create_Call{Call: _e.mock.On("Create", collection)}
+}
+
+func (_c *MockIRepository_Create_Call) Run(run func(collection *model.Collection)) *MockIRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Collection
+		if args[0] != nil {
+			arg0 = args[0].(*model.Collection)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Create_Call) Return(err error) *MockIRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Create_Call) RunAndReturn(run func(collection *model.Collection) error) *MockIRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDefault provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) CreateDefault(collection *model.Collection) error {
+	ret := _mock.Called(collection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDefault")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Collection) error); ok {
+		r0 = returnFunc(collection)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_CreateDefault_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDefault'
+type MockIRepository_CreateDefault_Call struct {
+	*mock.Call
+}
+
+// CreateDefault is a helper method to define mock.On call
+//   - collection *model.Collection
+func (_e *MockIRepository_Expecter) CreateDefault(collection interface{}) *MockIRepository_CreateDefault_Call {
+	return &MockIRepository_CreateDefault_Call{Call: _e.mock.On("CreateDefault", collection)}
+}
+
+func (_c *MockIRepository_CreateDefault_Call) Run(run func(collection *model.Collection)) *MockIRepository_CreateDefault_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Collection
+		if args[0] != nil {
+			arg0 = args[0].(*model.Collection)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_CreateDefault_Call) Return(err error) *MockIRepository_CreateDefault_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_CreateDefault_Call) RunAndReturn(run func(collection *model.Collection) error) *MockIRepository_CreateDefault_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Delete(id uint) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id uint
+func (_e *MockIRepository_Expecter) Delete(id interface{}) *MockIRepository_Delete_Call {
+	return &MockIRepository_Delete_Call{Call: _e.mock.On("Delete", id)}
+}
+
+func (_c *MockIRepository_Delete_Call) Run(run func(id uint)) *MockIRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Delete_Call) Return(err error) *MockIRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Delete_Call) RunAndReturn(run func(id uint) error) *MockIRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Get(userID string) (model.Collections, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.Collections
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (model.Collections, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) model.Collections); ok {
+		r0 = returnFunc(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Collections)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - userID string
+func (_e *MockIRepository_Expecter) Get(userID interface{}) *MockIRepository_Get_Call {
+	return &MockIRepository_Get_Call{Call: _e.mock.On("Get", userID)}
+}
+
+func (_c *MockIRepository_Get_Call) Run(run func(userID string)) *MockIRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Get_Call) Return(collections model.Collections, err error) *MockIRepository_Get_Call {
+	_c.Call.Return(collections, err)
+	return _c
+}
+
+func (_c *MockIRepository_Get_Call) RunAndReturn(run func(userID string) (model.Collections, error)) *MockIRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetByID(id int) (model.Collection, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Collection
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (model.Collection, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) model.Collection); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.Collection)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - id int
+func (_e *MockIRepository_Expecter) GetByID(id interface{}) *MockIRepository_GetByID_Call {
+	return &MockIRepository_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+}
+
+func (_c *MockIRepository_GetByID_Call) Run(run func(id int)) *MockIRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetByID_Call) Return(collection model.Collection, err error) *MockIRepository_GetByID_Call {
+	_c.Call.Return(collection, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetByID_Call) RunAndReturn(run func(id int) (model.Collection, error)) *MockIRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByName provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetByName(userID string, name string) (model.Collection, error) {
+	ret := _mock.Called(userID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByName")
+	}
+
+	var r0 model.Collection
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (model.Collection, error)); ok {
+		return returnFunc(userID, name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) model.Collection); ok {
+		r0 = returnFunc(userID, name)
+	} else {
+		r0 = ret.Get(0).(model.Collection)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(userID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByName'
+type MockIRepository_GetByName_Call struct {
+	*mock.Call
+}
+
+// GetByName is a helper method to define mock.On call
+//   - userID string
+//   - name string
+func (_e *MockIRepository_Expecter) GetByName(userID interface{}, name interface{}) *MockIRepository_GetByName_Call {
+	return &MockIRepository_GetByName_Call{Call: _e.mock.On("GetByName", userID, name)}
+}
+
+func (_c *MockIRepository_GetByName_Call) Run(run func(userID string, name string)) *MockIRepository_GetByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetByName_Call) Return(collection model.Collection, err error) *MockIRepository_GetByName_Call {
+	_c.Call.Return(collection, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetByName_Call) RunAndReturn(run func(userID string, name string) (model.Collection, error)) *MockIRepository_GetByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDefault provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetDefault(userID string) (model.Collection, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDefault")
+	}
+
+	var r0 model.Collection
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (model.Collection, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) model.Collection); ok {
+		r0 = returnFunc(userID)
+	} else {
+		r0 = ret.Get(0).(model.Collection)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetDefault_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDefault'
+type MockIRepository_GetDefault_Call struct {
+	*mock.Call
+}
+
+// GetDefault is a helper method to define mock.On call
+//   - userID string
+func (_e *MockIRepository_Expecter) GetDefault(userID interface{}) *MockIRepository_GetDefault_Call {
+	return &MockIRepository_GetDefault_Call{Call: _e.mock.On("GetDefault", userID)}
+}
+
+func (_c *MockIRepository_GetDefault_Call) Run(run func(userID string)) *MockIRepository_GetDefault_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetDefault_Call) Return(collection model.Collection, err error) *MockIRepository_GetDefault_Call {
+	_c.Call.Return(collection, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetDefault_Call) RunAndReturn(run func(userID string) (model.Collection, error)) *MockIRepository_GetDefault_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecipes provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetRecipes(collectionID uint, claimsID string) (model.FoodRecipes, error) {
+	ret := _mock.Called(collectionID, claimsID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecipes")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint, string) (model.FoodRecipes, error)); ok {
+		return returnFunc(collectionID, claimsID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint, string) model.FoodRecipes); ok {
+		r0 = returnFunc(collectionID, claimsID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint, string) error); ok {
+		r1 = returnFunc(collectionID, claimsID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
+type MockIRepository_GetRecipes_Call struct {
+	*mock.Call
+}
+
+// GetRecipes is a helper method to define mock.On call
+//   - collectionID uint
+//   - claimsID string
+func (_e *MockIRepository_Expecter) GetRecipes(collectionID interface{}, claimsID interface{}) *MockIRepository_GetRecipes_Call {
+	return &MockIRepository_GetRecipes_Call{Call: _e.mock.On("GetRecipes", collectionID, claimsID)}
+}
+
+func (_c *MockIRepository_GetRecipes_Call) Run(run func(collectionID uint, claimsID string)) *MockIRepository_GetRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetRecipes_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIRepository_GetRecipes_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetRecipes_Call) RunAndReturn(run func(collectionID uint, claimsID string) (model.FoodRecipes, error)) *MockIRepository_GetRecipes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveRecipe provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) RemoveRecipe(collection model.Collection, recipeID uint) error {
+	ret := _mock.Called(collection, recipeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveRecipe")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(model.Collection, uint) error); ok {
+		r0 = returnFunc(collection, recipeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_RemoveRecipe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveRecipe'
+type MockIRepository_RemoveRecipe_Call struct {
+	*mock.Call
+}
+
+// RemoveRecipe is a helper method to define mock.On call
+//   - collection model.Collection
+//   - recipeID uint
+func (_e *MockIRepository_Expecter) RemoveRecipe(collection interface{}, recipeID interface{}) *MockIRepository_RemoveRecipe_Call {
+	return &MockIRepository_RemoveRecipe_Call{Call: _e.mock.On("RemoveRecipe", collection, recipeID)}
+}
+
+func (_c *MockIRepository_RemoveRecipe_Call) Run(run func(collection model.Collection, recipeID uint)) *MockIRepository_RemoveRecipe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Collection
+		if args[0] != nil {
+			arg0 = args[0].(model.Collection)
+		}
+		var arg1 uint
+		if args[1] != nil {
+			arg1 = args[1].(uint)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_RemoveRecipe_Call) Return(err error) *MockIRepository_RemoveRecipe_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_RemoveRecipe_Call) RunAndReturn(run func(collection model.Collection, recipeID uint) error) *MockIRepository_RemoveRecipe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reorder provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Reorder(userID string, ids []uint) error {
+	ret := _mock.Called(userID, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reorder")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, []uint) error); ok {
+		r0 = returnFunc(userID, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Reorder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reorder'
+type MockIRepository_Reorder_Call struct {
+	*mock.Call
+}
+
+// Reorder is a helper method to define mock.On call
+//   - userID string
+//   - ids []uint
+func (_e *MockIRepository_Expecter) Reorder(userID interface{}, ids interface{}) *MockIRepository_Reorder_Call {
+	return &MockIRepository_Reorder_Call{Call: _e.mock.On("Reorder", userID, ids)}
+}
+
+func (_c *MockIRepository_Reorder_Call) Run(run func(userID string, ids []uint)) *MockIRepository_Reorder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 []uint
+		if args[1] != nil {
+			arg1 = args[1].([]uint)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Reorder_Call) Return(err error) *MockIRepository_Reorder_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Reorder_Call) RunAndReturn(run func(userID string, ids []uint) error) *MockIRepository_Reorder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Update(collection *model.Collection) error {
+	ret := _mock.Called(collection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Collection) error); ok {
+		r0 = returnFunc(collection)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - collection *model.Collection
+func (_e *MockIRepository_Expecter) Update(collection interface{}) *MockIRepository_Update_Call {
+	return &MockIRepository_Update_Call{Call: _e.mock.On("Update", collection)}
+}
+
+func (_c *MockIRepository_Update_Call) Run(run func(collection *model.Collection)) *MockIRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Collection
+		if args[0] != nil {
+			arg0 = args[0].(*model.Collection)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Update_Call) Return(err error) *MockIRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Update_Call) RunAndReturn(run func(collection *model.Collection) error) *MockIRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIUserService creates a new instance of MockIUserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIUserService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIUserService {
+	mock := &MockIUserService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIUserService is an autogenerated mock type for the IUserService type
+type MockIUserService struct {
+	mock.Mock
+}
+
+type MockIUserService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIUserService) EXPECT() *MockIUserService_Expecter {
+	return &MockIUserService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Create(claims model.Claims) (model.User, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
+		r0 = returnFunc(claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIUserService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) Create(claims interface{}) *MockIUserService_Create_Call {
+	return &MockIUserService_Create_Call{Call: _e.mock.On("Create", claims)}
+}
+
+func (_c *MockIUserService_Create_Call) Run(run func(claims model.Claims)) *MockIUserService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Create_Call) Return(user model.User, err error) *MockIUserService_Create_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIUserService_Create_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIUserService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) GetByID(claims model.Claims) (model.User, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
+		r0 = returnFunc(claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIUserService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) GetByID(claims interface{}) *MockIUserService_GetByID_Call {
+	return &MockIUserService_GetByID_Call{Call: _e.mock.On("GetByID", claims)}
+}
+
+func (_c *MockIUserService_GetByID_Call) Run(run func(claims model.Claims)) *MockIUserService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_GetByID_Call) Return(user model.User, err error) *MockIUserService_GetByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIUserService_GetByID_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIUserService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecipes provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) GetRecipes(userID string, query model.UserRecipesQuery, claims model.Claims) (model.FoodRecipes, error) {
+	ret := _mock.Called(userID, query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecipes")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.UserRecipesQuery, model.Claims) (model.FoodRecipes, error)); ok {
+		return returnFunc(userID, query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.UserRecipesQuery, model.Claims) model.FoodRecipes); ok {
+		r0 = returnFunc(userID, query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.UserRecipesQuery, model.Claims) error); ok {
+		r1 = returnFunc(userID, query, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
+type MockIUserService_GetRecipes_Call struct {
+	*mock.Call
+}
+
+// GetRecipes is a helper method to define mock.On call
+//   - userID string
+//   - query model.UserRecipesQuery
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) GetRecipes(userID interface{}, query interface{}, claims interface{}) *MockIUserService_GetRecipes_Call {
+	return &MockIUserService_GetRecipes_Call{Call: _e.mock.On("GetRecipes", userID, query, claims)}
+}
+
+func (_c *MockIUserService_GetRecipes_Call) Run(run func(userID string, query model.UserRecipesQuery, claims model.Claims)) *MockIUserService_GetRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.UserRecipesQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.UserRecipesQuery)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_GetRecipes_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIUserService_GetRecipes_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIUserService_GetRecipes_Call) RunAndReturn(run func(userID string, query model.UserRecipesQuery, claims model.Claims) (model.FoodRecipes, error)) *MockIUserService_GetRecipes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Update(user *model.User) (model.User, error) {
+	ret := _mock.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.User) (model.User, error)); ok {
+		return returnFunc(user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.User) model.User); ok {
+		r0 = returnFunc(user)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.User) error); ok {
+		r1 = returnFunc(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIUserService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - user *model.User
+func (_e *MockIUserService_Expecter) Update(user interface{}) *MockIUserService_Update_Call {
+	return &MockIUserService_Update_Call{Call: _e.mock.On("Update", user)}
+}
+
+func (_c *MockIUserService_Update_Call) Run(run func(user *model.User)) *MockIUserService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.User
+		if args[0] != nil {
+			arg0 = args[0].(*model.User)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Update_Call) Return(user1 model.User, err error) *MockIUserService_Update_Call {
+	_c.Call.Return(user1, err)
+	return _c
+}
+
+func (_c *MockIUserService_Update_Call) RunAndReturn(run func(user *model.User) (model.User, error)) *MockIUserService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertWithClaims provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) UpsertWithClaims(claims model.Claims) (model.User, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertWithClaims")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
+		r0 = returnFunc(claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_UpsertWithClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertWithClaims'
+type MockIUserService_UpsertWithClaims_Call struct {
+	*mock.Call
+}
+
+// UpsertWithClaims is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) UpsertWithClaims(claims interface{}) *MockIUserService_UpsertWithClaims_Call {
+	return &MockIUserService_UpsertWithClaims_Call{Call: _e.mock.On("UpsertWithClaims", claims)}
+}
+
+func (_c *MockIUserService_UpsertWithClaims_Call) Run(run func(claims model.Claims)) *MockIUserService_UpsertWithClaims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_UpsertWithClaims_Call) Return(user model.User, err error) *MockIUserService_UpsertWithClaims_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIUserService_UpsertWithClaims_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIUserService_UpsertWithClaims_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIFoodRecipeService creates a new instance of MockIFoodRecipeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIFoodRecipeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIFoodRecipeService {
+	mock := &MockIFoodRecipeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIFoodRecipeService is an autogenerated mock type for the IFoodRecipeService type
+type MockIFoodRecipeService struct {
+	mock.Mock
+}
+
+type MockIFoodRecipeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIFoodRecipeService) EXPECT() *MockIFoodRecipeService_Expecter {
+	return &MockIFoodRecipeService_Expecter{mock: &_m.Mock}
+}
+
+// BayesianPrior provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) BayesianPrior() (model.BayesianPrior, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BayesianPrior")
+	}
+
+	var r0 model.BayesianPrior
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (model.BayesianPrior, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() model.BayesianPrior); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(model.BayesianPrior)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_BayesianPrior_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BayesianPrior'
+type MockIFoodRecipeService_BayesianPrior_Call struct {
+	*mock.Call
+}
+
+// BayesianPrior is a helper method to define mock.On call
+func (_e *MockIFoodRecipeService_Expecter) BayesianPrior() *MockIFoodRecipeService_BayesianPrior_Call {
+	return &MockIFoodRecipeService_BayesianPrior_Call{Call: _e.mock.On("BayesianPrior")}
+}
+
+func (_c *MockIFoodRecipeService_BayesianPrior_Call) Run(run func()) *MockIFoodRecipeService_BayesianPrior_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_BayesianPrior_Call) Return(bayesianPrior model.BayesianPrior, err error) *MockIFoodRecipeService_BayesianPrior_Call {
+	_c.Call.Return(bayesianPrior, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_BayesianPrior_Call) RunAndReturn(run func() (model.BayesianPrior, error)) *MockIFoodRecipeService_BayesianPrior_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Create(request dto.FoodRecipeRequest, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(request, claims)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipe)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.FoodRecipeRequest, model.Claims) error); ok {
+		r1 = returnFunc(request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIFoodRecipeService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - request dto.FoodRecipeRequest
+//   - claims model.Claims
+func (_e *MockIFoodRecipeService_Expecter) Create(request interface{}, claims interface{}) *MockIFoodRecipeService_Create_Call {
+	return &MockIFoodRecipeService_Create_Call{Call: _e.mock.On("Create", request, claims)}
+}
+
+func (_c *MockIFoodRecipeService_Create_Call) Run(run func(request dto.FoodRecipeRequest, claims model.Claims)) *MockIFoodRecipeService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.FoodRecipeRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.FoodRecipeRequest)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Create_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIFoodRecipeService_Create_Call {
+	_c.Call.Return(foodRecipe, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Create_Call) RunAndReturn(run func(request dto.FoodRecipeRequest, claims model.Claims) (model.FoodRecipe, error)) *MockIFoodRecipeService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Delete(id int, claims model.Claims) error {
+	ret := _mock.Called(id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) error); ok {
+		r0 = returnFunc(id, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIFoodRecipeService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIFoodRecipeService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id int
+//   - claims model.Claims
+func (_e *MockIFoodRecipeService_Expecter) Delete(id interface{}, claims interface{}) *MockIFoodRecipeService_Delete_Call {
+	return &MockIFoodRecipeService_Delete_Call{Call: _e.mock.On("Delete", id, claims)}
+}
+
+func (_c *MockIFoodRecipeService_Delete_Call) Run(run func(id int, claims model.Claims)) *MockIFoodRecipeService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Delete_Call) Return(err error) *MockIFoodRecipeService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Delete_Call) RunAndReturn(run func(id int, claims model.Claims) error) *MockIFoodRecipeService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exists provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Exists(id int) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exists")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIFoodRecipeService_Exists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exists'
+type MockIFoodRecipeService_Exists_Call struct {
+	*mock.Call
+}
+
+// Exists is a helper method to define mock.On call
+//   - id int
+func (_e *MockIFoodRecipeService_Expecter) Exists(id interface{}) *MockIFoodRecipeService_Exists_Call {
+	return &MockIFoodRecipeService_Exists_Call{Call: _e.mock.On("Exists", id)}
+}
+
+func (_c *MockIFoodRecipeService_Exists_Call) Run(run func(id int)) *MockIFoodRecipeService_Exists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Exists_Call) Return(err error) *MockIFoodRecipeService_Exists_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Exists_Call) RunAndReturn(run func(id int) error) *MockIFoodRecipeService_Exists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Get(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, string, error) {
+	ret := _mock.Called(foodRecipeQuery, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 int64
+	var r2 string
+	var r3 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, model.Claims) (model.FoodRecipes, int64, string, error)); ok {
+		return returnFunc(foodRecipeQuery, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, model.Claims) model.FoodRecipes); ok {
+		r0 = returnFunc(foodRecipeQuery, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery, model.Claims) int64); ok {
+		r1 = returnFunc(foodRecipeQuery, claims)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(model.FoodRecipeQuery, model.Claims) string); ok {
+		r2 = returnFunc(foodRecipeQuery, claims)
+	} else {
+		r2 = ret.Get(2).(string)
+	}
+	if returnFunc, ok := ret.Get(3).(func(model.FoodRecipeQuery, model.Claims) error); ok {
+		r3 = returnFunc(foodRecipeQuery, claims)
+	} else {
+		r3 = ret.Error(3)
+	}
+	return r0, r1, r2, r3
+}
+
+// MockIFoodRecipeService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIFoodRecipeService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - foodRecipeQuery model.FoodRecipeQuery
+//   - claims model.Claims
+func (_e *MockIFoodRecipeService_Expecter) Get(foodRecipeQuery interface{}, claims interface{}) *MockIFoodRecipeService_Get_Call {
+	return &MockIFoodRecipeService_Get_Call{Call: _e.mock.On("Get", foodRecipeQuery, claims)}
+}
+
+func (_c *MockIFoodRecipeService_Get_Call) Run(run func(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims)) *MockIFoodRecipeService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Get_Call) Return(foodRecipes model.FoodRecipes, n int64, s string, err error) *MockIFoodRecipeService_Get_Call {
+	_c.Call.Return(foodRecipes, n, s, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Get_Call) RunAndReturn(run func(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, string, error)) *MockIFoodRecipeService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) GetByID(id int, query model.FoodRecipeDetailQuery, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(id, query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.FoodRecipeDetailQuery, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(id, query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, model.FoodRecipeDetailQuery, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(id, query, claims)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipe)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, model.FoodRecipeDetailQuery, model.Claims) error); ok {
+		r1 = returnFunc(id, query, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIFoodRecipeService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - id int
+//   - query model.FoodRecipeDetailQuery
+//   - claims model.Claims
+func (_e *MockIFoodRecipeService_Expecter) GetByID(id interface{}, query interface{}, claims interface{}) *MockIFoodRecipeService_GetByID_Call {
+	return &MockIFoodRecipeService_GetByID_Call{Call: _e.mock.On("GetByID", id, query, claims)}
+}
+
+func (_c *MockIFoodRecipeService_GetByID_Call) Run(run func(id int, query model.FoodRecipeDetailQuery, claims model.Claims)) *MockIFoodRecipeService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.FoodRecipeDetailQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.FoodRecipeDetailQuery)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_GetByID_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIFoodRecipeService_GetByID_Call {
+	_c.Call.Return(foodRecipe, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_GetByID_Call) RunAndReturn(run func(id int, query model.FoodRecipeDetailQuery, claims model.Claims) (model.FoodRecipe, error)) *MockIFoodRecipeService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFacets provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) GetFacets(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipeFacets, error) {
+	ret := _mock.Called(foodRecipeQuery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFacets")
+	}
+
+	var r0 model.FoodRecipeFacets
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) (model.FoodRecipeFacets, error)); ok {
+		return returnFunc(foodRecipeQuery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) model.FoodRecipeFacets); ok {
+		r0 = returnFunc(foodRecipeQuery)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipeFacets)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery) error); ok {
+		r1 = returnFunc(foodRecipeQuery)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_GetFacets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFacets'
+type MockIFoodRecipeService_GetFacets_Call struct {
+	*mock.Call
+}
+
+// GetFacets is a helper method to define mock.On call
+//   - foodRecipeQuery model.FoodRecipeQuery
+func (_e *MockIFoodRecipeService_Expecter) GetFacets(foodRecipeQuery interface{}) *MockIFoodRecipeService_GetFacets_Call {
+	return &MockIFoodRecipeService_GetFacets_Call{Call: _e.mock.On("GetFacets", foodRecipeQuery)}
+}
+
+func (_c *MockIFoodRecipeService_GetFacets_Call) Run(run func(foodRecipeQuery model.FoodRecipeQuery)) *MockIFoodRecipeService_GetFacets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_GetFacets_Call) Return(foodRecipeFacets model.FoodRecipeFacets, err error) *MockIFoodRecipeService_GetFacets_Call {
+	_c.Call.Return(foodRecipeFacets, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_GetFacets_Call) RunAndReturn(run func(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipeFacets, error)) *MockIFoodRecipeService_GetFacets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTop provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) GetTop(query model.TopRecipesQuery, claims model.Claims) (model.FoodRecipes, error) {
+	ret := _mock.Called(query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTop")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.TopRecipesQuery, model.Claims) (model.FoodRecipes, error)); ok {
+		return returnFunc(query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.TopRecipesQuery, model.Claims) model.FoodRecipes); ok {
+		r0 = returnFunc(query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.TopRecipesQuery, model.Claims) error); ok {
+		r1 = returnFunc(query, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_GetTop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTop'
+type MockIFoodRecipeService_GetTop_Call struct {
+	*mock.Call
+}
+
+// GetTop is a helper method to define mock.On call
+//   - query model.TopRecipesQuery
+//   - claims model.Claims
+func (_e *MockIFoodRecipeService_Expecter) GetTop(query interface{}, claims interface{}) *MockIFoodRecipeService_GetTop_Call {
+	return &MockIFoodRecipeService_GetTop_Call{Call: _e.mock.On("GetTop", query, claims)}
+}
+
+func (_c *MockIFoodRecipeService_GetTop_Call) Run(run func(query model.TopRecipesQuery, claims model.Claims)) *MockIFoodRecipeService_GetTop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.TopRecipesQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.TopRecipesQuery)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_GetTop_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIFoodRecipeService_GetTop_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_GetTop_Call) RunAndReturn(run func(query model.TopRecipesQuery, claims model.Claims) (model.FoodRecipes, error)) *MockIFoodRecipeService_GetTop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MatchPantry provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) MatchPantry(query model.PantryMatchQuery, claims model.Claims) (model.PantryMatches, int64, error) {
+	ret := _mock.Called(query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MatchPantry")
+	}
+
+	var r0 model.PantryMatches
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(model.PantryMatchQuery, model.Claims) (model.PantryMatches, int64, error)); ok {
+		return returnFunc(query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.PantryMatchQuery, model.Claims) model.PantryMatches); ok {
+		r0 = returnFunc(query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.PantryMatches)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.PantryMatchQuery, model.Claims) int64); ok {
+		r1 = returnFunc(query, claims)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(model.PantryMatchQuery, model.Claims) error); ok {
+		r2 = returnFunc(query, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIFoodRecipeService_MatchPantry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MatchPantry'
+type MockIFoodRecipeService_MatchPantry_Call struct {
+	*mock.Call
+}
+
+// MatchPantry is a helper method to define mock.On call
+//   - query model.PantryMatchQuery
+//   - claims model.Claims
+func (_e *MockIFoodRecipeService_Expecter) MatchPantry(query interface{}, claims interface{}) *MockIFoodRecipeService_MatchPantry_Call {
+	return &MockIFoodRecipeService_MatchPantry_Call{Call: _e.mock.On("MatchPantry", query, claims)}
+}
+
+func (_c *MockIFoodRecipeService_MatchPantry_Call) Run(run func(query model.PantryMatchQuery, claims model.Claims)) *MockIFoodRecipeService_MatchPantry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.PantryMatchQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.PantryMatchQuery)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_MatchPantry_Call) Return(pantryMatches model.PantryMatches, n int64, err error) *MockIFoodRecipeService_MatchPantry_Call {
+	_c.Call.Return(pantryMatches, n, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_MatchPantry_Call) RunAndReturn(run func(query model.PantryMatchQuery, claims model.Claims) (model.PantryMatches, int64, error)) *MockIFoodRecipeService_MatchPantry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Suggest provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Suggest(query model.SuggestQuery) (model.Suggestions, error) {
+	ret := _mock.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Suggest")
+	}
+
+	var r0 model.Suggestions
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.SuggestQuery) (model.Suggestions, error)); ok {
+		return returnFunc(query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.SuggestQuery) model.Suggestions); ok {
+		r0 = returnFunc(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Suggestions)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.SuggestQuery) error); ok {
+		r1 = returnFunc(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_Suggest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Suggest'
+type MockIFoodRecipeService_Suggest_Call struct {
+	*mock.Call
+}
+
+// Suggest is a helper method to define mock.On call
+//   - query model.SuggestQuery
+func (_e *MockIFoodRecipeService_Expecter) Suggest(query interface{}) *MockIFoodRecipeService_Suggest_Call {
+	return &MockIFoodRecipeService_Suggest_Call{Call: _e.mock.On("Suggest", query)}
+}
+
+func (_c *MockIFoodRecipeService_Suggest_Call) Run(run func(query model.SuggestQuery)) *MockIFoodRecipeService_Suggest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.SuggestQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.SuggestQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Suggest_Call) Return(suggestions model.Suggestions, err error) *MockIFoodRecipeService_Suggest_Call {
+	_c.Call.Return(suggestions, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Suggest_Call) RunAndReturn(run func(query model.SuggestQuery) (model.Suggestions, error)) *MockIFoodRecipeService_Suggest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Update(request dto.FoodRecipeRequest, id int, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(request, id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, int, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(request, id, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, int, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(request, id, claims)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipe)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.FoodRecipeRequest, int, model.Claims) error); ok {
+		r1 = returnFunc(request, id, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIFoodRecipeService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - request dto.FoodRecipeRequest
+//   - id int
+//   - claims model.Claims
+func (_e *MockIFoodRecipeService_Expecter) Update(request interface{}, id interface{}, claims interface{}) *MockIFoodRecipeService_Update_Call {
+	return &MockIFoodRecipeService_Update_Call{Call: _e.mock.On("Update", request, id, claims)}
+}
+
+func (_c *MockIFoodRecipeService_Update_Call) Run(run func(request dto.FoodRecipeRequest, id int, claims model.Claims)) *MockIFoodRecipeService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.FoodRecipeRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.FoodRecipeRequest)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Update_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIFoodRecipeService_Update_Call {
+	_c.Call.Return(foodRecipe, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Update_Call) RunAndReturn(run func(request dto.FoodRecipeRequest, id int, claims model.Claims) (model.FoodRecipe, error)) *MockIFoodRecipeService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIService creates a new instance of MockIService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIService {
+	mock := &MockIService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIService is an autogenerated mock type for the IService type
+type MockIService struct {
+	mock.Mock
+}
+
+type MockIService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIService) EXPECT() *MockIService_Expecter {
+	return &MockIService_Expecter{mock: &_m.Mock}
+}
+
+// AddRecipe provides a mock function for the type MockIService
+func (_mock *MockIService) AddRecipe(request dto.CollectionItemRequest, id int, claims model.Claims) (model.Collection, error) {
+	ret := _mock.Called(request, id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRecipe")
+	}
+
+	var r0 model.Collection
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.CollectionItemRequest, int, model.Claims) (model.Collection, error)); ok {
+		return returnFunc(request, id, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.CollectionItemRequest, int, model.Claims) model.Collection); ok {
+		r0 = returnFunc(request, id, claims)
+	} else {
+		r0 = ret.Get(0).(model.Collection)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.CollectionItemRequest, int, model.Claims) error); ok {
+		r1 = returnFunc(request, id, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_AddRecipe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRecipe'
+type MockIService_AddRecipe_Call struct {
+	*mock.Call
+}
+
+// AddRecipe is a helper method to define mock.On call
+//   - request dto.CollectionItemRequest
+//   - id int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) AddRecipe(request interface{}, id interface{}, claims interface{}) *MockIService_AddRecipe_Call {
+	return &MockIService_AddRecipe_Call{Call: _e.mock.On("AddRecipe", request, id, claims)}
+}
+
+func (_c *MockIService_AddRecipe_Call) Run(run func(request dto.CollectionItemRequest, id int, claims model.Claims)) *MockIService_AddRecipe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.CollectionItemRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.CollectionItemRequest)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_AddRecipe_Call) Return(collection model.Collection, err error) *MockIService_AddRecipe_Call {
+	_c.Call.Return(collection, err)
+	return _c
+}
+
+func (_c *MockIService_AddRecipe_Call) RunAndReturn(run func(request dto.CollectionItemRequest, id int, claims model.Claims) (model.Collection, error)) *MockIService_AddRecipe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddToDefault provides a mock function for the type MockIService
+func (_mock *MockIService) AddToDefault(recipeID int, claims model.Claims) error {
+	ret := _mock.Called(recipeID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddToDefault")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) error); ok {
+		r0 = returnFunc(recipeID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIService_AddToDefault_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddToDefault'
+type MockIService_AddToDefault_Call struct {
+	*mock.Call
+}
+
+// AddToDefault is a helper method to define mock.On call
+//   - recipeID int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) AddToDefault(recipeID interface{}, claims interface{}) *MockIService_AddToDefault_Call {
+	return &MockIService_AddToDefault_Call{Call: _e.mock.On("AddToDefault", recipeID, claims)}
+}
+
+func (_c *MockIService_AddToDefault_Call) Run(run func(recipeID int, claims model.Claims)) *MockIService_AddToDefault_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_AddToDefault_Call) Return(err error) *MockIService_AddToDefault_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIService_AddToDefault_Call) RunAndReturn(run func(recipeID int, claims model.Claims) error) *MockIService_AddToDefault_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockIService
+func (_mock *MockIService) Create(request dto.CollectionRequest, claims model.Claims) (model.Collection, error) {
+	ret := _mock.Called(request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Collection
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.CollectionRequest, model.Claims) (model.Collection, error)); ok {
+		return returnFunc(request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.CollectionRequest, model.Claims) model.Collection); ok {
+		r0 = returnFunc(request, claims)
+	} else {
+		r0 = ret.Get(0).(model.Collection)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.CollectionRequest, model.Claims) error); ok {
+		r1 = returnFunc(request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - request dto.CollectionRequest
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Create(request interface{}, claims interface{}) *MockIService_Create_Call {
+	return &MockIService_Create_Call{Call: _e.mock.On("Create", request, claims)}
+}
+
+func (_c *MockIService_Create_Call) Run(run func(request dto.CollectionRequest, claims model.Claims)) *MockIService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.CollectionRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.CollectionRequest)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Create_Call) Return(collection model.Collection, err error) *MockIService_Create_Call {
+	_c.Call.Return(collection, err)
+	return _c
+}
+
+func (_c *MockIService_Create_Call) RunAndReturn(run func(request dto.CollectionRequest, claims model.Claims) (model.Collection, error)) *MockIService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIService
+func (_mock *MockIService) Delete(id int, claims model.Claims) error {
+	ret := _mock.Called(id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) error); ok {
+		r0 = returnFunc(id, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Delete(id interface{}, claims interface{}) *MockIService_Delete_Call {
+	return &MockIService_Delete_Call{Call: _e.mock.On("Delete", id, claims)}
+}
+
+func (_c *MockIService_Delete_Call) Run(run func(id int, claims model.Claims)) *MockIService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Delete_Call) Return(err error) *MockIService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIService_Delete_Call) RunAndReturn(run func(id int, claims model.Claims) error) *MockIService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIService
+func (_mock *MockIService) Get(claims model.Claims) (model.Collections, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.Collections
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.Collections, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.Collections); ok {
+		r0 = returnFunc(claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Collections)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Get(claims interface{}) *MockIService_Get_Call {
+	return &MockIService_Get_Call{Call: _e.mock.On("Get", claims)}
+}
+
+func (_c *MockIService_Get_Call) Run(run func(claims model.Claims)) *MockIService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Get_Call) Return(collections model.Collections, err error) *MockIService_Get_Call {
+	_c.Call.Return(collections, err)
+	return _c
+}
+
+func (_c *MockIService_Get_Call) RunAndReturn(run func(claims model.Claims) (model.Collections, error)) *MockIService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIService
+func (_mock *MockIService) GetByID(id int, claims model.Claims) (model.Collection, model.FoodRecipes, error) {
+	ret := _mock.Called(id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Collection
+	var r1 model.FoodRecipes
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) (model.Collection, model.FoodRecipes, error)); ok {
+		return returnFunc(id, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) model.Collection); ok {
+		r0 = returnFunc(id, claims)
+	} else {
+		r0 = ret.Get(0).(model.Collection)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, model.Claims) model.FoodRecipes); ok {
+		r1 = returnFunc(id, claims)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(int, model.Claims) error); ok {
+		r2 = returnFunc(id, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - id int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetByID(id interface{}, claims interface{}) *MockIService_GetByID_Call {
+	return &MockIService_GetByID_Call{Call: _e.mock.On("GetByID", id, claims)}
+}
+
+func (_c *MockIService_GetByID_Call) Run(run func(id int, claims model.Claims)) *MockIService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetByID_Call) Return(collection model.Collection, foodRecipes model.FoodRecipes, err error) *MockIService_GetByID_Call {
+	_c.Call.Return(collection, foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIService_GetByID_Call) RunAndReturn(run func(id int, claims model.Claims) (model.Collection, model.FoodRecipes, error)) *MockIService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveFromDefault provides a mock function for the type MockIService
+func (_mock *MockIService) RemoveFromDefault(recipeID int, claims model.Claims) error {
+	ret := _mock.Called(recipeID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveFromDefault")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) error); ok {
+		r0 = returnFunc(recipeID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIService_RemoveFromDefault_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFromDefault'
+type MockIService_RemoveFromDefault_Call struct {
+	*mock.Call
+}
+
+// RemoveFromDefault is a helper method to define mock.On call
+//   - recipeID int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) RemoveFromDefault(recipeID interface{}, claims interface{}) *MockIService_RemoveFromDefault_Call {
+	return &MockIService_RemoveFromDefault_Call{Call: _e.mock.On("RemoveFromDefault", recipeID, claims)}
+}
+
+func (_c *MockIService_RemoveFromDefault_Call) Run(run func(recipeID int, claims model.Claims)) *MockIService_RemoveFromDefault_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_RemoveFromDefault_Call) Return(err error) *MockIService_RemoveFromDefault_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIService_RemoveFromDefault_Call) RunAndReturn(run func(recipeID int, claims model.Claims) error) *MockIService_RemoveFromDefault_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveRecipe provides a mock function for the type MockIService
+func (_mock *MockIService) RemoveRecipe(id int, recipeID int, claims model.Claims) (model.Collection, error) {
+	ret := _mock.Called(id, recipeID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveRecipe")
+	}
+
+	var r0 model.Collection
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, model.Claims) (model.Collection, error)); ok {
+		return returnFunc(id, recipeID, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int, model.Claims) model.Collection); ok {
+		r0 = returnFunc(id, recipeID, claims)
+	} else {
+		r0 = ret.Get(0).(model.Collection)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int, model.Claims) error); ok {
+		r1 = returnFunc(id, recipeID, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_RemoveRecipe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveRecipe'
+type MockIService_RemoveRecipe_Call struct {
+	*mock.Call
+}
+
+// RemoveRecipe is a helper method to define mock.On call
+//   - id int
+//   - recipeID int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) RemoveRecipe(id interface{}, recipeID interface{}, claims interface{}) *MockIService_RemoveRecipe_Call {
+	return &MockIService_RemoveRecipe_Call{Call: _e.mock.On("RemoveRecipe", id, recipeID, claims)}
+}
+
+func (_c *MockIService_RemoveRecipe_Call) Run(run func(id int, recipeID int, claims model.Claims)) *MockIService_RemoveRecipe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_RemoveRecipe_Call) Return(collection model.Collection, err error) *MockIService_RemoveRecipe_Call {
+	_c.Call.Return(collection, err)
+	return _c
+}
+
+func (_c *MockIService_RemoveRecipe_Call) RunAndReturn(run func(id int, recipeID int, claims model.Claims) (model.Collection, error)) *MockIService_RemoveRecipe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reorder provides a mock function for the type MockIService
+func (_mock *MockIService) Reorder(request dto.CollectionOrderRequest, claims model.Claims) (model.Collections, error) {
+	ret := _mock.Called(request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reorder")
+	}
+
+	var r0 model.Collections
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.CollectionOrderRequest, model.Claims) (model.Collections, error)); ok {
+		return returnFunc(request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.CollectionOrderRequest, model.Claims) model.Collections); ok {
+		r0 = returnFunc(request, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Collections)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.CollectionOrderRequest, model.Claims) error); ok {
+		r1 = returnFunc(request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Reorder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reorder'
+type MockIService_Reorder_Call struct {
+	*mock.Call
+}
+
+// Reorder is a helper method to define mock.On call
+//   - request dto.CollectionOrderRequest
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Reorder(request interface{}, claims interface{}) *MockIService_Reorder_Call {
+	return &MockIService_Reorder_Call{Call: _e.mock.On("Reorder", request, claims)}
+}
+
+func (_c *MockIService_Reorder_Call) Run(run func(request dto.CollectionOrderRequest, claims model.Claims)) *MockIService_Reorder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.CollectionOrderRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.CollectionOrderRequest)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Reorder_Call) Return(collections model.Collections, err error) *MockIService_Reorder_Call {
+	_c.Call.Return(collections, err)
+	return _c
+}
+
+func (_c *MockIService_Reorder_Call) RunAndReturn(run func(request dto.CollectionOrderRequest, claims model.Claims) (model.Collections, error)) *MockIService_Reorder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIService
+func (_mock *MockIService) Update(request dto.CollectionRequest, id int, claims model.Claims) (model.Collection, error) {
+	ret := _mock.Called(request, id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.Collection
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.CollectionRequest, int, model.Claims) (model.Collection, error)); ok {
+		return returnFunc(request, id, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.CollectionRequest, int, model.Claims) model.Collection); ok {
+		r0 = returnFunc(request, id, claims)
+	} else {
+		r0 = ret.Get(0).(model.Collection)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.CollectionRequest, int, model.Claims) error); ok {
+		r1 = returnFunc(request, id, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - request dto.CollectionRequest
+//   - id int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Update(request interface{}, id interface{}, claims interface{}) *MockIService_Update_Call {
+	return &MockIService_Update_Call{Call: _e.mock.On("Update", request, id, claims)}
+}
+
+func (_c *MockIService_Update_Call) Run(run func(request dto.CollectionRequest, id int, claims model.Claims)) *MockIService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.CollectionRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.CollectionRequest)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Update_Call) Return(collection model.Collection, err error) *MockIService_Update_Call {
+	_c.Call.Return(collection, err)
+	return _c
+}
+
+func (_c *MockIService_Update_Call) RunAndReturn(run func(request dto.CollectionRequest, id int, claims model.Claims) (model.Collection, error)) *MockIService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
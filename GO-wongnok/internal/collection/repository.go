@@ -0,0 +1,232 @@
+package collection
+
+import (
+	"wongnok/internal/model"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IRepository interface {
+	Get(userID string) (model.Collections, error)
+	GetByID(id int) (model.Collection, error)
+	GetDefault(userID string) (model.Collection, error)
+	GetByName(userID string, name string) (model.Collection, error)
+	GetRecipes(collectionID uint, claimsID string) (model.FoodRecipes, error)
+	Create(collection *model.Collection) error
+	CreateDefault(collection *model.Collection) error
+	Update(collection *model.Collection) error
+	Delete(id uint) error
+	Reorder(userID string, ids []uint) error
+	AddRecipe(collection model.Collection, recipeID uint) error
+	RemoveRecipe(collection model.Collection, recipeID uint) error
+}
+
+// เลือกคอลัมน์ของ collection พร้อมจำนวนสูตรที่อยู่ข้างใน
+const selectWithRecipeCount = "collections.*, (SELECT COUNT(*) FROM collection_items WHERE collection_items.collection_id = collections.id) AS recipe_count"
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+func (repo Repository) Get(userID string) (model.Collections, error) {
+	var collections = make(model.Collections, 0)
+
+	if err := repo.DB.Select(selectWithRecipeCount).
+		Where("user_id = ?", userID).
+		Order("position asc, id asc").
+		Find(&collections).Error; err != nil {
+		return nil, err
+	}
+
+	return collections, nil
+}
+
+func (repo Repository) GetByID(id int) (model.Collection, error) {
+	var collection model.Collection
+
+	if err := repo.DB.Select(selectWithRecipeCount).First(&collection, id).Error; err != nil {
+		return model.Collection{}, err
+	}
+
+	return collection, nil
+}
+
+func (repo Repository) GetDefault(userID string) (model.Collection, error) {
+	var collection model.Collection
+
+	if err := repo.DB.Where("user_id = ? AND is_default", userID).First(&collection).Error; err != nil {
+		return model.Collection{}, err
+	}
+
+	return collection, nil
+}
+
+func (repo Repository) GetByName(userID string, name string) (model.Collection, error) {
+	var collection model.Collection
+
+	if err := repo.DB.Where("user_id = ? AND LOWER(name) = LOWER(?)", userID, name).First(&collection).Error; err != nil {
+		return model.Collection{}, err
+	}
+
+	return collection, nil
+}
+
+// GetRecipes สูตรอาหารใน collection เรียงตามเวลาที่เพิ่มล่าสุดก่อน
+func (repo Repository) GetRecipes(collectionID uint, claimsID string) (model.FoodRecipes, error) {
+	var recipes = make(model.FoodRecipes, 0)
+
+	if err := repo.DB.
+		Joins("JOIN collection_items ON collection_items.food_recipe_id = food_recipes.id").
+		Where("collection_items.collection_id = ?", collectionID).
+		Preload("Favorite", "user_id = ?", claimsID).
+		Preload("Rating", "user_id = ?", claimsID).
+		Preload("CookingDuration").
+		Preload("Difficulty").
+		Preload("User").
+		Preload("Tags").
+		Order("collection_items.created_at desc, food_recipes.id desc").
+		Find(&recipes).Error; err != nil {
+		return nil, err
+	}
+
+	return recipes, nil
+}
+
+// Create เพิ่ม collection ไว้ท้ายสุดของผู้ใช้
+func (repo Repository) Create(collection *model.Collection) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		var position int
+		if err := tx.Model(&model.Collection{}).
+			Where("user_id = ?", collection.UserID).
+			Select("COALESCE(MAX(position), 0)").
+			Scan(&position).Error; err != nil {
+			return err
+		}
+
+		collection.Position = position + 1
+
+		return tx.Create(collection).Error
+	})
+}
+
+// CreateDefault เหมือน Create แต่ถ้ามี collection เริ่มต้นอยู่แล้วจะไม่ทำอะไร (ON CONFLICT DO NOTHING)
+// กดปุ่ม favorite ครั้งแรกพร้อมกันสองครั้งจะชน unique index ตัวหลังจึงไม่ได้ id กลับมา ผู้เรียกต้องอ่าน GetDefault ใหม่
+func (repo Repository) CreateDefault(collection *model.Collection) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		var position int
+		if err := tx.Model(&model.Collection{}).
+			Where("user_id = ?", collection.UserID).
+			Select("COALESCE(MAX(position), 0)").
+			Scan(&position).Error; err != nil {
+			return err
+		}
+
+		collection.Position = position + 1
+
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(collection).Error
+	})
+}
+
+func (repo Repository) Update(collection *model.Collection) error {
+	return repo.DB.Model(collection).Select("name", "is_public").Updates(collection).Error
+}
+
+// Delete ลบ collection และรายการสูตรข้างใน (ตัวสูตรอาหารไม่ถูกลบ)
+func (repo Repository) Delete(id uint) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("collection_id = ?", id).Delete(&model.CollectionItem{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&model.Collection{}, id).Error
+	})
+}
+
+// Reorder กำหนดลำดับใหม่ตาม ids ที่ส่งมา collection ที่ไม่ได้ส่งมาจะต่อท้ายตามลำดับเดิม
+func (repo Repository) Reorder(userID string, ids []uint) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		var collections model.Collections
+		if err := tx.Where("user_id = ?", userID).Order("position asc, id asc").Find(&collections).Error; err != nil {
+			return err
+		}
+
+		positions := make(map[uint]int, len(ids))
+		for i, id := range ids {
+			positions[id] = i + 1
+		}
+
+		next := len(ids)
+		for _, collection := range collections {
+			position, ok := positions[collection.ID]
+			if !ok {
+				next++
+				position = next
+			}
+
+			if err := tx.Model(&model.Collection{}).Where("id = ?", collection.ID).Update("position", position).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// AddRecipe เพิ่มสูตรเข้า collection (เพิ่มซ้ำได้โดยไม่ error)
+// ถ้าเป็น collection เริ่มต้นจะ favorite สูตรนั้นด้วย
+func (repo Repository) AddRecipe(collection model.Collection, recipeID uint) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		item := model.CollectionItem{CollectionID: collection.ID, FoodRecipeID: recipeID}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&item).Error; err != nil {
+			return err
+		}
+
+		if !collection.IsDefault {
+			return nil
+		}
+
+		return favorite(tx, collection.UserID, recipeID)
+	})
+}
+
+// RemoveRecipe เอาสูตรออกจาก collection ถ้าเป็น collection เริ่มต้นจะยกเลิก favorite ด้วย
+func (repo Repository) RemoveRecipe(collection model.Collection, recipeID uint) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("collection_id = ? AND food_recipe_id = ?", collection.ID, recipeID).Delete(&model.CollectionItem{}).Error; err != nil {
+			return err
+		}
+
+		if !collection.IsDefault {
+			return nil
+		}
+
+		return tx.Where("user_id = ? AND food_recipe_id = ?", collection.UserID, recipeID).Delete(&model.Favorite{}).Error
+	})
+}
+
+// favorite สร้าง favorite ใหม่ หรือกู้คืน favorite เดิมที่ถูก soft delete
+func favorite(tx *gorm.DB, userID string, recipeID uint) error {
+	var existing model.Favorite
+
+	err := tx.Unscoped().Where("user_id = ? AND food_recipe_id = ?", userID, recipeID).Order("id desc").First(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return tx.Create(&model.Favorite{UserID: userID, FoodRecipeID: recipeID}).Error
+	}
+	if err != nil {
+		return err
+	}
+
+	if !existing.DeletedAt.Valid {
+		return nil
+	}
+
+	return tx.Unscoped().Model(&existing).Update("deleted_at", nil).Error
+}
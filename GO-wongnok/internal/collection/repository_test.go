@@ -0,0 +1,211 @@
+package collection_test
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+	"wongnok/internal/collection"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	driver "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestNewRepository(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		repo := collection.NewRepository(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(repo))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+type RepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	container  *postgres.PostgresContainer
+	db         *gorm.DB
+	repository collection.IRepository
+}
+
+// This will run once before all tests in the suite
+func (suite *RepositoryTestSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	container, err := postgres.Run(
+		suite.ctx,
+		"postgres:17-alpine",
+		postgres.WithInitScripts(filepath.Join("../..", "tests", "init-db.sql")),
+		postgres.WithDatabase("wongnok-test"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").WithOccurrence(2).WithStartupTimeout(
+				(5 * time.Second),
+			),
+		),
+	)
+	suite.NoError(err)
+	suite.container = container
+}
+
+// This will run once after all tests in the suite
+func (suite *RepositoryTestSuite) TearDownSuite() {
+	err := suite.container.Terminate(suite.ctx)
+	suite.NoError(err)
+}
+
+// This will run before each test
+func (suite *RepositoryTestSuite) SetupTest() {
+	conn, err := suite.container.ConnectionString(suite.ctx, "sslmode=disable")
+	suite.NoError(err)
+
+	db, err := gorm.Open(driver.Open(conn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	suite.NoError(err)
+
+	suite.repository = &collection.Repository{
+		DB: db,
+	}
+
+	suite.db = db
+}
+
+// This will run after each test
+func (suite *RepositoryTestSuite) TearDownTest() {
+	sqldb, _ := suite.db.DB()
+	sqldb.Close()
+}
+
+const (
+	demoUserID   = "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"
+	secondUserID = "7c6d2f0a-5e3b-4c1d-9a8e-2f4b6d8c0e1a"
+)
+
+// Extend
+type RepositoryCreateDefaultTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryCreateDefaultTestSuite) TestSkipWhenDefaultExists() {
+	first := model.Collection{UserID: demoUserID, Name: model.DefaultCollectionName, IsDefault: true}
+	suite.NoError(suite.repository.CreateDefault(&first))
+	suite.NotZero(first.ID)
+
+	// ชน unique index ของ collection เริ่มต้น จึงไม่สร้างแถวใหม่และไม่ error
+	second := model.Collection{UserID: demoUserID, Name: model.DefaultCollectionName, IsDefault: true}
+	suite.NoError(suite.repository.CreateDefault(&second))
+	suite.Zero(second.ID)
+
+	result, err := suite.repository.GetDefault(demoUserID)
+	suite.NoError(err)
+	suite.Equal(first.ID, result.ID)
+
+	collections, err := suite.repository.Get(demoUserID)
+	suite.NoError(err)
+	suite.Len(collections, 1)
+}
+
+func TestRepositoryCreateDefault(t *testing.T) {
+	suite.Run(t, new(RepositoryCreateDefaultTestSuite))
+}
+
+// Extend
+type RepositoryOrderTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryOrderTestSuite) TestAppendAndReorder() {
+	weeknight := model.Collection{UserID: secondUserID, Name: "Weeknight"}
+	suite.NoError(suite.repository.Create(&weeknight))
+	party := model.Collection{UserID: secondUserID, Name: "Party"}
+	suite.NoError(suite.repository.Create(&party))
+	brunch := model.Collection{UserID: secondUserID, Name: "Brunch"}
+	suite.NoError(suite.repository.Create(&brunch))
+
+	suite.Equal(1, weeknight.Position)
+	suite.Equal(3, brunch.Position)
+
+	// collection ที่ไม่ได้ส่งมาต่อท้ายตามลำดับเดิม
+	suite.NoError(suite.repository.Reorder(secondUserID, []uint{brunch.ID}))
+
+	collections, err := suite.repository.Get(secondUserID)
+	suite.NoError(err)
+	suite.Len(collections, 3)
+	suite.Equal([]uint{brunch.ID, weeknight.ID, party.ID}, []uint{collections[0].ID, collections[1].ID, collections[2].ID})
+
+	found, err := suite.repository.GetByName(secondUserID, "PARTY")
+	suite.NoError(err)
+	suite.Equal(party.ID, found.ID)
+}
+
+func TestRepositoryOrder(t *testing.T) {
+	suite.Run(t, new(RepositoryOrderTestSuite))
+}
+
+// Extend
+type RepositoryRecipeTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryRecipeTestSuite) TestSyncFavoriteWithDefault() {
+	favorites := model.Collection{UserID: demoUserID, Name: model.DefaultCollectionName, IsDefault: true}
+	suite.NoError(suite.repository.CreateDefault(&favorites))
+
+	// เพิ่มซ้ำได้โดยไม่ error
+	suite.NoError(suite.repository.AddRecipe(favorites, 1))
+	suite.NoError(suite.repository.AddRecipe(favorites, 1))
+
+	result, err := suite.repository.GetByID(int(favorites.ID))
+	suite.NoError(err)
+	suite.Equal(int64(1), result.RecipeCount)
+
+	recipes, err := suite.repository.GetRecipes(favorites.ID, demoUserID)
+	suite.NoError(err)
+	suite.Len(recipes, 1)
+	suite.Equal(uint(1), recipes[0].ID)
+
+	var count int64
+	suite.NoError(suite.db.Model(&model.Favorite{}).Where("user_id = ? AND food_recipe_id = 1", demoUserID).Count(&count).Error)
+	suite.Equal(int64(1), count)
+
+	suite.NoError(suite.repository.RemoveRecipe(favorites, 1))
+
+	suite.NoError(suite.db.Model(&model.Favorite{}).Where("user_id = ? AND food_recipe_id = 1", demoUserID).Count(&count).Error)
+	suite.Zero(count)
+}
+
+func (suite *RepositoryRecipeTestSuite) TestKeepFavoriteForOtherCollection() {
+	weeknight := model.Collection{UserID: secondUserID, Name: "Weeknight"}
+	suite.NoError(suite.repository.Create(&weeknight))
+
+	suite.NoError(suite.repository.AddRecipe(weeknight, 1))
+
+	var count int64
+	suite.NoError(suite.db.Model(&model.Favorite{}).Where("user_id = ?", secondUserID).Count(&count).Error)
+	suite.Zero(count)
+
+	suite.NoError(suite.repository.Delete(weeknight.ID))
+
+	_, err := suite.repository.GetByID(int(weeknight.ID))
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func TestRepositoryRecipe(t *testing.T) {
+	suite.Run(t, new(RepositoryRecipeTestSuite))
+}
@@ -0,0 +1,290 @@
+package collection
+
+import (
+	"strings"
+	"wongnok/internal/foodrecipe"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+	"wongnok/internal/user"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IUserService user.IService
+type IFoodRecipeService foodrecipe.IService
+
+type IService interface {
+	Get(claims model.Claims) (model.Collections, error)
+	GetByID(id int, claims model.Claims) (model.Collection, model.FoodRecipes, error)
+	Create(request dto.CollectionRequest, claims model.Claims) (model.Collection, error)
+	Update(request dto.CollectionRequest, id int, claims model.Claims) (model.Collection, error)
+	Delete(id int, claims model.Claims) error
+	Reorder(request dto.CollectionOrderRequest, claims model.Claims) (model.Collections, error)
+	AddRecipe(request dto.CollectionItemRequest, id int, claims model.Claims) (model.Collection, error)
+	RemoveRecipe(id int, recipeID int, claims model.Claims) (model.Collection, error)
+	AddToDefault(recipeID int, claims model.Claims) error
+	RemoveFromDefault(recipeID int, claims model.Claims) error
+}
+
+type Service struct {
+	Repository        IRepository
+	UserService       IUserService
+	FoodRecipeService IFoodRecipeService
+}
+
+func NewService(db *gorm.DB) IService {
+	return &Service{
+		Repository:        NewRepository(db),
+		UserService:       user.NewService(db),
+		FoodRecipeService: foodrecipe.NewService(db),
+	}
+}
+
+// Get collection ทั้งหมดของผู้ใช้ที่ login ตามลำดับที่จัดไว้ (มี collection เริ่มต้นเสมอ)
+func (service Service) Get(claims model.Claims) (model.Collections, error) {
+	if _, err := service.defaultCollection(claims); err != nil {
+		return nil, err
+	}
+
+	return service.Repository.Get(claims.ID)
+}
+
+// GetByID collection พร้อมสูตรข้างใน collection ส่วนตัวของคนอื่นถือว่าไม่พบ
+func (service Service) GetByID(id int, claims model.Claims) (model.Collection, model.FoodRecipes, error) {
+	collection, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.Collection{}, nil, errors.Wrap(err, "find collection")
+	}
+
+	if !collection.IsVisibleTo(claims.ID) {
+		return model.Collection{}, nil, errors.Wrap(gorm.ErrRecordNotFound, "find collection")
+	}
+
+	recipes, err := service.Repository.GetRecipes(collection.ID, claims.ID)
+	if err != nil {
+		return model.Collection{}, nil, errors.Wrap(err, "get collection recipes")
+	}
+
+	return collection, recipes.CalculateAverageRatings(), nil
+}
+
+func (service Service) Create(request dto.CollectionRequest, claims model.Claims) (model.Collection, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Collection{}, errors.Wrap(err, "request invalid")
+	}
+
+	user, err := service.UserService.GetByID(claims)
+	if err != nil {
+		return model.Collection{}, errors.Wrap(err, "create collection")
+	}
+
+	collection := model.Collection{UserID: user.ID}
+	collection = collection.FromRequest(request)
+
+	if err := service.ensureUniqueName(collection); err != nil {
+		return model.Collection{}, err
+	}
+
+	if err := service.Repository.Create(&collection); err != nil {
+		return model.Collection{}, errors.Wrap(err, "create collection")
+	}
+
+	return collection, nil
+}
+
+// Update เปลี่ยนชื่อหรือการเปิดเป็นสาธารณะ (เฉพาะเจ้าของ)
+func (service Service) Update(request dto.CollectionRequest, id int, claims model.Claims) (model.Collection, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Collection{}, errors.Wrap(err, "request invalid")
+	}
+
+	collection, err := service.owned(id, claims)
+	if err != nil {
+		return model.Collection{}, err
+	}
+
+	collection = collection.FromRequest(request)
+
+	if err := service.ensureUniqueName(collection); err != nil {
+		return model.Collection{}, err
+	}
+
+	if err := service.Repository.Update(&collection); err != nil {
+		return model.Collection{}, errors.Wrap(err, "update collection")
+	}
+
+	return collection, nil
+}
+
+// Delete ลบ collection ของตัวเอง ยกเว้น collection เริ่มต้นที่ผูกกับ favorite
+func (service Service) Delete(id int, claims model.Claims) error {
+	collection, err := service.owned(id, claims)
+	if err != nil {
+		return err
+	}
+
+	if collection.IsDefault {
+		return errors.Wrap(global.ErrForbidden, "default collection cannot be deleted")
+	}
+
+	return service.Repository.Delete(collection.ID)
+}
+
+// Reorder จัดลำดับ collection ใหม่ ทุก id ต้องเป็นของผู้ใช้ที่ login
+func (service Service) Reorder(request dto.CollectionOrderRequest, claims model.Claims) (model.Collections, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return nil, errors.Wrap(err, "request invalid")
+	}
+
+	collections, err := service.Repository.Get(claims.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get collections")
+	}
+
+	owned := make(map[uint]bool, len(collections))
+	for _, collection := range collections {
+		owned[collection.ID] = true
+	}
+	for _, id := range request.IDs {
+		if !owned[id] {
+			return nil, errors.Wrapf(global.ErrForbidden, "collection %d", id)
+		}
+	}
+
+	if err := service.Repository.Reorder(claims.ID, request.IDs); err != nil {
+		return nil, errors.Wrap(err, "reorder collections")
+	}
+
+	return service.Repository.Get(claims.ID)
+}
+
+func (service Service) AddRecipe(request dto.CollectionItemRequest, id int, claims model.Claims) (model.Collection, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Collection{}, errors.Wrap(err, "request invalid")
+	}
+
+	collection, err := service.owned(id, claims)
+	if err != nil {
+		return model.Collection{}, err
+	}
+
+	if err := service.FoodRecipeService.Exists(int(request.FoodRecipeID)); err != nil {
+		return model.Collection{}, errors.Wrap(err, "find recipe")
+	}
+
+	if err := service.Repository.AddRecipe(collection, request.FoodRecipeID); err != nil {
+		return model.Collection{}, errors.Wrap(err, "add recipe to collection")
+	}
+
+	return service.Repository.GetByID(id)
+}
+
+func (service Service) RemoveRecipe(id int, recipeID int, claims model.Claims) (model.Collection, error) {
+	collection, err := service.owned(id, claims)
+	if err != nil {
+		return model.Collection{}, err
+	}
+
+	if err := service.Repository.RemoveRecipe(collection, uint(recipeID)); err != nil {
+		return model.Collection{}, errors.Wrap(err, "remove recipe from collection")
+	}
+
+	return service.Repository.GetByID(id)
+}
+
+// AddToDefault ใช้โดยปุ่ม favorite: เพิ่มสูตรเข้า collection เริ่มต้น (และ favorite สูตรนั้น)
+func (service Service) AddToDefault(recipeID int, claims model.Claims) error {
+	if err := service.FoodRecipeService.Exists(recipeID); err != nil {
+		return errors.Wrap(err, "find recipe")
+	}
+
+	collection, err := service.defaultCollection(claims)
+	if err != nil {
+		return err
+	}
+
+	return service.Repository.AddRecipe(collection, uint(recipeID))
+}
+
+// RemoveFromDefault ใช้โดยปุ่ม favorite: เอาสูตรออกจาก collection เริ่มต้น (และยกเลิก favorite)
+func (service Service) RemoveFromDefault(recipeID int, claims model.Claims) error {
+	collection, err := service.defaultCollection(claims)
+	if err != nil {
+		return err
+	}
+
+	return service.Repository.RemoveRecipe(collection, uint(recipeID))
+}
+
+// defaultCollection collection เริ่มต้นของผู้ใช้ ถ้ายังไม่มีจะสร้างให้
+func (service Service) defaultCollection(claims model.Claims) (model.Collection, error) {
+	collection, err := service.Repository.GetDefault(claims.ID)
+	if err == nil {
+		return collection, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return model.Collection{}, errors.Wrap(err, "find default collection")
+	}
+
+	user, err := service.UserService.GetByID(claims)
+	if err != nil {
+		return model.Collection{}, errors.Wrap(err, "create default collection")
+	}
+
+	collection = model.Collection{
+		UserID:    user.ID,
+		Name:      model.DefaultCollectionName,
+		IsDefault: true,
+	}
+	if err := service.Repository.CreateDefault(&collection); err != nil {
+		return model.Collection{}, errors.Wrap(err, "create default collection")
+	}
+
+	// อ่านใหม่เสมอ เผื่อ request อื่นสร้างไปก่อนแล้วแถวของเราถูกข้าม
+	collection, err = service.Repository.GetDefault(claims.ID)
+	if err != nil {
+		return model.Collection{}, errors.Wrap(err, "find default collection")
+	}
+
+	return collection, nil
+}
+
+// owned collection ที่ผู้ใช้ที่ login เป็นเจ้าของ
+func (service Service) owned(id int, claims model.Claims) (model.Collection, error) {
+	collection, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.Collection{}, errors.Wrap(err, "find collection")
+	}
+
+	if collection.UserID != claims.ID {
+		return model.Collection{}, global.ErrForbidden
+	}
+
+	return collection, nil
+}
+
+// ชื่อ collection ต้องไม่ซ้ำกันในผู้ใช้คนเดียวกัน
+// และชื่อของ collection เริ่มต้นใช้ได้กับ collection เริ่มต้นเท่านั้น แม้ผู้ใช้ยังไม่เคยกด favorite
+func (service Service) ensureUniqueName(collection model.Collection) error {
+	if !collection.IsDefault && strings.EqualFold(collection.Name, model.DefaultCollectionName) {
+		return errors.Wrapf(global.ErrConflict, "collection name %q is reserved", model.DefaultCollectionName)
+	}
+
+	existing, err := service.Repository.GetByName(collection.UserID, collection.Name)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return errors.Wrap(err, "find collection")
+	}
+
+	if err == nil && existing.ID != collection.ID {
+		return errors.Wrapf(global.ErrConflict, "collection %q already exists", collection.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,371 @@
+package collection_test
+
+import (
+	"reflect"
+	"testing"
+	"wongnok/internal/collection"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewService(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		service := collection.NewService(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(service))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+type ServiceTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service           collection.IService
+	repo              *MockIRepository
+	userService       *MockIUserService
+	foodRecipeService *MockIFoodRecipeService
+
+	// Mock data
+	claims     model.Claims
+	favorites  model.Collection
+	weeknight  model.Collection
+	errExists  error
+	respByName model.Collection
+	errByName  error
+}
+
+// This will run before each test
+func (suite *ServiceTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.userService = new(MockIUserService)
+	suite.foodRecipeService = new(MockIFoodRecipeService)
+	suite.service = &collection.Service{
+		Repository:        suite.repo,
+		UserService:       suite.userService,
+		FoodRecipeService: suite.foodRecipeService,
+	}
+
+	suite.claims = model.Claims{ID: "UID"}
+	suite.favorites = model.Collection{Model: gorm.Model{ID: 1}, UserID: "UID", Name: model.DefaultCollectionName, Position: 1, IsDefault: true}
+	suite.weeknight = model.Collection{Model: gorm.Model{ID: 2}, UserID: "UID", Name: "Weeknight", Position: 2}
+	suite.errExists = nil
+	suite.respByName = model.Collection{}
+	suite.errByName = gorm.ErrRecordNotFound
+
+	suite.userService.On("GetByID", mock.Anything).Return(model.User{ID: "UID"}, nil)
+	suite.foodRecipeService.On("Exists", mock.Anything).Return(func(int) error {
+		return suite.errExists
+	})
+	suite.repo.On("GetByID", 1).Return(func(int) (model.Collection, error) {
+		return suite.favorites, nil
+	})
+	suite.repo.On("GetByID", 2).Return(func(int) (model.Collection, error) {
+		return suite.weeknight, nil
+	})
+	suite.repo.On("GetByID", 99).Return(model.Collection{}, gorm.ErrRecordNotFound)
+	suite.repo.On("GetByName", mock.Anything, mock.Anything).Return(func(string, string) (model.Collection, error) {
+		return suite.respByName, suite.errByName
+	})
+	suite.repo.On("Get", "UID").Return(func(string) (model.Collections, error) {
+		return model.Collections{suite.favorites, suite.weeknight}, nil
+	})
+	suite.repo.On("Create", mock.Anything).Return(nil)
+	suite.repo.On("Update", mock.Anything).Return(nil)
+	suite.repo.On("Delete", mock.Anything).Return(nil)
+	suite.repo.On("Reorder", mock.Anything, mock.Anything).Return(nil)
+	suite.repo.On("AddRecipe", mock.Anything, mock.Anything).Return(nil)
+	suite.repo.On("RemoveRecipe", mock.Anything, mock.Anything).Return(nil)
+	suite.repo.On("GetRecipes", mock.Anything, mock.Anything).Return(model.FoodRecipes{}, nil)
+}
+
+// Extend
+type ServiceDefaultCollectionTestSuite struct {
+	ServiceTestSuite
+}
+
+func (suite *ServiceDefaultCollectionTestSuite) TestCreateDefaultWhenMissing() {
+	suite.repo.On("GetDefault", "UID").Return(model.Collection{}, gorm.ErrRecordNotFound).Once()
+	suite.repo.On("GetDefault", "UID").Return(suite.favorites, nil)
+	// ตรวจตอนเรียก เพราะหลังจากนั้น service อ่านค่าใหม่ทับตัวแปรเดิม
+	suite.repo.On("CreateDefault", mock.MatchedBy(func(created *model.Collection) bool {
+		return *created == model.Collection{UserID: "UID", Name: model.DefaultCollectionName, IsDefault: true}
+	})).Return(nil)
+
+	collections, err := suite.service.Get(suite.claims)
+	suite.NoError(err)
+
+	suite.Len(collections, 2)
+	suite.repo.AssertNumberOfCalls(suite.T(), "CreateDefault", 1)
+}
+
+func (suite *ServiceDefaultCollectionTestSuite) TestReselectWhenCreatedConcurrently() {
+	// request อื่นสร้างไปก่อน แถวของเราถูกข้าม (ON CONFLICT DO NOTHING) จึงไม่ได้ id กลับมา
+	suite.repo.On("GetDefault", "UID").Return(model.Collection{}, gorm.ErrRecordNotFound).Once()
+	suite.repo.On("GetDefault", "UID").Return(suite.favorites, nil)
+	suite.repo.On("CreateDefault", mock.Anything).Return(nil)
+
+	err := suite.service.AddToDefault(10, suite.claims)
+	suite.NoError(err)
+
+	suite.repo.AssertNumberOfCalls(suite.T(), "GetDefault", 2)
+	suite.repo.AssertCalled(suite.T(), "AddRecipe", suite.favorites, uint(10))
+}
+
+func (suite *ServiceDefaultCollectionTestSuite) TestSkipCreateWhenExists() {
+	suite.repo.On("GetDefault", "UID").Return(suite.favorites, nil)
+
+	err := suite.service.RemoveFromDefault(10, suite.claims)
+	suite.NoError(err)
+
+	suite.repo.AssertNotCalled(suite.T(), "CreateDefault", mock.Anything)
+	suite.repo.AssertCalled(suite.T(), "RemoveRecipe", suite.favorites, uint(10))
+}
+
+func (suite *ServiceDefaultCollectionTestSuite) TestErrorWhenCreateDefault() {
+	suite.repo.On("GetDefault", "UID").Return(model.Collection{}, gorm.ErrRecordNotFound)
+	suite.repo.On("CreateDefault", mock.Anything).Return(assert.AnError)
+
+	_, err := suite.service.Get(suite.claims)
+	suite.ErrorIs(err, assert.AnError)
+
+	suite.repo.AssertNotCalled(suite.T(), "Get", mock.Anything)
+}
+
+func (suite *ServiceDefaultCollectionTestSuite) TestErrorWhenAddMissingRecipeToDefault() {
+	suite.errExists = gorm.ErrRecordNotFound
+
+	err := suite.service.AddToDefault(99, suite.claims)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "AddRecipe", mock.Anything, mock.Anything)
+}
+
+func TestServiceDefaultCollection(t *testing.T) {
+	suite.Run(t, new(ServiceDefaultCollectionTestSuite))
+}
+
+// Extend
+type ServiceGetByIDTestSuite struct {
+	ServiceTestSuite
+}
+
+func (suite *ServiceGetByIDTestSuite) TestReturnOwnPrivateCollection() {
+	result, recipes, err := suite.service.GetByID(2, suite.claims)
+	suite.NoError(err)
+
+	suite.Equal(suite.weeknight, result)
+	suite.NotNil(recipes)
+	suite.repo.AssertCalled(suite.T(), "GetRecipes", uint(2), "UID")
+}
+
+func (suite *ServiceGetByIDTestSuite) TestReturnPublicCollectionToOthers() {
+	suite.weeknight.IsPublic = true
+
+	_, _, err := suite.service.GetByID(2, model.Claims{})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "GetRecipes", uint(2), "")
+}
+
+func (suite *ServiceGetByIDTestSuite) TestHidePrivateCollectionFromOthers() {
+	_, _, err := suite.service.GetByID(2, model.Claims{ID: "OTHER"})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "GetRecipes", mock.Anything, mock.Anything)
+}
+
+func TestServiceGetByID(t *testing.T) {
+	suite.Run(t, new(ServiceGetByIDTestSuite))
+}
+
+// Extend
+type ServiceCreateTestSuite struct {
+	ServiceTestSuite
+}
+
+func (suite *ServiceCreateTestSuite) TestCreateCollection() {
+	result, err := suite.service.Create(dto.CollectionRequest{Name: " Party ", IsPublic: true}, suite.claims)
+	suite.NoError(err)
+
+	suite.Equal(model.Collection{UserID: "UID", Name: "Party", IsPublic: true}, result)
+	suite.repo.AssertCalled(suite.T(), "GetByName", "UID", "Party")
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenNameReserved() {
+	// ผู้ใช้ยังไม่เคยกด favorite ก็ตั้งชื่อนี้ไม่ได้
+	_, err := suite.service.Create(dto.CollectionRequest{Name: "favorites"}, suite.claims)
+	suite.ErrorIs(err, global.ErrConflict)
+
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenNameExists() {
+	suite.respByName = suite.weeknight
+	suite.errByName = nil
+
+	_, err := suite.service.Create(dto.CollectionRequest{Name: "weeknight"}, suite.claims)
+	suite.ErrorIs(err, global.ErrConflict)
+
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenRequestInvalid() {
+	_, err := suite.service.Create(dto.CollectionRequest{}, suite.claims)
+	suite.ErrorAs(err, &validator.ValidationErrors{})
+}
+
+func TestServiceCreate(t *testing.T) {
+	suite.Run(t, new(ServiceCreateTestSuite))
+}
+
+// Extend
+type ServiceUpdateTestSuite struct {
+	ServiceTestSuite
+}
+
+func (suite *ServiceUpdateTestSuite) TestKeepOwnName() {
+	suite.respByName = suite.weeknight
+	suite.errByName = nil
+
+	result, err := suite.service.Update(dto.CollectionRequest{Name: "Weeknight", IsPublic: true}, 2, suite.claims)
+	suite.NoError(err)
+
+	suite.True(result.IsPublic)
+}
+
+func (suite *ServiceUpdateTestSuite) TestKeepDefaultName() {
+	result, err := suite.service.Update(dto.CollectionRequest{Name: model.DefaultCollectionName, IsPublic: true}, 1, suite.claims)
+	suite.NoError(err)
+
+	suite.True(result.IsDefault)
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorWhenRenameToReserved() {
+	_, err := suite.service.Update(dto.CollectionRequest{Name: "FAVORITES"}, 2, suite.claims)
+	suite.ErrorIs(err, global.ErrConflict)
+
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorWhenNotOwner() {
+	_, err := suite.service.Update(dto.CollectionRequest{Name: "Mine"}, 2, model.Claims{ID: "OTHER"})
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorWhenNotFound() {
+	_, err := suite.service.Update(dto.CollectionRequest{Name: "Mine"}, 99, suite.claims)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func TestServiceUpdate(t *testing.T) {
+	suite.Run(t, new(ServiceUpdateTestSuite))
+}
+
+// Extend
+type ServiceDeleteTestSuite struct {
+	ServiceTestSuite
+}
+
+func (suite *ServiceDeleteTestSuite) TestDeleteOwnCollection() {
+	err := suite.service.Delete(2, suite.claims)
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "Delete", uint(2))
+}
+
+func (suite *ServiceDeleteTestSuite) TestErrorWhenDeleteDefault() {
+	err := suite.service.Delete(1, suite.claims)
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
+
+func (suite *ServiceDeleteTestSuite) TestErrorWhenNotOwner() {
+	err := suite.service.Delete(2, model.Claims{ID: "OTHER"})
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
+
+func TestServiceDelete(t *testing.T) {
+	suite.Run(t, new(ServiceDeleteTestSuite))
+}
+
+// Extend
+type ServiceReorderTestSuite struct {
+	ServiceTestSuite
+}
+
+func (suite *ServiceReorderTestSuite) TestReorderOwnCollections() {
+	_, err := suite.service.Reorder(dto.CollectionOrderRequest{IDs: []uint{2, 1}}, suite.claims)
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "Reorder", "UID", []uint{2, 1})
+}
+
+func (suite *ServiceReorderTestSuite) TestErrorWhenContainOtherCollection() {
+	_, err := suite.service.Reorder(dto.CollectionOrderRequest{IDs: []uint{2, 7}}, suite.claims)
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "Reorder", mock.Anything, mock.Anything)
+}
+
+func TestServiceReorder(t *testing.T) {
+	suite.Run(t, new(ServiceReorderTestSuite))
+}
+
+// Extend
+type ServiceRecipeTestSuite struct {
+	ServiceTestSuite
+}
+
+func (suite *ServiceRecipeTestSuite) TestAddRecipe() {
+	_, err := suite.service.AddRecipe(dto.CollectionItemRequest{FoodRecipeID: 10}, 2, suite.claims)
+	suite.NoError(err)
+
+	suite.foodRecipeService.AssertCalled(suite.T(), "Exists", 10)
+	suite.repo.AssertCalled(suite.T(), "AddRecipe", suite.weeknight, uint(10))
+}
+
+func (suite *ServiceRecipeTestSuite) TestErrorWhenAddMissingRecipe() {
+	suite.errExists = gorm.ErrRecordNotFound
+
+	_, err := suite.service.AddRecipe(dto.CollectionItemRequest{FoodRecipeID: 99}, 2, suite.claims)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "AddRecipe", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceRecipeTestSuite) TestErrorWhenAddToOtherCollection() {
+	_, err := suite.service.AddRecipe(dto.CollectionItemRequest{FoodRecipeID: 10}, 2, model.Claims{ID: "OTHER"})
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.foodRecipeService.AssertNotCalled(suite.T(), "Exists", mock.Anything)
+}
+
+func (suite *ServiceRecipeTestSuite) TestErrorWhenRemoveFromOtherCollection() {
+	_, err := suite.service.RemoveRecipe(2, 10, model.Claims{ID: "OTHER"})
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "RemoveRecipe", mock.Anything, mock.Anything)
+}
+
+func TestServiceRecipe(t *testing.T) {
+	suite.Run(t, new(ServiceRecipeTestSuite))
+}
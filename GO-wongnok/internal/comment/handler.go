@@ -0,0 +1,206 @@
+package comment
+
+import (
+	"net/http"
+	"strconv"
+	"wongnok/internal/global"
+	"wongnok/internal/helper"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IHandler interface {
+	Get(ctx *gin.Context)
+	Create(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+}
+
+type Handler struct {
+	Service IService
+}
+
+func NewHandler(db *gorm.DB) *Handler {
+	return &Handler{
+		Service: NewService(db),
+	}
+}
+
+// Get godoc
+// @Summary Get comments of a food recipe
+// @Description Get top-level comments (newest first) with their replies, page by page
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path int true "Food Recipe ID"
+// @Param page query int false "Page number" (default 1)
+// @Param limit query int false "Top-level comments per page (max 50)" (default 20)
+// @Success 200 {object} dto.CommentsResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/food-recipes/{id}/comments [get]
+func (handler Handler) Get(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid food recipe id"})
+		return
+	}
+
+	var query model.CommentQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	comments, total, err := handler.Service.Get(id, query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	response := comments.ToResponse()
+	response.Total = total
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Create godoc
+// @Summary Comment on a food recipe
+// @Description Add a comment, or reply to a top-level comment by giving parentID
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path int true "Food Recipe ID"
+// @Param comment body dto.CommentRequest true "Comment data"
+// @Success 201 {object} dto.CommentResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/food-recipes/{id}/comments [post]
+func (handler Handler) Create(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid food recipe id"})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var request dto.CommentRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	comment, err := handler.Service.Create(request, id, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, comment.ToResponse())
+}
+
+// Update godoc
+// @Summary Edit a comment
+// @Description Edit the text of your own comment
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path int true "Comment ID"
+// @Param comment body dto.CommentRequest true "Comment data"
+// @Success 200 {object} dto.CommentResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/comments/{id} [put]
+func (handler Handler) Update(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid comment id"})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var request dto.CommentRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	comment, err := handler.Service.Update(request, id, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, comment.ToResponse())
+}
+
+// Delete godoc
+// @Summary Delete a comment
+// @Description Delete your own comment, replies under it stay visible and it is shown as "[deleted]"
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path int true "Comment ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/comments/{id} [delete]
+func (handler Handler) Delete(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid comment id"})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	if err := handler.Service.Delete(id, claims); err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Comment deleted successfully"})
+}
+
+func statusCode(err error) int {
+	switch {
+	case errors.As(err, &validator.ValidationErrors{}), errors.Is(err, global.ErrInvalidParent):
+		return http.StatusBadRequest
+	case errors.Is(err, global.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,281 @@
+package comment_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"wongnok/internal/comment"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewHandler(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		handler := comment.NewHandler(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(handler))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler comment.IHandler
+	service *MockIService
+
+	// Helper
+	server func(method string, url string, payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+// This will run once before all tests in the suite
+func (suite *HandlerTestSuite) SetupSuite() {
+	// Gin testing mode
+	gin.SetMode(gin.TestMode)
+}
+
+// This will run before each test
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = comment.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(method string, url string, payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder {
+		// Create router
+		router := gin.Default()
+
+		// Set context
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+
+		router.GET("/api/v1/food-recipes/:id/comments", suite.handler.Get)
+		router.POST("/api/v1/food-recipes/:id/comments", suite.handler.Create)
+		router.PUT("/api/v1/comments/:id", suite.handler.Update)
+		router.DELETE("/api/v1/comments/:id", suite.handler.Delete)
+
+		recorder := httptest.NewRecorder()
+
+		// Create request
+		request, err := http.NewRequest(method, url, payload)
+		suite.NoError(err)
+
+		// Start testing server
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+}
+
+// Extend
+type HandlerGetTestSuite struct {
+	HandlerTestSuite
+}
+
+func (suite *HandlerGetTestSuite) TestResponseCommentsWithTotal() {
+	comments := model.Comments{{Model: gorm.Model{ID: 1}, FoodRecipeID: 1, Body: "อร่อย"}}
+	suite.service.On("Get", 1, model.CommentQuery{Page: 2, Limit: 5}).Return(comments, int64(6), nil)
+
+	response := suite.server(http.MethodGet, "/api/v1/food-recipes/1/comments?page=2&limit=5", nil, nil)
+
+	expected := comments.ToResponse()
+	expected.Total = 6
+	expectedJson, _ := json.Marshal(expected)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+}
+
+func (suite *HandlerGetTestSuite) TestErrorWhenLimitTooLarge() {
+	response := suite.server(http.MethodGet, "/api/v1/food-recipes/1/comments?limit=51", nil, nil)
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Get", mock.Anything, mock.Anything)
+}
+
+func TestHandlerGet(t *testing.T) {
+	suite.Run(t, new(HandlerGetTestSuite))
+}
+
+// Extend
+type HandlerCreateTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	respServiceCreate model.Comment
+	errServiceCreate  error
+}
+
+// This will run before each test
+func (suite *HandlerCreateTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.respServiceCreate = model.Comment{Model: gorm.Model{ID: 1}, FoodRecipeID: 1, Body: "อร่อย"}
+	suite.errServiceCreate = nil
+
+	suite.service.On("Create", mock.Anything, mock.Anything, mock.Anything).Return(func(dto.CommentRequest, int, model.Claims) (model.Comment, error) {
+		return suite.respServiceCreate, suite.errServiceCreate
+	})
+}
+
+func (suite *HandlerCreateTestSuite) TestResponseCommentWithStatusCode201() {
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(http.MethodPost, "/api/v1/food-recipes/1/comments", strings.NewReader(`{"body": "อร่อย", "parentID": 5}`), &claims)
+
+	expectedJson, _ := json.Marshal(suite.respServiceCreate.ToResponse())
+
+	suite.Equal(http.StatusCreated, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Create", dto.CommentRequest{Body: "อร่อย", ParentID: parentID(5)}, 1, claims)
+}
+
+func (suite *HandlerCreateTestSuite) TestErrorWhenNoClaims() {
+	response := suite.server(http.MethodPost, "/api/v1/food-recipes/1/comments", strings.NewReader(`{"body": "อร่อย"}`), nil)
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerCreateTestSuite) TestErrorWhenRecipeNotFound() {
+	suite.errServiceCreate = gorm.ErrRecordNotFound
+
+	response := suite.server(http.MethodPost, "/api/v1/food-recipes/99/comments", strings.NewReader(`{"body": "อร่อย"}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerCreateTestSuite) TestErrorWhenParentInvalid() {
+	suite.errServiceCreate = global.ErrInvalidParent
+
+	response := suite.server(http.MethodPost, "/api/v1/food-recipes/1/comments", strings.NewReader(`{"body": "อร่อย", "parentID": 5}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+}
+
+func (suite *HandlerCreateTestSuite) TestErrorWhenRecipeIDInvalid() {
+	response := suite.server(http.MethodPost, "/api/v1/food-recipes/abc/comments", strings.NewReader(`{"body": "อร่อย"}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.Equal(`{"message":"invalid food recipe id"}`, response.Body.String())
+}
+
+func TestHandlerCreate(t *testing.T) {
+	suite.Run(t, new(HandlerCreateTestSuite))
+}
+
+// Extend
+type HandlerUpdateTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	errServiceUpdate error
+}
+
+// This will run before each test
+func (suite *HandlerUpdateTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.errServiceUpdate = nil
+
+	suite.service.On("Update", mock.Anything, mock.Anything, mock.Anything).Return(func(request dto.CommentRequest, id int, claims model.Claims) (model.Comment, error) {
+		return model.Comment{Model: gorm.Model{ID: uint(id)}, Body: request.Body}, suite.errServiceUpdate
+	})
+}
+
+func (suite *HandlerUpdateTestSuite) TestResponseCommentWithStatusCode200() {
+	response := suite.server(http.MethodPut, "/api/v1/comments/5", strings.NewReader(`{"body": "อร่อยมาก"}`), &model.Claims{ID: "UID"})
+
+	expectedJson, _ := json.Marshal(model.Comment{Model: gorm.Model{ID: 5}, Body: "อร่อยมาก"}.ToResponse())
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+}
+
+func (suite *HandlerUpdateTestSuite) TestErrorWhenNotAuthor() {
+	suite.errServiceUpdate = global.ErrForbidden
+
+	response := suite.server(http.MethodPut, "/api/v1/comments/5", strings.NewReader(`{"body": "อร่อยมาก"}`), &model.Claims{ID: "OTHER"})
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func (suite *HandlerUpdateTestSuite) TestErrorWhenNotFound() {
+	suite.errServiceUpdate = gorm.ErrRecordNotFound
+
+	response := suite.server(http.MethodPut, "/api/v1/comments/5", strings.NewReader(`{"body": "อร่อยมาก"}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func TestHandlerUpdate(t *testing.T) {
+	suite.Run(t, new(HandlerUpdateTestSuite))
+}
+
+// Extend
+type HandlerDeleteTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	errServiceDelete error
+}
+
+// This will run before each test
+func (suite *HandlerDeleteTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.errServiceDelete = nil
+
+	suite.service.On("Delete", mock.Anything, mock.Anything).Return(func(int, model.Claims) error {
+		return suite.errServiceDelete
+	})
+}
+
+func (suite *HandlerDeleteTestSuite) TestResponseMessageWithStatusCode200() {
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(http.MethodDelete, "/api/v1/comments/5", nil, &claims)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(`{"message":"Comment deleted successfully"}`, response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Delete", 5, claims)
+}
+
+func (suite *HandlerDeleteTestSuite) TestErrorWhenNotAuthor() {
+	suite.errServiceDelete = global.ErrForbidden
+
+	response := suite.server(http.MethodDelete, "/api/v1/comments/5", nil, &model.Claims{ID: "OTHER"})
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func (suite *HandlerDeleteTestSuite) TestErrorWhenServiceFailed() {
+	suite.errServiceDelete = assert.AnError
+
+	response := suite.server(http.MethodDelete, "/api/v1/comments/5", nil, &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+}
+
+func TestHandlerDelete(t *testing.T) {
+	suite.Run(t, new(HandlerDeleteTestSuite))
+}
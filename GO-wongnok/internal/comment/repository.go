@@ -0,0 +1,89 @@
+package comment
+
+import (
+	"wongnok/internal/model"
+
+	"gorm.io/gorm"
+)
+
+type IRepository interface {
+	Get(recipeID int, query model.CommentQuery) (model.Comments, error)
+	Count(recipeID int) (int64, error)
+	GetByID(id int) (model.Comment, error)
+	Create(comment *model.Comment) error
+	Update(comment *model.Comment) error
+	Delete(id uint) error
+}
+
+// ความคิดเห็นระดับบนสุดที่ยังแสดงอยู่: ยังไม่ถูกลบ หรือถูกลบแล้วแต่ยังมีคนตอบกลับอยู่
+const visibleTopLevel = "comments.food_recipe_id = ? AND comments.parent_id IS NULL AND (comments.deleted_at IS NULL OR EXISTS (SELECT 1 FROM comments AS replies WHERE replies.parent_id = comments.id AND replies.deleted_at IS NULL))"
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+// Get ดึงความคิดเห็นระดับบนสุดทีละหน้า (ใหม่สุดก่อน) พร้อม reply ทั้งหมด (เก่าสุดก่อน)
+// ใช้ Unscoped เพื่อให้ความคิดเห็นที่ถูกลบแต่มี reply ยังอยู่ในรายการ (แสดงเป็น [deleted])
+func (repo Repository) Get(recipeID int, query model.CommentQuery) (model.Comments, error) {
+	var comments = make(model.Comments, 0)
+	offset := (query.Page - 1) * query.Limit
+
+	if err := repo.DB.Unscoped().
+		Preload("User").
+		Preload("Replies", func(db *gorm.DB) *gorm.DB {
+			return db.Where("comments.deleted_at IS NULL").Order("comments.created_at asc, comments.id asc")
+		}).
+		Preload("Replies.User").
+		Where(visibleTopLevel, recipeID).
+		Order("comments.created_at desc, comments.id desc").
+		Limit(query.Limit).
+		Offset(offset).
+		Find(&comments).Error; err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+func (repo Repository) Count(recipeID int) (int64, error) {
+	var count int64
+
+	if err := repo.DB.Unscoped().Model(&model.Comment{}).Where(visibleTopLevel, recipeID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (repo Repository) GetByID(id int) (model.Comment, error) {
+	var comment model.Comment
+
+	if err := repo.DB.Preload("User").First(&comment, id).Error; err != nil {
+		return model.Comment{}, err
+	}
+
+	return comment, nil
+}
+
+func (repo Repository) Create(comment *model.Comment) error {
+	if err := repo.DB.Create(comment).Error; err != nil {
+		return err
+	}
+
+	return repo.DB.Preload("User").First(comment, comment.ID).Error
+}
+
+func (repo Repository) Update(comment *model.Comment) error {
+	return repo.DB.Model(comment).Select("body").Updates(comment).Error
+}
+
+// Delete ลบแบบ soft delete เพื่อให้ reply ที่อยู่ใต้ความคิดเห็นนี้ยังแสดงได้
+func (repo Repository) Delete(id uint) error {
+	return repo.DB.Delete(&model.Comment{}, id).Error
+}
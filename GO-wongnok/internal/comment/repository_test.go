@@ -0,0 +1,192 @@
+package comment_test
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+	"wongnok/internal/comment"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	driver "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestNewRepository(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		repo := comment.NewRepository(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(repo))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+type RepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	container  *postgres.PostgresContainer
+	db         *gorm.DB
+	repository comment.IRepository
+}
+
+// This will run once before all tests in the suite
+func (suite *RepositoryTestSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	container, err := postgres.Run(
+		suite.ctx,
+		"postgres:17-alpine",
+		postgres.WithInitScripts(filepath.Join("../..", "tests", "init-db.sql")),
+		postgres.WithDatabase("wongnok-test"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").WithOccurrence(2).WithStartupTimeout(
+				(5 * time.Second),
+			),
+		),
+	)
+	suite.NoError(err)
+	suite.container = container
+}
+
+// This will run once after all tests in the suite
+func (suite *RepositoryTestSuite) TearDownSuite() {
+	err := suite.container.Terminate(suite.ctx)
+	suite.NoError(err)
+}
+
+// This will run before each test
+func (suite *RepositoryTestSuite) SetupTest() {
+	conn, err := suite.container.ConnectionString(suite.ctx, "sslmode=disable")
+	suite.NoError(err)
+
+	db, err := gorm.Open(driver.Open(conn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	suite.NoError(err)
+
+	suite.repository = &comment.Repository{
+		DB: db,
+	}
+
+	suite.db = db
+}
+
+// This will run after each test
+func (suite *RepositoryTestSuite) TearDownTest() {
+	sqldb, _ := suite.db.DB()
+	sqldb.Close()
+}
+
+
+const (
+	demoUserID   = "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"
+	secondUserID = "7c6d2f0a-5e3b-4c1d-9a8e-2f4b6d8c0e1a"
+)
+
+// Extend
+type RepositoryThreadTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryThreadTestSuite) TestReturnTopLevelWithReplies() {
+	parent := model.Comment{FoodRecipeID: 1, UserID: demoUserID, Body: "อร่อย"}
+	suite.NoError(suite.repository.Create(&parent))
+	suite.Equal("Demo", parent.User.FirstName)
+
+	reply := model.Comment{FoodRecipeID: 1, UserID: secondUserID, ParentID: &parent.ID, Body: "เห็นด้วย"}
+	suite.NoError(suite.repository.Create(&reply))
+
+	comments, err := suite.repository.Get(1, model.CommentQuery{Page: 1, Limit: 10})
+	suite.NoError(err)
+
+	// reply ไม่อยู่ในรายการระดับบนสุด แต่อยู่ใต้ความคิดเห็นที่ตอบ
+	suite.Len(comments, 1)
+	suite.Equal(parent.ID, comments[0].ID)
+	suite.Len(comments[0].Replies, 1)
+	suite.Equal("Second", comments[0].Replies[0].User.FirstName)
+
+	count, err := suite.repository.Count(1)
+	suite.NoError(err)
+	suite.Equal(int64(1), count)
+}
+
+func TestRepositoryThread(t *testing.T) {
+	suite.Run(t, new(RepositoryThreadTestSuite))
+}
+
+// Extend
+type RepositoryDeleteTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryDeleteTestSuite) TestKeepDeletedParentWithReplies() {
+	parent := model.Comment{FoodRecipeID: 1, UserID: demoUserID, Body: "อร่อย"}
+	suite.NoError(suite.repository.Create(&parent))
+	reply := model.Comment{FoodRecipeID: 1, UserID: secondUserID, ParentID: &parent.ID, Body: "เห็นด้วย"}
+	suite.NoError(suite.repository.Create(&reply))
+
+	// ความคิดเห็นที่ไม่มีคนตอบหายไปเลยเมื่อถูกลบ
+	lonely := model.Comment{FoodRecipeID: 1, UserID: demoUserID, Body: "ลองแล้ว"}
+	suite.NoError(suite.repository.Create(&lonely))
+
+	suite.NoError(suite.repository.Delete(parent.ID))
+	suite.NoError(suite.repository.Delete(lonely.ID))
+
+	comments, err := suite.repository.Get(1, model.CommentQuery{Page: 1, Limit: 10})
+	suite.NoError(err)
+
+	suite.Len(comments, 1)
+	suite.Equal(parent.ID, comments[0].ID)
+	suite.True(comments[0].DeletedAt.Valid)
+	suite.Len(comments[0].Replies, 1)
+
+	count, err := suite.repository.Count(1)
+	suite.NoError(err)
+	suite.Equal(int64(1), count)
+
+	_, err = suite.repository.GetByID(int(parent.ID))
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func TestRepositoryDelete(t *testing.T) {
+	suite.Run(t, new(RepositoryDeleteTestSuite))
+}
+
+// Extend
+type RepositoryUpdateTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryUpdateTestSuite) TestUpdateOnlyBody() {
+	created := model.Comment{FoodRecipeID: 1, UserID: demoUserID, Body: "อร่อย"}
+	suite.NoError(suite.repository.Create(&created))
+
+	created.Body = "อร่อยมาก"
+	created.UserID = secondUserID
+	suite.NoError(suite.repository.Update(&created))
+
+	result, err := suite.repository.GetByID(int(created.ID))
+	suite.NoError(err)
+
+	suite.Equal("อร่อยมาก", result.Body)
+	suite.Equal(demoUserID, result.UserID)
+}
+
+func TestRepositoryUpdate(t *testing.T) {
+	suite.Run(t, new(RepositoryUpdateTestSuite))
+}
@@ -0,0 +1,138 @@
+package comment
+
+import (
+	"wongnok/internal/foodrecipe"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+	"wongnok/internal/user"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IUserService user.IService
+type IFoodRecipeService foodrecipe.IService
+
+type IService interface {
+	Get(recipeID int, query model.CommentQuery) (model.Comments, int64, error)
+	Create(request dto.CommentRequest, recipeID int, claims model.Claims) (model.Comment, error)
+	Update(request dto.CommentRequest, id int, claims model.Claims) (model.Comment, error)
+	Delete(id int, claims model.Claims) error
+}
+
+type Service struct {
+	Repository        IRepository
+	UserService       IUserService
+	FoodRecipeService IFoodRecipeService
+}
+
+func NewService(db *gorm.DB) IService {
+	return &Service{
+		Repository:        NewRepository(db),
+		UserService:       user.NewService(db),
+		FoodRecipeService: foodrecipe.NewService(db),
+	}
+}
+
+func (service Service) Get(recipeID int, query model.CommentQuery) (model.Comments, int64, error) {
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.Limit == 0 {
+		query.Limit = model.DefaultCommentLimit
+	}
+
+	total, err := service.Repository.Count(recipeID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	comments, err := service.Repository.Get(recipeID, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
+
+// Create เพิ่มความคิดเห็น ถ้าระบุ ParentID จะเป็นการตอบกลับ
+// ตอบกลับได้เฉพาะความคิดเห็นระดับบนสุดของสูตรเดียวกัน
+func (service Service) Create(request dto.CommentRequest, recipeID int, claims model.Claims) (model.Comment, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Comment{}, errors.Wrap(err, "request invalid")
+	}
+
+	if err := service.FoodRecipeService.Exists(recipeID); err != nil {
+		return model.Comment{}, errors.Wrap(err, "find recipe")
+	}
+
+	if request.ParentID != nil {
+		parent, err := service.Repository.GetByID(int(*request.ParentID))
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return model.Comment{}, global.ErrInvalidParent
+			}
+			return model.Comment{}, errors.Wrap(err, "find parent comment")
+		}
+
+		if parent.FoodRecipeID != uint(recipeID) || parent.IsReply() {
+			return model.Comment{}, global.ErrInvalidParent
+		}
+	}
+
+	user, err := service.UserService.GetByID(claims)
+	if err != nil {
+		return model.Comment{}, errors.Wrap(err, "create comment")
+	}
+
+	comment := model.Comment{FoodRecipeID: uint(recipeID), UserID: user.ID}
+	comment = comment.FromRequest(request)
+
+	if err := service.Repository.Create(&comment); err != nil {
+		return model.Comment{}, errors.Wrap(err, "create comment")
+	}
+
+	return comment, nil
+}
+
+// Update แก้ข้อความได้เฉพาะผู้เขียน (ย้ายไปตอบความคิดเห็นอื่นไม่ได้)
+func (service Service) Update(request dto.CommentRequest, id int, claims model.Claims) (model.Comment, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Comment{}, errors.Wrap(err, "request invalid")
+	}
+
+	comment, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.Comment{}, errors.Wrap(err, "find comment")
+	}
+
+	if comment.UserID != claims.ID {
+		return model.Comment{}, global.ErrForbidden
+	}
+
+	comment.Body = comment.FromRequest(request).Body
+
+	if err := service.Repository.Update(&comment); err != nil {
+		return model.Comment{}, errors.Wrap(err, "update comment")
+	}
+
+	return comment, nil
+}
+
+// Delete ลบได้เฉพาะผู้เขียน reply ที่อยู่ใต้ความคิดเห็นนี้ยังแสดงอยู่
+func (service Service) Delete(id int, claims model.Claims) error {
+	comment, err := service.Repository.GetByID(id)
+	if err != nil {
+		return errors.Wrap(err, "find comment")
+	}
+
+	if comment.UserID != claims.ID {
+		return global.ErrForbidden
+	}
+
+	return service.Repository.Delete(comment.ID)
+}
@@ -0,0 +1,272 @@
+package comment_test
+
+import (
+	"reflect"
+	"testing"
+	"wongnok/internal/comment"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewService(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		service := comment.NewService(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(service))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+func parentID(id uint) *uint {
+	return &id
+}
+
+type ServiceGetTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service comment.IService
+	repo    *MockIRepository
+}
+
+// This will run before each test
+func (suite *ServiceGetTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &comment.Service{
+		Repository: suite.repo,
+	}
+
+	suite.repo.On("Count", 1).Return(int64(2), nil)
+	suite.repo.On("Get", 1, mock.Anything).Return(model.Comments{{Body: "อร่อย"}, {Body: "ทำตามแล้ว"}}, nil)
+}
+
+func (suite *ServiceGetTestSuite) TestUseDefaultPaging() {
+	comments, total, err := suite.service.Get(1, model.CommentQuery{})
+	suite.NoError(err)
+
+	suite.Len(comments, 2)
+	suite.Equal(int64(2), total)
+	suite.repo.AssertCalled(suite.T(), "Get", 1, model.CommentQuery{Page: 1, Limit: model.DefaultCommentLimit})
+}
+
+func TestServiceGet(t *testing.T) {
+	suite.Run(t, new(ServiceGetTestSuite))
+}
+
+type ServiceCreateTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service           comment.IService
+	repo              *MockIRepository
+	userService       *MockIUserService
+	foodRecipeService *MockIFoodRecipeService
+
+	// Mock data
+	claims        model.Claims
+	errExists     error
+	respGetByID   model.Comment
+	errGetByID    error
+	errRepoCreate error
+}
+
+// This will run before each test
+func (suite *ServiceCreateTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.userService = new(MockIUserService)
+	suite.foodRecipeService = new(MockIFoodRecipeService)
+	suite.service = &comment.Service{
+		Repository:        suite.repo,
+		UserService:       suite.userService,
+		FoodRecipeService: suite.foodRecipeService,
+	}
+
+	suite.claims = model.Claims{ID: "UID"}
+	suite.errExists = nil
+	suite.respGetByID = model.Comment{Model: gorm.Model{ID: 5}, FoodRecipeID: 1, UserID: "OTHER"}
+	suite.errGetByID = nil
+	suite.errRepoCreate = nil
+
+	suite.foodRecipeService.On("Exists", mock.Anything).Return(func(int) error {
+		return suite.errExists
+	})
+	suite.repo.On("GetByID", mock.Anything).Return(func(int) (model.Comment, error) {
+		return suite.respGetByID, suite.errGetByID
+	})
+	suite.userService.On("GetByID", mock.Anything).Return(model.User{ID: "UID"}, nil)
+	suite.repo.On("Create", mock.Anything).Return(func(*model.Comment) error {
+		return suite.errRepoCreate
+	})
+}
+
+func (suite *ServiceCreateTestSuite) TestCreateTopLevelComment() {
+	created, err := suite.service.Create(dto.CommentRequest{Body: "  อร่อยมาก  "}, 1, suite.claims)
+	suite.NoError(err)
+
+	suite.Equal(model.Comment{FoodRecipeID: 1, UserID: "UID", Body: "อร่อยมาก"}, created)
+	suite.foodRecipeService.AssertCalled(suite.T(), "Exists", 1)
+	suite.repo.AssertNotCalled(suite.T(), "GetByID", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestCreateReply() {
+	created, err := suite.service.Create(dto.CommentRequest{Body: "ขอบคุณครับ", ParentID: parentID(5)}, 1, suite.claims)
+	suite.NoError(err)
+
+	suite.Equal(parentID(5), created.ParentID)
+	suite.repo.AssertCalled(suite.T(), "GetByID", 5)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenRequestInvalid() {
+	_, err := suite.service.Create(dto.CommentRequest{}, 1, suite.claims)
+	suite.ErrorAs(err, &validator.ValidationErrors{})
+
+	suite.foodRecipeService.AssertNotCalled(suite.T(), "Exists", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenRecipeNotFound() {
+	suite.errExists = gorm.ErrRecordNotFound
+
+	_, err := suite.service.Create(dto.CommentRequest{Body: "อร่อย"}, 99, suite.claims)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenParentNotFound() {
+	suite.errGetByID = gorm.ErrRecordNotFound
+
+	_, err := suite.service.Create(dto.CommentRequest{Body: "อร่อย", ParentID: parentID(5)}, 1, suite.claims)
+	suite.ErrorIs(err, global.ErrInvalidParent)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenParentOnOtherRecipe() {
+	suite.respGetByID.FoodRecipeID = 2
+
+	_, err := suite.service.Create(dto.CommentRequest{Body: "อร่อย", ParentID: parentID(5)}, 1, suite.claims)
+	suite.ErrorIs(err, global.ErrInvalidParent)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenParentIsReply() {
+	suite.respGetByID.ParentID = parentID(3)
+
+	_, err := suite.service.Create(dto.CommentRequest{Body: "อร่อย", ParentID: parentID(5)}, 1, suite.claims)
+	suite.ErrorIs(err, global.ErrInvalidParent)
+
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenRepositoryCreate() {
+	suite.errRepoCreate = assert.AnError
+
+	_, err := suite.service.Create(dto.CommentRequest{Body: "อร่อย"}, 1, suite.claims)
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func TestServiceCreate(t *testing.T) {
+	suite.Run(t, new(ServiceCreateTestSuite))
+}
+
+type ServiceUpdateTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service comment.IService
+	repo    *MockIRepository
+
+	// Mock data
+	respGetByID model.Comment
+	errGetByID  error
+}
+
+// This will run before each test
+func (suite *ServiceUpdateTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &comment.Service{
+		Repository: suite.repo,
+	}
+
+	suite.respGetByID = model.Comment{Model: gorm.Model{ID: 5}, FoodRecipeID: 1, UserID: "UID", Body: "อร่อย"}
+	suite.errGetByID = nil
+
+	suite.repo.On("GetByID", 5).Return(func(int) (model.Comment, error) {
+		return suite.respGetByID, suite.errGetByID
+	})
+	suite.repo.On("Update", mock.Anything).Return(nil)
+}
+
+func (suite *ServiceUpdateTestSuite) TestUpdateOnlyBody() {
+	updated, err := suite.service.Update(dto.CommentRequest{Body: "อร่อยมาก", ParentID: parentID(9)}, 5, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	// ย้ายไปตอบความคิดเห็นอื่นไม่ได้
+	suite.Equal("อร่อยมาก", updated.Body)
+	suite.Nil(updated.ParentID)
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorWhenNotAuthor() {
+	_, err := suite.service.Update(dto.CommentRequest{Body: "อร่อยมาก"}, 5, model.Claims{ID: "OTHER"})
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorWhenNotFound() {
+	suite.errGetByID = gorm.ErrRecordNotFound
+
+	_, err := suite.service.Update(dto.CommentRequest{Body: "อร่อยมาก"}, 5, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func TestServiceUpdate(t *testing.T) {
+	suite.Run(t, new(ServiceUpdateTestSuite))
+}
+
+type ServiceDeleteTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service comment.IService
+	repo    *MockIRepository
+}
+
+// This will run before each test
+func (suite *ServiceDeleteTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &comment.Service{
+		Repository: suite.repo,
+	}
+
+	suite.repo.On("GetByID", 5).Return(model.Comment{Model: gorm.Model{ID: 5}, UserID: "UID"}, nil)
+	suite.repo.On("Delete", uint(5)).Return(nil)
+}
+
+func (suite *ServiceDeleteTestSuite) TestDeleteOwnComment() {
+	err := suite.service.Delete(5, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "Delete", uint(5))
+}
+
+func (suite *ServiceDeleteTestSuite) TestErrorWhenNotAuthor() {
+	err := suite.service.Delete(5, model.Claims{ID: "OTHER"})
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
+
+func TestServiceDelete(t *testing.T) {
+	suite.Run(t, new(ServiceDeleteTestSuite))
+}
@@ -3,4 +3,5 @@ package config
 type Config struct {
 	Database Database
 	Keycloak Keycloak
+	Ranking  Ranking
 }
@@ -0,0 +1,9 @@
+package config
+
+// Ranking ค่าตั้งต้นของคะแนนแบบ Bayesian ที่ใช้จัดอันดับ "top rated"
+type Ranking struct {
+	// จำนวนโหวตสมมติที่เติมให้ทุกสูตร ยิ่งมากสูตรที่มีคนให้คะแนนน้อยยิ่งถูกดึงเข้าหาค่าเฉลี่ยรวม
+	MinVotes float64 `env:"RANKING_MIN_VOTES" envDefault:"10"`
+	// ค่าเฉลี่ยรวมที่ใช้เป็น prior ถ้าเป็น 0 จะคำนวณจากคะแนนทั้งหมดในระบบ
+	GlobalMean float64 `env:"RANKING_GLOBAL_MEAN" envDefault:"0"`
+}
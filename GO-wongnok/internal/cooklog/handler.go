@@ -0,0 +1,251 @@
+package cooklog
+
+import (
+	"net/http"
+	"strconv"
+	"wongnok/internal/global"
+	"wongnok/internal/helper"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IHandler interface {
+	GetByRecipe(ctx *gin.Context)
+	GetByUser(ctx *gin.Context)
+	Create(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+}
+
+type Handler struct {
+	Service IService
+}
+
+func NewHandler(db *gorm.DB) *Handler {
+	return &Handler{
+		Service: NewService(db),
+	}
+}
+
+// GetByRecipe godoc
+// @Summary Get cook logs of a food recipe
+// @Description How many times the recipe was cooked (total) and the most recent cook logs. Private notes are only shown to their owner
+// @Tags cook-logs
+// @Accept json
+// @Produce json
+// @Param id path int true "Food Recipe ID"
+// @Param page query int false "Page number" (default 1)
+// @Param limit query int false "Cook logs per page (max 50)" (default 10)
+// @Success 200 {object} dto.CookLogsResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/food-recipes/{id}/cook-logs [get]
+func (handler Handler) GetByRecipe(ctx *gin.Context) {
+	claims, err := helper.DecodeClaimsFromHeader(ctx, global.Verifier)
+	if err != nil {
+		claims.ID = ""
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid food recipe id"})
+		return
+	}
+
+	var query model.CookLogQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	cookLogs, total, err := handler.Service.GetByRecipe(id, query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	response := cookLogs.ToResponse(claims.ID)
+	response.Total = total
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// GetByUser godoc
+// @Summary Get my cook logs
+// @Description Cook logs of the logged-in user, newest first, including private notes
+// @Tags cook-logs
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" (default 1)
+// @Param limit query int false "Cook logs per page (max 50)" (default 10)
+// @Success 200 {object} dto.CookLogsResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/users/self/cook-logs [get]
+func (handler Handler) GetByUser(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var query model.CookLogQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	cookLogs, total, err := handler.Service.GetByUser(query, claims)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	response := cookLogs.ToResponse(claims.ID)
+	response.Total = total
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Create godoc
+// @Summary Log that I cooked a recipe
+// @Description Record the date, photos, private notes and modifications
+// @Tags cook-logs
+// @Accept json
+// @Produce json
+// @Param id path int true "Food Recipe ID"
+// @Param cookLog body dto.CookLogRequest true "Cook log data"
+// @Success 201 {object} dto.CookLogResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/food-recipes/{id}/cook-logs [post]
+func (handler Handler) Create(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid food recipe id"})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var request dto.CookLogRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	cookLog, err := handler.Service.Create(request, id, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, cookLog.ToResponse(claims.ID))
+}
+
+// Update godoc
+// @Summary Update my cook log
+// @Description Update a cook log you created
+// @Tags cook-logs
+// @Accept json
+// @Produce json
+// @Param id path int true "Cook log ID"
+// @Param cookLog body dto.CookLogRequest true "Cook log data"
+// @Success 200 {object} dto.CookLogResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/cook-logs/{id} [put]
+func (handler Handler) Update(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid cook log id"})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var request dto.CookLogRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	cookLog, err := handler.Service.Update(request, id, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, cookLog.ToResponse(claims.ID))
+}
+
+// Delete godoc
+// @Summary Delete my cook log
+// @Description Delete a cook log you created
+// @Tags cook-logs
+// @Accept json
+// @Produce json
+// @Param id path int true "Cook log ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/cook-logs/{id} [delete]
+func (handler Handler) Delete(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid cook log id"})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	if err := handler.Service.Delete(id, claims); err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Cook log deleted successfully"})
+}
+
+func statusCode(err error) int {
+	switch {
+	case errors.As(err, &validator.ValidationErrors{}):
+		return http.StatusBadRequest
+	case errors.Is(err, global.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
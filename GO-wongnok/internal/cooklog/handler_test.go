@@ -0,0 +1,287 @@
+package cooklog_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"wongnok/internal/cooklog"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewHandler(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		handler := cooklog.NewHandler(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(handler))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler cooklog.IHandler
+	service *MockIService
+
+	// Mock data
+	cookLog model.CookLog
+
+	// Helper
+	server func(method string, url string, payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+// This will run once before all tests in the suite
+func (suite *HandlerTestSuite) SetupSuite() {
+	// Gin testing mode
+	gin.SetMode(gin.TestMode)
+}
+
+// This will run before each test
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = cooklog.Handler{
+		Service: suite.service,
+	}
+
+	suite.cookLog = model.CookLog{
+		Model:        gorm.Model{ID: 5},
+		FoodRecipeID: 1,
+		UserID:       "UID",
+		CookedOn:     time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		Notes:        "เกลือน้อยไป",
+	}
+
+	suite.server = func(method string, url string, payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder {
+		// Create router
+		router := gin.Default()
+
+		// Set context
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+
+		router.GET("/api/v1/food-recipes/:id/cook-logs", suite.handler.GetByRecipe)
+		router.POST("/api/v1/food-recipes/:id/cook-logs", suite.handler.Create)
+		router.PUT("/api/v1/cook-logs/:id", suite.handler.Update)
+		router.DELETE("/api/v1/cook-logs/:id", suite.handler.Delete)
+		router.GET("/api/v1/users/self/cook-logs", suite.handler.GetByUser)
+
+		recorder := httptest.NewRecorder()
+
+		// Create request
+		request, err := http.NewRequest(method, url, payload)
+		suite.NoError(err)
+
+		// Start testing server
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+}
+
+// Extend
+type HandlerGetTestSuite struct {
+	HandlerTestSuite
+}
+
+func (suite *HandlerGetTestSuite) TestHideNotesFromPublic() {
+	suite.service.On("GetByRecipe", 1, model.CookLogQuery{}).Return(model.CookLogs{suite.cookLog}, int64(1), nil)
+
+	response := suite.server(http.MethodGet, "/api/v1/food-recipes/1/cook-logs", nil, nil)
+
+	var body dto.CookLogsResponse
+	suite.NoError(json.Unmarshal(response.Body.Bytes(), &body))
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(int64(1), body.Total)
+	suite.Empty(body.Results[0].Notes)
+}
+
+func (suite *HandlerGetTestSuite) TestShowNotesToOwner() {
+	suite.service.On("GetByUser", model.CookLogQuery{}, model.Claims{ID: "UID"}).Return(model.CookLogs{suite.cookLog}, int64(1), nil)
+
+	response := suite.server(http.MethodGet, "/api/v1/users/self/cook-logs", nil, &model.Claims{ID: "UID"})
+
+	expected := model.CookLogs{suite.cookLog}.ToResponse("UID")
+	expected.Total = 1
+	expectedJson, _ := json.Marshal(expected)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+}
+
+func (suite *HandlerGetTestSuite) TestErrorWhenGetByUserWithoutClaims() {
+	response := suite.server(http.MethodGet, "/api/v1/users/self/cook-logs", nil, nil)
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+}
+
+func TestHandlerGet(t *testing.T) {
+	suite.Run(t, new(HandlerGetTestSuite))
+}
+
+// Extend
+type HandlerCreateTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	errServiceCreate error
+}
+
+// This will run before each test
+func (suite *HandlerCreateTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.errServiceCreate = nil
+
+	suite.service.On("Create", mock.Anything, mock.Anything, mock.Anything).Return(func(dto.CookLogRequest, int, model.Claims) (model.CookLog, error) {
+		return suite.cookLog, suite.errServiceCreate
+	})
+}
+
+func (suite *HandlerCreateTestSuite) TestResponseCookLogWithStatusCode201() {
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(http.MethodPost, "/api/v1/food-recipes/1/cook-logs", strings.NewReader(`{"cookedOn": "2026-10-01", "notes": "เกลือน้อยไป"}`), &claims)
+
+	expectedJson, _ := json.Marshal(suite.cookLog.ToResponse("UID"))
+
+	suite.Equal(http.StatusCreated, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Create", dto.CookLogRequest{CookedOn: "2026-10-01", Notes: "เกลือน้อยไป"}, 1, claims)
+}
+
+func (suite *HandlerCreateTestSuite) TestErrorWhenRecipeNotFound() {
+	suite.errServiceCreate = gorm.ErrRecordNotFound
+
+	response := suite.server(http.MethodPost, "/api/v1/food-recipes/99/cook-logs", strings.NewReader(`{}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerCreateTestSuite) TestErrorWhenNoClaims() {
+	response := suite.server(http.MethodPost, "/api/v1/food-recipes/1/cook-logs", strings.NewReader(`{}`), nil)
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandlerCreate(t *testing.T) {
+	suite.Run(t, new(HandlerCreateTestSuite))
+}
+
+// Extend
+type HandlerUpdateTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	errServiceUpdate error
+}
+
+// This will run before each test
+func (suite *HandlerUpdateTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.errServiceUpdate = nil
+
+	suite.service.On("Update", mock.Anything, mock.Anything, mock.Anything).Return(func(dto.CookLogRequest, int, model.Claims) (model.CookLog, error) {
+		return suite.cookLog, suite.errServiceUpdate
+	})
+}
+
+func (suite *HandlerUpdateTestSuite) TestResponseCookLogWithStatusCode200() {
+	response := suite.server(http.MethodPut, "/api/v1/cook-logs/5", strings.NewReader(`{"notes": "เกลือน้อยไป"}`), &model.Claims{ID: "UID"})
+
+	expectedJson, _ := json.Marshal(suite.cookLog.ToResponse("UID"))
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+}
+
+func (suite *HandlerUpdateTestSuite) TestErrorWhenNotOwner() {
+	suite.errServiceUpdate = global.ErrForbidden
+
+	response := suite.server(http.MethodPut, "/api/v1/cook-logs/5", strings.NewReader(`{}`), &model.Claims{ID: "OTHER"})
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func (suite *HandlerUpdateTestSuite) TestErrorWhenNotFound() {
+	suite.errServiceUpdate = gorm.ErrRecordNotFound
+
+	response := suite.server(http.MethodPut, "/api/v1/cook-logs/5", strings.NewReader(`{}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func TestHandlerUpdate(t *testing.T) {
+	suite.Run(t, new(HandlerUpdateTestSuite))
+}
+
+// Extend
+type HandlerDeleteTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	errServiceDelete error
+}
+
+// This will run before each test
+func (suite *HandlerDeleteTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.errServiceDelete = nil
+
+	suite.service.On("Delete", mock.Anything, mock.Anything).Return(func(int, model.Claims) error {
+		return suite.errServiceDelete
+	})
+}
+
+func (suite *HandlerDeleteTestSuite) TestResponseMessageWithStatusCode200() {
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(http.MethodDelete, "/api/v1/cook-logs/5", nil, &claims)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(`{"message":"Cook log deleted successfully"}`, response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Delete", 5, claims)
+}
+
+func (suite *HandlerDeleteTestSuite) TestErrorWhenNotOwner() {
+	suite.errServiceDelete = global.ErrForbidden
+
+	response := suite.server(http.MethodDelete, "/api/v1/cook-logs/5", nil, &model.Claims{ID: "OTHER"})
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func (suite *HandlerDeleteTestSuite) TestErrorWhenIDInvalid() {
+	response := suite.server(http.MethodDelete, "/api/v1/cook-logs/abc", nil, &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func TestHandlerDelete(t *testing.T) {
+	suite.Run(t, new(HandlerDeleteTestSuite))
+}
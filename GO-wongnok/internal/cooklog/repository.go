@@ -0,0 +1,111 @@
+package cooklog
+
+import (
+	"wongnok/internal/model"
+
+	"gorm.io/gorm"
+)
+
+type IRepository interface {
+	GetByRecipe(recipeID int, query model.CookLogQuery) (model.CookLogs, error)
+	CountByRecipe(recipeID int) (int64, error)
+	GetByUser(userID string, query model.CookLogQuery) (model.CookLogs, error)
+	CountByUser(userID string) (int64, error)
+	GetByID(id int) (model.CookLog, error)
+	Create(cookLog *model.CookLog) error
+	Update(cookLog *model.CookLog) error
+	Delete(id uint) error
+}
+
+// เรียงบันทึกล่าสุดก่อน
+const latestFirst = "cooked_on desc, created_at desc, id desc"
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+// GetByRecipe บันทึกล่าสุดของสูตร พร้อมข้อมูลผู้ทำ
+func (repo Repository) GetByRecipe(recipeID int, query model.CookLogQuery) (model.CookLogs, error) {
+	var cookLogs = make(model.CookLogs, 0)
+	offset := (query.Page - 1) * query.Limit
+
+	if err := repo.DB.Preload("User").
+		Where("food_recipe_id = ?", recipeID).
+		Order(latestFirst).
+		Limit(query.Limit).
+		Offset(offset).
+		Find(&cookLogs).Error; err != nil {
+		return nil, err
+	}
+
+	return cookLogs, nil
+}
+
+func (repo Repository) CountByRecipe(recipeID int) (int64, error) {
+	var count int64
+
+	if err := repo.DB.Model(&model.CookLog{}).Where("food_recipe_id = ?", recipeID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetByUser บันทึกของผู้ใช้คนหนึ่ง พร้อมชื่อและรูปของสูตร
+func (repo Repository) GetByUser(userID string, query model.CookLogQuery) (model.CookLogs, error) {
+	var cookLogs = make(model.CookLogs, 0)
+	offset := (query.Page - 1) * query.Limit
+
+	if err := repo.DB.Preload("FoodRecipe").
+		Where("user_id = ?", userID).
+		Order(latestFirst).
+		Limit(query.Limit).
+		Offset(offset).
+		Find(&cookLogs).Error; err != nil {
+		return nil, err
+	}
+
+	return cookLogs, nil
+}
+
+func (repo Repository) CountByUser(userID string) (int64, error) {
+	var count int64
+
+	if err := repo.DB.Model(&model.CookLog{}).Where("user_id = ?", userID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (repo Repository) GetByID(id int) (model.CookLog, error) {
+	var cookLog model.CookLog
+
+	if err := repo.DB.Preload("FoodRecipe").First(&cookLog, id).Error; err != nil {
+		return model.CookLog{}, err
+	}
+
+	return cookLog, nil
+}
+
+func (repo Repository) Create(cookLog *model.CookLog) error {
+	if err := repo.DB.Omit("FoodRecipe", "User").Create(cookLog).Error; err != nil {
+		return err
+	}
+
+	return repo.DB.Preload("FoodRecipe").First(cookLog, cookLog.ID).Error
+}
+
+func (repo Repository) Update(cookLog *model.CookLog) error {
+	return repo.DB.Model(cookLog).Select("cooked_on", "photo_urls", "notes", "modifications").Updates(cookLog).Error
+}
+
+func (repo Repository) Delete(id uint) error {
+	return repo.DB.Delete(&model.CookLog{}, id).Error
+}
@@ -0,0 +1,175 @@
+package cooklog_test
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+	"wongnok/internal/cooklog"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	driver "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestNewRepository(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		repo := cooklog.NewRepository(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(repo))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+type RepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	container  *postgres.PostgresContainer
+	db         *gorm.DB
+	repository cooklog.IRepository
+}
+
+// This will run once before all tests in the suite
+func (suite *RepositoryTestSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	container, err := postgres.Run(
+		suite.ctx,
+		"postgres:17-alpine",
+		postgres.WithInitScripts(filepath.Join("../..", "tests", "init-db.sql")),
+		postgres.WithDatabase("wongnok-test"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").WithOccurrence(2).WithStartupTimeout(
+				(5 * time.Second),
+			),
+		),
+	)
+	suite.NoError(err)
+	suite.container = container
+}
+
+// This will run once after all tests in the suite
+func (suite *RepositoryTestSuite) TearDownSuite() {
+	err := suite.container.Terminate(suite.ctx)
+	suite.NoError(err)
+}
+
+// This will run before each test
+func (suite *RepositoryTestSuite) SetupTest() {
+	conn, err := suite.container.ConnectionString(suite.ctx, "sslmode=disable")
+	suite.NoError(err)
+
+	db, err := gorm.Open(driver.Open(conn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	suite.NoError(err)
+
+	suite.repository = &cooklog.Repository{
+		DB: db,
+	}
+
+	suite.db = db
+}
+
+// This will run after each test
+func (suite *RepositoryTestSuite) TearDownTest() {
+	sqldb, _ := suite.db.DB()
+	sqldb.Close()
+}
+
+const (
+	demoUserID   = "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"
+	secondUserID = "7c6d2f0a-5e3b-4c1d-9a8e-2f4b6d8c0e1a"
+)
+
+// Extend
+type RepositoryGetTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryGetTestSuite) TestReturnLatestFirst() {
+	older := model.CookLog{FoodRecipeID: 1, UserID: demoUserID, CookedOn: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC), PhotoURLs: model.StringList{}}
+	suite.NoError(suite.repository.Create(&older))
+	newer := model.CookLog{FoodRecipeID: 1, UserID: secondUserID, CookedOn: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), PhotoURLs: model.StringList{}}
+	suite.NoError(suite.repository.Create(&newer))
+
+	// Create โหลดสูตรกลับมาด้วย
+	suite.Equal("Omlet", older.FoodRecipe.Name)
+
+	byRecipe, err := suite.repository.GetByRecipe(1, model.CookLogQuery{Page: 1, Limit: 10})
+	suite.NoError(err)
+	suite.Len(byRecipe, 2)
+	suite.Equal(newer.ID, byRecipe[0].ID)
+	suite.Equal("Second", byRecipe[0].User.FirstName)
+
+	count, err := suite.repository.CountByRecipe(1)
+	suite.NoError(err)
+	suite.Equal(int64(2), count)
+
+	byUser, err := suite.repository.GetByUser(demoUserID, model.CookLogQuery{Page: 1, Limit: 10})
+	suite.NoError(err)
+	suite.Len(byUser, 1)
+	suite.Equal(older.ID, byUser[0].ID)
+	suite.Equal("Omlet", byUser[0].FoodRecipe.Name)
+
+	count, err = suite.repository.CountByUser(demoUserID)
+	suite.NoError(err)
+	suite.Equal(int64(1), count)
+}
+
+func TestRepositoryGet(t *testing.T) {
+	suite.Run(t, new(RepositoryGetTestSuite))
+}
+
+// Extend
+type RepositoryUpdateTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryUpdateTestSuite) TestUpdateEditableFields() {
+	cookLog := model.CookLog{FoodRecipeID: 1, UserID: demoUserID, CookedOn: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC), PhotoURLs: model.StringList{}}
+	suite.NoError(suite.repository.Create(&cookLog))
+
+	cookLog.CookedOn = time.Date(2026, 9, 2, 0, 0, 0, 0, time.UTC)
+	cookLog.PhotoURLs = model.StringList{"https://example.com/omelet.jpg"}
+	cookLog.Notes = "เกลือน้อยไป"
+	cookLog.UserID = secondUserID
+	suite.NoError(suite.repository.Update(&cookLog))
+
+	result, err := suite.repository.GetByID(int(cookLog.ID))
+	suite.NoError(err)
+
+	suite.Equal("2026-09-02", result.CookedOn.Format(model.CookedOnLayout))
+	suite.Equal(model.StringList{"https://example.com/omelet.jpg"}, result.PhotoURLs)
+	suite.Equal("เกลือน้อยไป", result.Notes)
+	suite.Equal(demoUserID, result.UserID)
+}
+
+func (suite *RepositoryUpdateTestSuite) TestHideDeleted() {
+	cookLog := model.CookLog{FoodRecipeID: 1, UserID: demoUserID, CookedOn: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC), PhotoURLs: model.StringList{}}
+	suite.NoError(suite.repository.Create(&cookLog))
+
+	suite.NoError(suite.repository.Delete(cookLog.ID))
+
+	_, err := suite.repository.GetByID(int(cookLog.ID))
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func TestRepositoryUpdate(t *testing.T) {
+	suite.Run(t, new(RepositoryUpdateTestSuite))
+}
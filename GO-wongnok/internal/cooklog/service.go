@@ -0,0 +1,151 @@
+package cooklog
+
+import (
+	"wongnok/internal/foodrecipe"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+	"wongnok/internal/user"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IUserService user.IService
+type IFoodRecipeService foodrecipe.IService
+
+type IService interface {
+	GetByRecipe(recipeID int, query model.CookLogQuery) (model.CookLogs, int64, error)
+	GetByUser(query model.CookLogQuery, claims model.Claims) (model.CookLogs, int64, error)
+	Create(request dto.CookLogRequest, recipeID int, claims model.Claims) (model.CookLog, error)
+	Update(request dto.CookLogRequest, id int, claims model.Claims) (model.CookLog, error)
+	Delete(id int, claims model.Claims) error
+}
+
+type Service struct {
+	Repository        IRepository
+	UserService       IUserService
+	FoodRecipeService IFoodRecipeService
+}
+
+func NewService(db *gorm.DB) IService {
+	return &Service{
+		Repository:        NewRepository(db),
+		UserService:       user.NewService(db),
+		FoodRecipeService: foodrecipe.NewService(db),
+	}
+}
+
+// GetByRecipe จำนวนครั้งที่มีคนทำสูตรนี้ และบันทึกล่าสุด (สาธารณะ)
+func (service Service) GetByRecipe(recipeID int, query model.CookLogQuery) (model.CookLogs, int64, error) {
+	query = withDefaults(query)
+
+	total, err := service.Repository.CountByRecipe(recipeID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	cookLogs, err := service.Repository.GetByRecipe(recipeID, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return cookLogs, total, nil
+}
+
+// GetByUser บันทึกทั้งหมดของผู้ใช้ที่ login
+func (service Service) GetByUser(query model.CookLogQuery, claims model.Claims) (model.CookLogs, int64, error) {
+	query = withDefaults(query)
+
+	total, err := service.Repository.CountByUser(claims.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	cookLogs, err := service.Repository.GetByUser(claims.ID, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return cookLogs, total, nil
+}
+
+func (service Service) Create(request dto.CookLogRequest, recipeID int, claims model.Claims) (model.CookLog, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.CookLog{}, errors.Wrap(err, "request invalid")
+	}
+
+	if err := service.FoodRecipeService.Exists(recipeID); err != nil {
+		return model.CookLog{}, errors.Wrap(err, "find recipe")
+	}
+
+	user, err := service.UserService.GetByID(claims)
+	if err != nil {
+		return model.CookLog{}, errors.Wrap(err, "create cook log")
+	}
+
+	cookLog := model.CookLog{FoodRecipeID: uint(recipeID), UserID: user.ID}
+	cookLog = cookLog.FromRequest(request)
+
+	if err := service.Repository.Create(&cookLog); err != nil {
+		return model.CookLog{}, errors.Wrap(err, "create cook log")
+	}
+
+	return cookLog, nil
+}
+
+// Update แก้บันทึกของตัวเอง ถ้าไม่ส่งวันที่มาจะคงวันที่เดิมไว้
+func (service Service) Update(request dto.CookLogRequest, id int, claims model.Claims) (model.CookLog, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.CookLog{}, errors.Wrap(err, "request invalid")
+	}
+
+	cookLog, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.CookLog{}, errors.Wrap(err, "find cook log")
+	}
+
+	if cookLog.UserID != claims.ID {
+		return model.CookLog{}, global.ErrForbidden
+	}
+
+	updated := cookLog.FromRequest(request)
+	if request.CookedOn != "" {
+		cookLog.CookedOn = updated.CookedOn
+	}
+	cookLog.PhotoURLs = updated.PhotoURLs
+	cookLog.Notes = updated.Notes
+	cookLog.Modifications = updated.Modifications
+
+	if err := service.Repository.Update(&cookLog); err != nil {
+		return model.CookLog{}, errors.Wrap(err, "update cook log")
+	}
+
+	return cookLog, nil
+}
+
+func (service Service) Delete(id int, claims model.Claims) error {
+	cookLog, err := service.Repository.GetByID(id)
+	if err != nil {
+		return errors.Wrap(err, "find cook log")
+	}
+
+	if cookLog.UserID != claims.ID {
+		return global.ErrForbidden
+	}
+
+	return service.Repository.Delete(cookLog.ID)
+}
+
+func withDefaults(query model.CookLogQuery) model.CookLogQuery {
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.Limit == 0 {
+		query.Limit = model.DefaultCookLogLimit
+	}
+	return query
+}
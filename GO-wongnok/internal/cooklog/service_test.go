@@ -0,0 +1,263 @@
+package cooklog_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+	"wongnok/internal/cooklog"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewService(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		service := cooklog.NewService(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(service))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+type ServiceGetTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service cooklog.IService
+	repo    *MockIRepository
+}
+
+// This will run before each test
+func (suite *ServiceGetTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &cooklog.Service{
+		Repository: suite.repo,
+	}
+
+	suite.repo.On("CountByRecipe", 1).Return(int64(3), nil)
+	suite.repo.On("GetByRecipe", 1, mock.Anything).Return(model.CookLogs{{FoodRecipeID: 1}}, nil)
+	suite.repo.On("CountByUser", "UID").Return(int64(1), nil)
+	suite.repo.On("GetByUser", "UID", mock.Anything).Return(model.CookLogs{{UserID: "UID"}}, nil)
+}
+
+func (suite *ServiceGetTestSuite) TestGetByRecipeWithDefaultPaging() {
+	cookLogs, total, err := suite.service.GetByRecipe(1, model.CookLogQuery{})
+	suite.NoError(err)
+
+	suite.Len(cookLogs, 1)
+	suite.Equal(int64(3), total)
+	suite.repo.AssertCalled(suite.T(), "GetByRecipe", 1, model.CookLogQuery{Page: 1, Limit: model.DefaultCookLogLimit})
+}
+
+func (suite *ServiceGetTestSuite) TestGetByUserOfClaims() {
+	cookLogs, total, err := suite.service.GetByUser(model.CookLogQuery{Page: 2, Limit: 5}, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.Len(cookLogs, 1)
+	suite.Equal(int64(1), total)
+	suite.repo.AssertCalled(suite.T(), "GetByUser", "UID", model.CookLogQuery{Page: 2, Limit: 5})
+}
+
+func TestServiceGet(t *testing.T) {
+	suite.Run(t, new(ServiceGetTestSuite))
+}
+
+type ServiceCreateTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service           cooklog.IService
+	repo              *MockIRepository
+	userService       *MockIUserService
+	foodRecipeService *MockIFoodRecipeService
+
+	// Mock data
+	errExists     error
+	errRepoCreate error
+}
+
+// This will run before each test
+func (suite *ServiceCreateTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.userService = new(MockIUserService)
+	suite.foodRecipeService = new(MockIFoodRecipeService)
+	suite.service = &cooklog.Service{
+		Repository:        suite.repo,
+		UserService:       suite.userService,
+		FoodRecipeService: suite.foodRecipeService,
+	}
+
+	suite.errExists = nil
+	suite.errRepoCreate = nil
+
+	suite.foodRecipeService.On("Exists", mock.Anything).Return(func(int) error {
+		return suite.errExists
+	})
+	suite.userService.On("GetByID", mock.Anything).Return(model.User{ID: "UID"}, nil)
+	suite.repo.On("Create", mock.Anything).Return(func(*model.CookLog) error {
+		return suite.errRepoCreate
+	})
+}
+
+func (suite *ServiceCreateTestSuite) TestCreateCookLog() {
+	cookLog, err := suite.service.Create(dto.CookLogRequest{
+		CookedOn:      "2026-10-01",
+		PhotoURLs:     []string{"https://example.com/omelet.jpg"},
+		Notes:         " เกลือน้อยไป ",
+		Modifications: "ใส่หอมใหญ่",
+	}, 1, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.Equal(model.CookLog{
+		FoodRecipeID:  1,
+		UserID:        "UID",
+		CookedOn:      time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		PhotoURLs:     model.StringList{"https://example.com/omelet.jpg"},
+		Notes:         "เกลือน้อยไป",
+		Modifications: "ใส่หอมใหญ่",
+	}, cookLog)
+	suite.foodRecipeService.AssertCalled(suite.T(), "Exists", 1)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenRequestInvalid() {
+	_, err := suite.service.Create(dto.CookLogRequest{CookedOn: "01/10/2026"}, 1, model.Claims{ID: "UID"})
+	suite.ErrorAs(err, &validator.ValidationErrors{})
+
+	suite.foodRecipeService.AssertNotCalled(suite.T(), "Exists", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenRecipeNotFound() {
+	suite.errExists = gorm.ErrRecordNotFound
+
+	_, err := suite.service.Create(dto.CookLogRequest{}, 99, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenRepositoryCreate() {
+	suite.errRepoCreate = assert.AnError
+
+	_, err := suite.service.Create(dto.CookLogRequest{}, 1, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func TestServiceCreate(t *testing.T) {
+	suite.Run(t, new(ServiceCreateTestSuite))
+}
+
+type ServiceUpdateTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service cooklog.IService
+	repo    *MockIRepository
+
+	// Mock data
+	respGetByID model.CookLog
+	errGetByID  error
+}
+
+// This will run before each test
+func (suite *ServiceUpdateTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &cooklog.Service{
+		Repository: suite.repo,
+	}
+
+	suite.respGetByID = model.CookLog{
+		Model:        gorm.Model{ID: 5},
+		FoodRecipeID: 1,
+		UserID:       "UID",
+		CookedOn:     time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		Notes:        "เกลือน้อยไป",
+	}
+	suite.errGetByID = nil
+
+	suite.repo.On("GetByID", 5).Return(func(int) (model.CookLog, error) {
+		return suite.respGetByID, suite.errGetByID
+	})
+	suite.repo.On("Update", mock.Anything).Return(nil)
+}
+
+func (suite *ServiceUpdateTestSuite) TestKeepCookedOnWhenNotGiven() {
+	cookLog, err := suite.service.Update(dto.CookLogRequest{Notes: "เค็มกำลังดี"}, 5, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.Equal(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), cookLog.CookedOn)
+	suite.Equal("เค็มกำลังดี", cookLog.Notes)
+}
+
+func (suite *ServiceUpdateTestSuite) TestChangeCookedOn() {
+	cookLog, err := suite.service.Update(dto.CookLogRequest{CookedOn: "2026-10-05"}, 5, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.Equal(time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC), cookLog.CookedOn)
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorWhenNotOwner() {
+	_, err := suite.service.Update(dto.CookLogRequest{Notes: "เค็มกำลังดี"}, 5, model.Claims{ID: "OTHER"})
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorWhenNotFound() {
+	suite.errGetByID = gorm.ErrRecordNotFound
+
+	_, err := suite.service.Update(dto.CookLogRequest{}, 5, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func TestServiceUpdate(t *testing.T) {
+	suite.Run(t, new(ServiceUpdateTestSuite))
+}
+
+type ServiceDeleteTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service cooklog.IService
+	repo    *MockIRepository
+}
+
+// This will run before each test
+func (suite *ServiceDeleteTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &cooklog.Service{
+		Repository: suite.repo,
+	}
+
+	suite.repo.On("GetByID", 5).Return(model.CookLog{Model: gorm.Model{ID: 5}, UserID: "UID"}, nil)
+	suite.repo.On("Delete", uint(5)).Return(nil)
+}
+
+func (suite *ServiceDeleteTestSuite) TestDeleteOwnCookLog() {
+	err := suite.service.Delete(5, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "Delete", uint(5))
+}
+
+func (suite *ServiceDeleteTestSuite) TestErrorWhenNotOwner() {
+	err := suite.service.Delete(5, model.Claims{ID: "OTHER"})
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
+
+func TestServiceDelete(t *testing.T) {
+	suite.Run(t, new(ServiceDeleteTestSuite))
+}
@@ -69,9 +69,12 @@ func (handler Handler) Get(ctx *gin.Context) {
 // @Tags favorite
 // @Accept json
 // @Produce json
-// @Param page query int true "Page number" (default 1)
+// @Param page query int false "Page number, required unless cursor is given" (default 1)
 // @Param limit query int true "Items per page" (default 10)
+// @Param cursor query string false "nextCursor from the previous page (keyset pagination)"
 // @Param search query string false "Search term"
+// @Param sort query string false "Sort order (newest, oldest, highest-rated, top-rated, most-rated, most-favorited, name)"
+// @Param units query string false "Convert ingredient units (metric or imperial)"
 // @Success 200 {object} dto.FavoriteResponse
 // @Failure 400 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
@@ -90,17 +93,23 @@ func (handler Handler) GetByUser(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
 		return
 	}
-	foodRecipes, total, err := handler.Service.GetByUser(foodRecipeQuery, claims)
+	foodRecipes, total, nextCursor, err := handler.Service.GetByUser(foodRecipeQuery, claims)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			ctx.JSON(http.StatusInternalServerError, gin.H{"message": "favorite not found"})
 			return
 		}
+		if errors.Is(err, global.ErrInvalidCursor) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
 		return
 	}
 
-	ctx.JSON(http.StatusOK, foodRecipes.ToResponse(total))
+	response := foodRecipes.ToResponse(total)
+	response.NextCursor = nextCursor
+	ctx.JSON(http.StatusOK, response)
 }
 
 // Create godoc
@@ -79,6 +79,46 @@ func (_c *MockIHandler_Create_Call) RunAndReturn(run func(ctx *gin.Context)) *Mo
 	return _c
 }
 
+// Delete provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Delete(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIHandler_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Delete(ctx interface{}) *MockIHandler_Delete_Call {
+	return &MockIHandler_Delete_Call{Call: _e.mock.On("Delete", ctx)}
+}
+
+func (_c *MockIHandler_Delete_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Delete_Call) Return() *MockIHandler_Delete_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Delete_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Delete_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Get provides a mock function for the type MockIHandler
 func (_mock *MockIHandler) Get(ctx *gin.Context) {
 	_mock.Called(ctx)
@@ -159,6 +199,46 @@ func (_c *MockIHandler_GetByUser_Call) RunAndReturn(run func(ctx *gin.Context))
 	return _c
 }
 
+// Update provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Update(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIHandler_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Update(ctx interface{}) *MockIHandler_Update_Call {
+	return &MockIHandler_Update_Call{Call: _e.mock.On("Update", ctx)}
+}
+
+func (_c *MockIHandler_Update_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Update_Call) Return() *MockIHandler_Update_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Update_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Update_Call {
+	_c.Run(run)
+	return _c
+}
+
 // NewMockIRepository creates a new instance of MockIRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIRepository(t interface {
@@ -182,105 +262,1964 @@ type MockIRepository_Expecter struct {
 	mock *mock.Mock
 }
 
-func (_m *MockIRepository) EXPECT() *MockIRepository_Expecter {
-	return &MockIRepository_Expecter{mock: &_m.Mock}
+func (_m *MockIRepository) EXPECT() *MockIRepository_Expecter {
+	return &MockIRepository_Expecter{mock: &_m.Mock}
+}
+
+// Count provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Count(UserID string, search string) (int64, error) {
+	ret := _mock.Called(UserID, search)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (int64, error)); ok {
+		return returnFunc(UserID, search)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) int64); ok {
+		r0 = returnFunc(UserID, search)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(UserID, search)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockIRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - UserID string
+//   - search string
+func (_e *MockIRepository_Expecter) Count(UserID interface{}, search interface{}) *MockIRepository_Count_Call {
+	return &MockIRepository_Count_Call{Call: _e.mock.On("Count", UserID, search)}
+}
+
+func (_c *MockIRepository_Count_Call) Run(run func(UserID string, search string)) *MockIRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Count_Call) Return(n int64, err error) *MockIRepository_Count_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIRepository_Count_Call) RunAndReturn(run func(UserID string, search string) (int64, error)) *MockIRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Create(favorite *model.Favorite) error {
+	ret := _mock.Called(favorite)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Favorite) error); ok {
+		r0 = returnFunc(favorite)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - favorite *model.Favorite
+func (_e *MockIRepository_Expecter) Create(favorite interface{}) *MockIRepository_Create_Call {
+	return &MockIRepository_Create_Call{Call: _e.mock.On("Create", favorite)}
+}
+
+func (_c *MockIRepository_Create_Call) Run(run func(favorite *model.Favorite)) *MockIRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Favorite
+		if args[0] != nil {
+			arg0 = args[0].(*model.Favorite)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Create_Call) Return(err error) *MockIRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Create_Call) RunAndReturn(run func(favorite *model.Favorite) error) *MockIRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Delete(id int) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id int
+func (_e *MockIRepository_Expecter) Delete(id interface{}) *MockIRepository_Delete_Call {
+	return &MockIRepository_Delete_Call{Call: _e.mock.On("Delete", id)}
+}
+
+func (_c *MockIRepository_Delete_Call) Run(run func(id int)) *MockIRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Delete_Call) Return(err error) *MockIRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Delete_Call) RunAndReturn(run func(id int) error) *MockIRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Get(userID string) (model.Favorites, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.Favorites
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (model.Favorites, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) model.Favorites); ok {
+		r0 = returnFunc(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Favorites)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - userID string
+func (_e *MockIRepository_Expecter) Get(userID interface{}) *MockIRepository_Get_Call {
+	return &MockIRepository_Get_Call{Call: _e.mock.On("Get", userID)}
+}
+
+func (_c *MockIRepository_Get_Call) Run(run func(userID string)) *MockIRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Get_Call) Return(favorites model.Favorites, err error) *MockIRepository_Get_Call {
+	_c.Call.Return(favorites, err)
+	return _c
+}
+
+func (_c *MockIRepository_Get_Call) RunAndReturn(run func(userID string) (model.Favorites, error)) *MockIRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetByID(id int, claimsID string) (model.Favorite, error) {
+	ret := _mock.Called(id, claimsID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Favorite
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) (model.Favorite, error)); ok {
+		return returnFunc(id, claimsID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, string) model.Favorite); ok {
+		r0 = returnFunc(id, claimsID)
+	} else {
+		r0 = ret.Get(0).(model.Favorite)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = returnFunc(id, claimsID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - id int
+//   - claimsID string
+func (_e *MockIRepository_Expecter) GetByID(id interface{}, claimsID interface{}) *MockIRepository_GetByID_Call {
+	return &MockIRepository_GetByID_Call{Call: _e.mock.On("GetByID", id, claimsID)}
+}
+
+func (_c *MockIRepository_GetByID_Call) Run(run func(id int, claimsID string)) *MockIRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetByID_Call) Return(favorite model.Favorite, err error) *MockIRepository_GetByID_Call {
+	_c.Call.Return(favorite, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetByID_Call) RunAndReturn(run func(id int, claimsID string) (model.Favorite, error)) *MockIRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUser provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetByUser(foodRecipeQuery model.FoodRecipeQuery, userID string) (model.FoodRecipes, error) {
+	ret := _mock.Called(foodRecipeQuery, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUser")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, string) (model.FoodRecipes, error)); ok {
+		return returnFunc(foodRecipeQuery, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, string) model.FoodRecipes); ok {
+		r0 = returnFunc(foodRecipeQuery, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery, string) error); ok {
+		r1 = returnFunc(foodRecipeQuery, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUser'
+type MockIRepository_GetByUser_Call struct {
+	*mock.Call
+}
+
+// GetByUser is a helper method to define mock.On call
+//   - foodRecipeQuery model.FoodRecipeQuery
+//   - userID string
+func (_e *MockIRepository_Expecter) GetByUser(foodRecipeQuery interface{}, userID interface{}) *MockIRepository_GetByUser_Call {
+	return &MockIRepository_GetByUser_Call{Call: _e.mock.On("GetByUser", foodRecipeQuery, userID)}
+}
+
+func (_c *MockIRepository_GetByUser_Call) Run(run func(foodRecipeQuery model.FoodRecipeQuery, userID string)) *MockIRepository_GetByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetByUser_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIRepository_GetByUser_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetByUser_Call) RunAndReturn(run func(foodRecipeQuery model.FoodRecipeQuery, userID string) (model.FoodRecipes, error)) *MockIRepository_GetByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeleteByID provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetDeleteByID(id int, claimsID string) (model.Favorite, error) {
+	ret := _mock.Called(id, claimsID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeleteByID")
+	}
+
+	var r0 model.Favorite
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) (model.Favorite, error)); ok {
+		return returnFunc(id, claimsID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, string) model.Favorite); ok {
+		r0 = returnFunc(id, claimsID)
+	} else {
+		r0 = ret.Get(0).(model.Favorite)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = returnFunc(id, claimsID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetDeleteByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeleteByID'
+type MockIRepository_GetDeleteByID_Call struct {
+	*mock.Call
+}
+
+// GetDeleteByID is a helper method to define mock.On call
+//   - id int
+//   - claimsID string
+func (_e *MockIRepository_Expecter) GetDeleteByID(id interface{}, claimsID interface{}) *MockIRepository_GetDeleteByID_Call {
+	return &MockIRepository_GetDeleteByID_Call{Call: _e.mock.On("GetDeleteByID", id, claimsID)}
+}
+
+func (_c *MockIRepository_GetDeleteByID_Call) Run(run func(id int, claimsID string)) *MockIRepository_GetDeleteByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetDeleteByID_Call) Return(favorite model.Favorite, err error) *MockIRepository_GetDeleteByID_Call {
+	_c.Call.Return(favorite, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetDeleteByID_Call) RunAndReturn(run func(id int, claimsID string) (model.Favorite, error)) *MockIRepository_GetDeleteByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Update(id int) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - id int
+func (_e *MockIRepository_Expecter) Update(id interface{}) *MockIRepository_Update_Call {
+	return &MockIRepository_Update_Call{Call: _e.mock.On("Update", id)}
+}
+
+func (_c *MockIRepository_Update_Call) Run(run func(id int)) *MockIRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Update_Call) Return(err error) *MockIRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Update_Call) RunAndReturn(run func(id int) error) *MockIRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIUserService creates a new instance of MockIUserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIUserService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIUserService {
+	mock := &MockIUserService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIUserService is an autogenerated mock type for the IUserService type
+type MockIUserService struct {
+	mock.Mock
+}
+
+type MockIUserService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIUserService) EXPECT() *MockIUserService_Expecter {
+	return &MockIUserService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Create(claims model.Claims) (model.User, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
+		r0 = returnFunc(claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIUserService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) Create(claims interface{}) *MockIUserService_Create_Call {
+	return &MockIUserService_Create_Call{Call: _e.mock.On("Create", claims)}
+}
+
+func (_c *MockIUserService_Create_Call) Run(run func(claims model.Claims)) *MockIUserService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Create_Call) Return(user model.User, err error) *MockIUserService_Create_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIUserService_Create_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIUserService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) GetByID(claims model.Claims) (model.User, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
+		r0 = returnFunc(claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIUserService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) GetByID(claims interface{}) *MockIUserService_GetByID_Call {
+	return &MockIUserService_GetByID_Call{Call: _e.mock.On("GetByID", claims)}
+}
+
+func (_c *MockIUserService_GetByID_Call) Run(run func(claims model.Claims)) *MockIUserService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_GetByID_Call) Return(user model.User, err error) *MockIUserService_GetByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIUserService_GetByID_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIUserService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecipes provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) GetRecipes(userID string, query model.UserRecipesQuery, claims model.Claims) (model.FoodRecipes, error) {
+	ret := _mock.Called(userID, query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecipes")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.UserRecipesQuery, model.Claims) (model.FoodRecipes, error)); ok {
+		return returnFunc(userID, query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.UserRecipesQuery, model.Claims) model.FoodRecipes); ok {
+		r0 = returnFunc(userID, query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.UserRecipesQuery, model.Claims) error); ok {
+		r1 = returnFunc(userID, query, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
+type MockIUserService_GetRecipes_Call struct {
+	*mock.Call
+}
+
+// GetRecipes is a helper method to define mock.On call
+//   - userID string
+//   - query model.UserRecipesQuery
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) GetRecipes(userID interface{}, query interface{}, claims interface{}) *MockIUserService_GetRecipes_Call {
+	return &MockIUserService_GetRecipes_Call{Call: _e.mock.On("GetRecipes", userID, query, claims)}
+}
+
+func (_c *MockIUserService_GetRecipes_Call) Run(run func(userID string, query model.UserRecipesQuery, claims model.Claims)) *MockIUserService_GetRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.UserRecipesQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.UserRecipesQuery)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_GetRecipes_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIUserService_GetRecipes_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIUserService_GetRecipes_Call) RunAndReturn(run func(userID string, query model.UserRecipesQuery, claims model.Claims) (model.FoodRecipes, error)) *MockIUserService_GetRecipes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Update(user *model.User) (model.User, error) {
+	ret := _mock.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*model.User) (model.User, error)); ok {
+		return returnFunc(user)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*model.User) model.User); ok {
+		r0 = returnFunc(user)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*model.User) error); ok {
+		r1 = returnFunc(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIUserService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - user *model.User
+func (_e *MockIUserService_Expecter) Update(user interface{}) *MockIUserService_Update_Call {
+	return &MockIUserService_Update_Call{Call: _e.mock.On("Update", user)}
+}
+
+func (_c *MockIUserService_Update_Call) Run(run func(user *model.User)) *MockIUserService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.User
+		if args[0] != nil {
+			arg0 = args[0].(*model.User)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Update_Call) Return(user1 model.User, err error) *MockIUserService_Update_Call {
+	_c.Call.Return(user1, err)
+	return _c
+}
+
+func (_c *MockIUserService_Update_Call) RunAndReturn(run func(user *model.User) (model.User, error)) *MockIUserService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertWithClaims provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) UpsertWithClaims(claims model.Claims) (model.User, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertWithClaims")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
+		r0 = returnFunc(claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_UpsertWithClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertWithClaims'
+type MockIUserService_UpsertWithClaims_Call struct {
+	*mock.Call
+}
+
+// UpsertWithClaims is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) UpsertWithClaims(claims interface{}) *MockIUserService_UpsertWithClaims_Call {
+	return &MockIUserService_UpsertWithClaims_Call{Call: _e.mock.On("UpsertWithClaims", claims)}
+}
+
+func (_c *MockIUserService_UpsertWithClaims_Call) Run(run func(claims model.Claims)) *MockIUserService_UpsertWithClaims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_UpsertWithClaims_Call) Return(user model.User, err error) *MockIUserService_UpsertWithClaims_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIUserService_UpsertWithClaims_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIUserService_UpsertWithClaims_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockICollectionService creates a new instance of MockICollectionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockICollectionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockICollectionService {
+	mock := &MockICollectionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockICollectionService is an autogenerated mock type for the ICollectionService type
+type MockICollectionService struct {
+	mock.Mock
+}
+
+type MockICollectionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockICollectionService) EXPECT() *MockICollectionService_Expecter {
+	return &MockICollectionService_Expecter{mock: &_m.Mock}
+}
+
+// AddRecipe provides a mock function for the type MockICollectionService
+func (_mock *MockICollectionService) AddRecipe(request dto.CollectionItemRequest, id int, claims model.Claims) (model.Collection, error) {
+	ret := _mock.Called(request, id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRecipe")
+	}
+
+	var r0 model.Collection
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.CollectionItemRequest, int, model.Claims) (model.Collection, error)); ok {
+		return returnFunc(request, id, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.CollectionItemRequest, int, model.Claims) model.Collection); ok {
+		r0 = returnFunc(request, id, claims)
+	} else {
+		r0 = ret.Get(0).(model.Collection)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.CollectionItemRequest, int, model.Claims) error); ok {
+		r1 = returnFunc(request, id, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICollectionService_AddRecipe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRecipe'
+type MockICollectionService_AddRecipe_Call struct {
+	*mock.Call
+}
+
+// AddRecipe is a helper method to define mock.On call
+//   - request dto.CollectionItemRequest
+//   - id int
+//   - claims model.Claims
+func (_e *MockICollectionService_Expecter) AddRecipe(request interface{}, id interface{}, claims interface{}) *MockICollectionService_AddRecipe_Call {
+	return &MockICollectionService_AddRecipe_Call{Call: _e.mock.On("AddRecipe", request, id, claims)}
+}
+
+func (_c *MockICollectionService_AddRecipe_Call) Run(run func(request dto.CollectionItemRequest, id int, claims model.Claims)) *MockICollectionService_AddRecipe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.CollectionItemRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.CollectionItemRequest)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICollectionService_AddRecipe_Call) Return(collection model.Collection, err error) *MockICollectionService_AddRecipe_Call {
+	_c.Call.Return(collection, err)
+	return _c
+}
+
+func (_c *MockICollectionService_AddRecipe_Call) RunAndReturn(run func(request dto.CollectionItemRequest, id int, claims model.Claims) (model.Collection, error)) *MockICollectionService_AddRecipe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddToDefault provides a mock function for the type MockICollectionService
+func (_mock *MockICollectionService) AddToDefault(recipeID int, claims model.Claims) error {
+	ret := _mock.Called(recipeID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddToDefault")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) error); ok {
+		r0 = returnFunc(recipeID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockICollectionService_AddToDefault_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddToDefault'
+type MockICollectionService_AddToDefault_Call struct {
+	*mock.Call
+}
+
+// AddToDefault is a helper method to define mock.On call
+//   - recipeID int
+//   - claims model.Claims
+func (_e *MockICollectionService_Expecter) AddToDefault(recipeID interface{}, claims interface{}) *MockICollectionService_AddToDefault_Call {
+	return &MockICollectionService_AddToDefault_Call{Call: _e.mock.On("AddToDefault", recipeID, claims)}
+}
+
+func (_c *MockICollectionService_AddToDefault_Call) Run(run func(recipeID int, claims model.Claims)) *MockICollectionService_AddToDefault_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICollectionService_AddToDefault_Call) Return(err error) *MockICollectionService_AddToDefault_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockICollectionService_AddToDefault_Call) RunAndReturn(run func(recipeID int, claims model.Claims) error) *MockICollectionService_AddToDefault_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockICollectionService
+func (_mock *MockICollectionService) Create(request dto.CollectionRequest, claims model.Claims) (model.Collection, error) {
+	ret := _mock.Called(request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Collection
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.CollectionRequest, model.Claims) (model.Collection, error)); ok {
+		return returnFunc(request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.CollectionRequest, model.Claims) model.Collection); ok {
+		r0 = returnFunc(request, claims)
+	} else {
+		r0 = ret.Get(0).(model.Collection)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.CollectionRequest, model.Claims) error); ok {
+		r1 = returnFunc(request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICollectionService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockICollectionService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - request dto.CollectionRequest
+//   - claims model.Claims
+func (_e *MockICollectionService_Expecter) Create(request interface{}, claims interface{}) *MockICollectionService_Create_Call {
+	return &MockICollectionService_Create_Call{Call: _e.mock.On("Create", request, claims)}
+}
+
+func (_c *MockICollectionService_Create_Call) Run(run func(request dto.CollectionRequest, claims model.Claims)) *MockICollectionService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.CollectionRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.CollectionRequest)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICollectionService_Create_Call) Return(collection model.Collection, err error) *MockICollectionService_Create_Call {
+	_c.Call.Return(collection, err)
+	return _c
+}
+
+func (_c *MockICollectionService_Create_Call) RunAndReturn(run func(request dto.CollectionRequest, claims model.Claims) (model.Collection, error)) *MockICollectionService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockICollectionService
+func (_mock *MockICollectionService) Delete(id int, claims model.Claims) error {
+	ret := _mock.Called(id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) error); ok {
+		r0 = returnFunc(id, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockICollectionService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockICollectionService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id int
+//   - claims model.Claims
+func (_e *MockICollectionService_Expecter) Delete(id interface{}, claims interface{}) *MockICollectionService_Delete_Call {
+	return &MockICollectionService_Delete_Call{Call: _e.mock.On("Delete", id, claims)}
+}
+
+func (_c *MockICollectionService_Delete_Call) Run(run func(id int, claims model.Claims)) *MockICollectionService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICollectionService_Delete_Call) Return(err error) *MockICollectionService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockICollectionService_Delete_Call) RunAndReturn(run func(id int, claims model.Claims) error) *MockICollectionService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockICollectionService
+func (_mock *MockICollectionService) Get(claims model.Claims) (model.Collections, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.Collections
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.Collections, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.Collections); ok {
+		r0 = returnFunc(claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Collections)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICollectionService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockICollectionService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockICollectionService_Expecter) Get(claims interface{}) *MockICollectionService_Get_Call {
+	return &MockICollectionService_Get_Call{Call: _e.mock.On("Get", claims)}
+}
+
+func (_c *MockICollectionService_Get_Call) Run(run func(claims model.Claims)) *MockICollectionService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICollectionService_Get_Call) Return(collections model.Collections, err error) *MockICollectionService_Get_Call {
+	_c.Call.Return(collections, err)
+	return _c
+}
+
+func (_c *MockICollectionService_Get_Call) RunAndReturn(run func(claims model.Claims) (model.Collections, error)) *MockICollectionService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockICollectionService
+func (_mock *MockICollectionService) GetByID(id int, claims model.Claims) (model.Collection, model.FoodRecipes, error) {
+	ret := _mock.Called(id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.Collection
+	var r1 model.FoodRecipes
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) (model.Collection, model.FoodRecipes, error)); ok {
+		return returnFunc(id, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) model.Collection); ok {
+		r0 = returnFunc(id, claims)
+	} else {
+		r0 = ret.Get(0).(model.Collection)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, model.Claims) model.FoodRecipes); ok {
+		r1 = returnFunc(id, claims)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(int, model.Claims) error); ok {
+		r2 = returnFunc(id, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockICollectionService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockICollectionService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - id int
+//   - claims model.Claims
+func (_e *MockICollectionService_Expecter) GetByID(id interface{}, claims interface{}) *MockICollectionService_GetByID_Call {
+	return &MockICollectionService_GetByID_Call{Call: _e.mock.On("GetByID", id, claims)}
+}
+
+func (_c *MockICollectionService_GetByID_Call) Run(run func(id int, claims model.Claims)) *MockICollectionService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICollectionService_GetByID_Call) Return(collection model.Collection, foodRecipes model.FoodRecipes, err error) *MockICollectionService_GetByID_Call {
+	_c.Call.Return(collection, foodRecipes, err)
+	return _c
+}
+
+func (_c *MockICollectionService_GetByID_Call) RunAndReturn(run func(id int, claims model.Claims) (model.Collection, model.FoodRecipes, error)) *MockICollectionService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveFromDefault provides a mock function for the type MockICollectionService
+func (_mock *MockICollectionService) RemoveFromDefault(recipeID int, claims model.Claims) error {
+	ret := _mock.Called(recipeID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveFromDefault")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) error); ok {
+		r0 = returnFunc(recipeID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockICollectionService_RemoveFromDefault_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveFromDefault'
+type MockICollectionService_RemoveFromDefault_Call struct {
+	*mock.Call
+}
+
+// RemoveFromDefault is a helper method to define mock.On call
+//   - recipeID int
+//   - claims model.Claims
+func (_e *MockICollectionService_Expecter) RemoveFromDefault(recipeID interface{}, claims interface{}) *MockICollectionService_RemoveFromDefault_Call {
+	return &MockICollectionService_RemoveFromDefault_Call{Call: _e.mock.On("RemoveFromDefault", recipeID, claims)}
+}
+
+func (_c *MockICollectionService_RemoveFromDefault_Call) Run(run func(recipeID int, claims model.Claims)) *MockICollectionService_RemoveFromDefault_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICollectionService_RemoveFromDefault_Call) Return(err error) *MockICollectionService_RemoveFromDefault_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockICollectionService_RemoveFromDefault_Call) RunAndReturn(run func(recipeID int, claims model.Claims) error) *MockICollectionService_RemoveFromDefault_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveRecipe provides a mock function for the type MockICollectionService
+func (_mock *MockICollectionService) RemoveRecipe(id int, recipeID int, claims model.Claims) (model.Collection, error) {
+	ret := _mock.Called(id, recipeID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveRecipe")
+	}
+
+	var r0 model.Collection
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, model.Claims) (model.Collection, error)); ok {
+		return returnFunc(id, recipeID, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int, model.Claims) model.Collection); ok {
+		r0 = returnFunc(id, recipeID, claims)
+	} else {
+		r0 = ret.Get(0).(model.Collection)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int, model.Claims) error); ok {
+		r1 = returnFunc(id, recipeID, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICollectionService_RemoveRecipe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveRecipe'
+type MockICollectionService_RemoveRecipe_Call struct {
+	*mock.Call
+}
+
+// RemoveRecipe is a helper method to define mock.On call
+//   - id int
+//   - recipeID int
+//   - claims model.Claims
+func (_e *MockICollectionService_Expecter) RemoveRecipe(id interface{}, recipeID interface{}, claims interface{}) *MockICollectionService_RemoveRecipe_Call {
+	return &MockICollectionService_RemoveRecipe_Call{Call: _e.mock.On("RemoveRecipe", id, recipeID, claims)}
+}
+
+func (_c *MockICollectionService_RemoveRecipe_Call) Run(run func(id int, recipeID int, claims model.Claims)) *MockICollectionService_RemoveRecipe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICollectionService_RemoveRecipe_Call) Return(collection model.Collection, err error) *MockICollectionService_RemoveRecipe_Call {
+	_c.Call.Return(collection, err)
+	return _c
+}
+
+func (_c *MockICollectionService_RemoveRecipe_Call) RunAndReturn(run func(id int, recipeID int, claims model.Claims) (model.Collection, error)) *MockICollectionService_RemoveRecipe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reorder provides a mock function for the type MockICollectionService
+func (_mock *MockICollectionService) Reorder(request dto.CollectionOrderRequest, claims model.Claims) (model.Collections, error) {
+	ret := _mock.Called(request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reorder")
+	}
+
+	var r0 model.Collections
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.CollectionOrderRequest, model.Claims) (model.Collections, error)); ok {
+		return returnFunc(request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.CollectionOrderRequest, model.Claims) model.Collections); ok {
+		r0 = returnFunc(request, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Collections)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.CollectionOrderRequest, model.Claims) error); ok {
+		r1 = returnFunc(request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICollectionService_Reorder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reorder'
+type MockICollectionService_Reorder_Call struct {
+	*mock.Call
+}
+
+// Reorder is a helper method to define mock.On call
+//   - request dto.CollectionOrderRequest
+//   - claims model.Claims
+func (_e *MockICollectionService_Expecter) Reorder(request interface{}, claims interface{}) *MockICollectionService_Reorder_Call {
+	return &MockICollectionService_Reorder_Call{Call: _e.mock.On("Reorder", request, claims)}
+}
+
+func (_c *MockICollectionService_Reorder_Call) Run(run func(request dto.CollectionOrderRequest, claims model.Claims)) *MockICollectionService_Reorder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.CollectionOrderRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.CollectionOrderRequest)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICollectionService_Reorder_Call) Return(collections model.Collections, err error) *MockICollectionService_Reorder_Call {
+	_c.Call.Return(collections, err)
+	return _c
+}
+
+func (_c *MockICollectionService_Reorder_Call) RunAndReturn(run func(request dto.CollectionOrderRequest, claims model.Claims) (model.Collections, error)) *MockICollectionService_Reorder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockICollectionService
+func (_mock *MockICollectionService) Update(request dto.CollectionRequest, id int, claims model.Claims) (model.Collection, error) {
+	ret := _mock.Called(request, id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.Collection
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.CollectionRequest, int, model.Claims) (model.Collection, error)); ok {
+		return returnFunc(request, id, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.CollectionRequest, int, model.Claims) model.Collection); ok {
+		r0 = returnFunc(request, id, claims)
+	} else {
+		r0 = ret.Get(0).(model.Collection)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.CollectionRequest, int, model.Claims) error); ok {
+		r1 = returnFunc(request, id, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockICollectionService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockICollectionService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - request dto.CollectionRequest
+//   - id int
+//   - claims model.Claims
+func (_e *MockICollectionService_Expecter) Update(request interface{}, id interface{}, claims interface{}) *MockICollectionService_Update_Call {
+	return &MockICollectionService_Update_Call{Call: _e.mock.On("Update", request, id, claims)}
+}
+
+func (_c *MockICollectionService_Update_Call) Run(run func(request dto.CollectionRequest, id int, claims model.Claims)) *MockICollectionService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.CollectionRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.CollectionRequest)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockICollectionService_Update_Call) Return(collection model.Collection, err error) *MockICollectionService_Update_Call {
+	_c.Call.Return(collection, err)
+	return _c
+}
+
+func (_c *MockICollectionService_Update_Call) RunAndReturn(run func(request dto.CollectionRequest, id int, claims model.Claims) (model.Collection, error)) *MockICollectionService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIFoodRecipeService creates a new instance of MockIFoodRecipeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIFoodRecipeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIFoodRecipeService {
+	mock := &MockIFoodRecipeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIFoodRecipeService is an autogenerated mock type for the IFoodRecipeService type
+type MockIFoodRecipeService struct {
+	mock.Mock
+}
+
+type MockIFoodRecipeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIFoodRecipeService) EXPECT() *MockIFoodRecipeService_Expecter {
+	return &MockIFoodRecipeService_Expecter{mock: &_m.Mock}
+}
+
+// BayesianPrior provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) BayesianPrior() (model.BayesianPrior, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BayesianPrior")
+	}
+
+	var r0 model.BayesianPrior
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (model.BayesianPrior, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() model.BayesianPrior); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(model.BayesianPrior)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_BayesianPrior_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BayesianPrior'
+type MockIFoodRecipeService_BayesianPrior_Call struct {
+	*mock.Call
+}
+
+// BayesianPrior is a helper method to define mock.On call
+func (_e *MockIFoodRecipeService_Expecter) BayesianPrior() *MockIFoodRecipeService_BayesianPrior_Call {
+	return &MockIFoodRecipeService_BayesianPrior_Call{Call: _e.mock.On("BayesianPrior")}
+}
+
+func (_c *MockIFoodRecipeService_BayesianPrior_Call) Run(run func()) *MockIFoodRecipeService_BayesianPrior_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_BayesianPrior_Call) Return(bayesianPrior model.BayesianPrior, err error) *MockIFoodRecipeService_BayesianPrior_Call {
+	_c.Call.Return(bayesianPrior, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_BayesianPrior_Call) RunAndReturn(run func() (model.BayesianPrior, error)) *MockIFoodRecipeService_BayesianPrior_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Create(request dto.FoodRecipeRequest, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(request, claims)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipe)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.FoodRecipeRequest, model.Claims) error); ok {
+		r1 = returnFunc(request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIFoodRecipeService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIFoodRecipeService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - request dto.FoodRecipeRequest
+//   - claims model.Claims
+func (_e *MockIFoodRecipeService_Expecter) Create(request interface{}, claims interface{}) *MockIFoodRecipeService_Create_Call {
+	return &MockIFoodRecipeService_Create_Call{Call: _e.mock.On("Create", request, claims)}
+}
+
+func (_c *MockIFoodRecipeService_Create_Call) Run(run func(request dto.FoodRecipeRequest, claims model.Claims)) *MockIFoodRecipeService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.FoodRecipeRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.FoodRecipeRequest)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Create_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIFoodRecipeService_Create_Call {
+	_c.Call.Return(foodRecipe, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Create_Call) RunAndReturn(run func(request dto.FoodRecipeRequest, claims model.Claims) (model.FoodRecipe, error)) *MockIFoodRecipeService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Delete(id int, claims model.Claims) error {
+	ret := _mock.Called(id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) error); ok {
+		r0 = returnFunc(id, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIFoodRecipeService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIFoodRecipeService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id int
+//   - claims model.Claims
+func (_e *MockIFoodRecipeService_Expecter) Delete(id interface{}, claims interface{}) *MockIFoodRecipeService_Delete_Call {
+	return &MockIFoodRecipeService_Delete_Call{Call: _e.mock.On("Delete", id, claims)}
+}
+
+func (_c *MockIFoodRecipeService_Delete_Call) Run(run func(id int, claims model.Claims)) *MockIFoodRecipeService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Delete_Call) Return(err error) *MockIFoodRecipeService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Delete_Call) RunAndReturn(run func(id int, claims model.Claims) error) *MockIFoodRecipeService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exists provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Exists(id int) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exists")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIFoodRecipeService_Exists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exists'
+type MockIFoodRecipeService_Exists_Call struct {
+	*mock.Call
+}
+
+// Exists is a helper method to define mock.On call
+//   - id int
+func (_e *MockIFoodRecipeService_Expecter) Exists(id interface{}) *MockIFoodRecipeService_Exists_Call {
+	return &MockIFoodRecipeService_Exists_Call{Call: _e.mock.On("Exists", id)}
+}
+
+func (_c *MockIFoodRecipeService_Exists_Call) Run(run func(id int)) *MockIFoodRecipeService_Exists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Exists_Call) Return(err error) *MockIFoodRecipeService_Exists_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Exists_Call) RunAndReturn(run func(id int) error) *MockIFoodRecipeService_Exists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Get(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, string, error) {
+	ret := _mock.Called(foodRecipeQuery, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 int64
+	var r2 string
+	var r3 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, model.Claims) (model.FoodRecipes, int64, string, error)); ok {
+		return returnFunc(foodRecipeQuery, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, model.Claims) model.FoodRecipes); ok {
+		r0 = returnFunc(foodRecipeQuery, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery, model.Claims) int64); ok {
+		r1 = returnFunc(foodRecipeQuery, claims)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(model.FoodRecipeQuery, model.Claims) string); ok {
+		r2 = returnFunc(foodRecipeQuery, claims)
+	} else {
+		r2 = ret.Get(2).(string)
+	}
+	if returnFunc, ok := ret.Get(3).(func(model.FoodRecipeQuery, model.Claims) error); ok {
+		r3 = returnFunc(foodRecipeQuery, claims)
+	} else {
+		r3 = ret.Error(3)
+	}
+	return r0, r1, r2, r3
+}
+
+// MockIFoodRecipeService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIFoodRecipeService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - foodRecipeQuery model.FoodRecipeQuery
+//   - claims model.Claims
+func (_e *MockIFoodRecipeService_Expecter) Get(foodRecipeQuery interface{}, claims interface{}) *MockIFoodRecipeService_Get_Call {
+	return &MockIFoodRecipeService_Get_Call{Call: _e.mock.On("Get", foodRecipeQuery, claims)}
+}
+
+func (_c *MockIFoodRecipeService_Get_Call) Run(run func(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims)) *MockIFoodRecipeService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Get_Call) Return(foodRecipes model.FoodRecipes, n int64, s string, err error) *MockIFoodRecipeService_Get_Call {
+	_c.Call.Return(foodRecipes, n, s, err)
+	return _c
+}
+
+func (_c *MockIFoodRecipeService_Get_Call) RunAndReturn(run func(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, string, error)) *MockIFoodRecipeService_Get_Call {
+	_c.Call.Return(run)
+	return _c
 }
 
-// Create provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) Create(favorite *model.Favorite) error {
-	ret := _mock.Called(favorite)
+// GetByID provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) GetByID(id int, query model.FoodRecipeDetailQuery, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(id, query, claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for GetByID")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Favorite) error); ok {
-		r0 = returnFunc(favorite)
+	var r0 model.FoodRecipe
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.FoodRecipeDetailQuery, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(id, query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, model.FoodRecipeDetailQuery, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(id, query, claims)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(model.FoodRecipe)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int, model.FoodRecipeDetailQuery, model.Claims) error); ok {
+		r1 = returnFunc(id, query, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockIRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockIRepository_Create_Call struct {
+// MockIFoodRecipeService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIFoodRecipeService_GetByID_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
-//   - favorite *model.Favorite
-func (_e *MockIRepository_Expecter) Create(favorite interface{}) *MockIRepository_Create_Call {
-	return &MockIRepository_Create_Call{Call: _e.mock.On("Create", favorite)}
+// GetByID is a helper method to define mock.On call
+//   - id int
+//   - query model.FoodRecipeDetailQuery
+//   - claims model.Claims
+func (_e *MockIFoodRecipeService_Expecter) GetByID(id interface{}, query interface{}, claims interface{}) *MockIFoodRecipeService_GetByID_Call {
+	return &MockIFoodRecipeService_GetByID_Call{Call: _e.mock.On("GetByID", id, query, claims)}
 }
 
-func (_c *MockIRepository_Create_Call) Run(run func(favorite *model.Favorite)) *MockIRepository_Create_Call {
+func (_c *MockIFoodRecipeService_GetByID_Call) Run(run func(id int, query model.FoodRecipeDetailQuery, claims model.Claims)) *MockIFoodRecipeService_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Favorite
+		var arg0 int
 		if args[0] != nil {
-			arg0 = args[0].(*model.Favorite)
+			arg0 = args[0].(int)
+		}
+		var arg1 model.FoodRecipeDetailQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.FoodRecipeDetailQuery)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIRepository_Create_Call) Return(err error) *MockIRepository_Create_Call {
-	_c.Call.Return(err)
+func (_c *MockIFoodRecipeService_GetByID_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIFoodRecipeService_GetByID_Call {
+	_c.Call.Return(foodRecipe, err)
 	return _c
 }
 
-func (_c *MockIRepository_Create_Call) RunAndReturn(run func(favorite *model.Favorite) error) *MockIRepository_Create_Call {
+func (_c *MockIFoodRecipeService_GetByID_Call) RunAndReturn(run func(id int, query model.FoodRecipeDetailQuery, claims model.Claims) (model.FoodRecipe, error)) *MockIFoodRecipeService_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Get provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) Get(userID string) (model.Favorites, error) {
-	ret := _mock.Called(userID)
+// GetFacets provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) GetFacets(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipeFacets, error) {
+	ret := _mock.Called(foodRecipeQuery)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Get")
+		panic("no return value specified for GetFacets")
 	}
 
-	var r0 model.Favorites
+	var r0 model.FoodRecipeFacets
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (model.Favorites, error)); ok {
-		return returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) (model.FoodRecipeFacets, error)); ok {
+		return returnFunc(foodRecipeQuery)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) model.Favorites); ok {
-		r0 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) model.FoodRecipeFacets); ok {
+		r0 = returnFunc(foodRecipeQuery)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(model.Favorites)
-		}
+		r0 = ret.Get(0).(model.FoodRecipeFacets)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery) error); ok {
+		r1 = returnFunc(foodRecipeQuery)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
-type MockIRepository_Get_Call struct {
+// MockIFoodRecipeService_GetFacets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFacets'
+type MockIFoodRecipeService_GetFacets_Call struct {
 	*mock.Call
 }
 
-// Get is a helper method to define mock.On call
-//   - userID string
-func (_e *MockIRepository_Expecter) Get(userID interface{}) *MockIRepository_Get_Call {
-	return &MockIRepository_Get_Call{Call: _e.mock.On("Get", userID)}
+// GetFacets is a helper method to define mock.On call
+//   - foodRecipeQuery model.FoodRecipeQuery
+func (_e *MockIFoodRecipeService_Expecter) GetFacets(foodRecipeQuery interface{}) *MockIFoodRecipeService_GetFacets_Call {
+	return &MockIFoodRecipeService_GetFacets_Call{Call: _e.mock.On("GetFacets", foodRecipeQuery)}
 }
 
-func (_c *MockIRepository_Get_Call) Run(run func(userID string)) *MockIRepository_Get_Call {
+func (_c *MockIFoodRecipeService_GetFacets_Call) Run(run func(foodRecipeQuery model.FoodRecipeQuery)) *MockIFoodRecipeService_GetFacets_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 model.FoodRecipeQuery
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(model.FoodRecipeQuery)
 		}
 		run(
 			arg0,
@@ -289,289 +2228,288 @@ func (_c *MockIRepository_Get_Call) Run(run func(userID string)) *MockIRepositor
 	return _c
 }
 
-func (_c *MockIRepository_Get_Call) Return(favorites model.Favorites, err error) *MockIRepository_Get_Call {
-	_c.Call.Return(favorites, err)
+func (_c *MockIFoodRecipeService_GetFacets_Call) Return(foodRecipeFacets model.FoodRecipeFacets, err error) *MockIFoodRecipeService_GetFacets_Call {
+	_c.Call.Return(foodRecipeFacets, err)
 	return _c
 }
 
-func (_c *MockIRepository_Get_Call) RunAndReturn(run func(userID string) (model.Favorites, error)) *MockIRepository_Get_Call {
+func (_c *MockIFoodRecipeService_GetFacets_Call) RunAndReturn(run func(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipeFacets, error)) *MockIFoodRecipeService_GetFacets_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByUser provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) GetByUser(userID string) (model.FoodRecipes, error) {
-	ret := _mock.Called(userID)
+// GetTop provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) GetTop(query model.TopRecipesQuery, claims model.Claims) (model.FoodRecipes, error) {
+	ret := _mock.Called(query, claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByUser")
+		panic("no return value specified for GetTop")
 	}
 
 	var r0 model.FoodRecipes
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (model.FoodRecipes, error)); ok {
-		return returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(model.TopRecipesQuery, model.Claims) (model.FoodRecipes, error)); ok {
+		return returnFunc(query, claims)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) model.FoodRecipes); ok {
-		r0 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(model.TopRecipesQuery, model.Claims) model.FoodRecipes); ok {
+		r0 = returnFunc(query, claims)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(model.FoodRecipes)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(1).(func(model.TopRecipesQuery, model.Claims) error); ok {
+		r1 = returnFunc(query, claims)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIRepository_GetByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUser'
-type MockIRepository_GetByUser_Call struct {
+// MockIFoodRecipeService_GetTop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTop'
+type MockIFoodRecipeService_GetTop_Call struct {
 	*mock.Call
 }
 
-// GetByUser is a helper method to define mock.On call
-//   - userID string
-func (_e *MockIRepository_Expecter) GetByUser(userID interface{}) *MockIRepository_GetByUser_Call {
-	return &MockIRepository_GetByUser_Call{Call: _e.mock.On("GetByUser", userID)}
+// GetTop is a helper method to define mock.On call
+//   - query model.TopRecipesQuery
+//   - claims model.Claims
+func (_e *MockIFoodRecipeService_Expecter) GetTop(query interface{}, claims interface{}) *MockIFoodRecipeService_GetTop_Call {
+	return &MockIFoodRecipeService_GetTop_Call{Call: _e.mock.On("GetTop", query, claims)}
 }
 
-func (_c *MockIRepository_GetByUser_Call) Run(run func(userID string)) *MockIRepository_GetByUser_Call {
+func (_c *MockIFoodRecipeService_GetTop_Call) Run(run func(query model.TopRecipesQuery, claims model.Claims)) *MockIFoodRecipeService_GetTop_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 model.TopRecipesQuery
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(model.TopRecipesQuery)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIRepository_GetByUser_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIRepository_GetByUser_Call {
+func (_c *MockIFoodRecipeService_GetTop_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIFoodRecipeService_GetTop_Call {
 	_c.Call.Return(foodRecipes, err)
 	return _c
 }
 
-func (_c *MockIRepository_GetByUser_Call) RunAndReturn(run func(userID string) (model.FoodRecipes, error)) *MockIRepository_GetByUser_Call {
+func (_c *MockIFoodRecipeService_GetTop_Call) RunAndReturn(run func(query model.TopRecipesQuery, claims model.Claims) (model.FoodRecipes, error)) *MockIFoodRecipeService_GetTop_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockIUserService creates a new instance of MockIUserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockIUserService(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockIUserService {
-	mock := &MockIUserService{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockIUserService is an autogenerated mock type for the IUserService type
-type MockIUserService struct {
-	mock.Mock
-}
-
-type MockIUserService_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockIUserService) EXPECT() *MockIUserService_Expecter {
-	return &MockIUserService_Expecter{mock: &_m.Mock}
-}
-
-// GetByID provides a mock function for the type MockIUserService
-func (_mock *MockIUserService) GetByID(claims model.Claims) (model.User, error) {
-	ret := _mock.Called(claims)
+// MatchPantry provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) MatchPantry(query model.PantryMatchQuery, claims model.Claims) (model.PantryMatches, int64, error) {
+	ret := _mock.Called(query, claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for MatchPantry")
 	}
 
-	var r0 model.User
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
-		return returnFunc(claims)
+	var r0 model.PantryMatches
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(model.PantryMatchQuery, model.Claims) (model.PantryMatches, int64, error)); ok {
+		return returnFunc(query, claims)
 	}
-	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
-		r0 = returnFunc(claims)
+	if returnFunc, ok := ret.Get(0).(func(model.PantryMatchQuery, model.Claims) model.PantryMatches); ok {
+		r0 = returnFunc(query, claims)
 	} else {
-		r0 = ret.Get(0).(model.User)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.PantryMatches)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
-		r1 = returnFunc(claims)
+	if returnFunc, ok := ret.Get(1).(func(model.PantryMatchQuery, model.Claims) int64); ok {
+		r1 = returnFunc(query, claims)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int64)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(model.PantryMatchQuery, model.Claims) error); ok {
+		r2 = returnFunc(query, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
-// MockIUserService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockIUserService_GetByID_Call struct {
+// MockIFoodRecipeService_MatchPantry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MatchPantry'
+type MockIFoodRecipeService_MatchPantry_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// MatchPantry is a helper method to define mock.On call
+//   - query model.PantryMatchQuery
 //   - claims model.Claims
-func (_e *MockIUserService_Expecter) GetByID(claims interface{}) *MockIUserService_GetByID_Call {
-	return &MockIUserService_GetByID_Call{Call: _e.mock.On("GetByID", claims)}
+func (_e *MockIFoodRecipeService_Expecter) MatchPantry(query interface{}, claims interface{}) *MockIFoodRecipeService_MatchPantry_Call {
+	return &MockIFoodRecipeService_MatchPantry_Call{Call: _e.mock.On("MatchPantry", query, claims)}
 }
 
-func (_c *MockIUserService_GetByID_Call) Run(run func(claims model.Claims)) *MockIUserService_GetByID_Call {
+func (_c *MockIFoodRecipeService_MatchPantry_Call) Run(run func(query model.PantryMatchQuery, claims model.Claims)) *MockIFoodRecipeService_MatchPantry_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 model.Claims
+		var arg0 model.PantryMatchQuery
 		if args[0] != nil {
-			arg0 = args[0].(model.Claims)
+			arg0 = args[0].(model.PantryMatchQuery)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIUserService_GetByID_Call) Return(user model.User, err error) *MockIUserService_GetByID_Call {
-	_c.Call.Return(user, err)
+func (_c *MockIFoodRecipeService_MatchPantry_Call) Return(pantryMatches model.PantryMatches, n int64, err error) *MockIFoodRecipeService_MatchPantry_Call {
+	_c.Call.Return(pantryMatches, n, err)
 	return _c
 }
 
-func (_c *MockIUserService_GetByID_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIUserService_GetByID_Call {
+func (_c *MockIFoodRecipeService_MatchPantry_Call) RunAndReturn(run func(query model.PantryMatchQuery, claims model.Claims) (model.PantryMatches, int64, error)) *MockIFoodRecipeService_MatchPantry_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRecipes provides a mock function for the type MockIUserService
-func (_mock *MockIUserService) GetRecipes(userID string, claims model.Claims) (model.FoodRecipes, error) {
-	ret := _mock.Called(userID, claims)
+// Suggest provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Suggest(query model.SuggestQuery) (model.Suggestions, error) {
+	ret := _mock.Called(query)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRecipes")
+		panic("no return value specified for Suggest")
 	}
 
-	var r0 model.FoodRecipes
+	var r0 model.Suggestions
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) (model.FoodRecipes, error)); ok {
-		return returnFunc(userID, claims)
+	if returnFunc, ok := ret.Get(0).(func(model.SuggestQuery) (model.Suggestions, error)); ok {
+		return returnFunc(query)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) model.FoodRecipes); ok {
-		r0 = returnFunc(userID, claims)
+	if returnFunc, ok := ret.Get(0).(func(model.SuggestQuery) model.Suggestions); ok {
+		r0 = returnFunc(query)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(model.FoodRecipes)
+			r0 = ret.Get(0).(model.Suggestions)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, model.Claims) error); ok {
-		r1 = returnFunc(userID, claims)
+	if returnFunc, ok := ret.Get(1).(func(model.SuggestQuery) error); ok {
+		r1 = returnFunc(query)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIUserService_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
-type MockIUserService_GetRecipes_Call struct {
+// MockIFoodRecipeService_Suggest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Suggest'
+type MockIFoodRecipeService_Suggest_Call struct {
 	*mock.Call
 }
 
-// GetRecipes is a helper method to define mock.On call
-//   - userID string
-//   - claims model.Claims
-func (_e *MockIUserService_Expecter) GetRecipes(userID interface{}, claims interface{}) *MockIUserService_GetRecipes_Call {
-	return &MockIUserService_GetRecipes_Call{Call: _e.mock.On("GetRecipes", userID, claims)}
+// Suggest is a helper method to define mock.On call
+//   - query model.SuggestQuery
+func (_e *MockIFoodRecipeService_Expecter) Suggest(query interface{}) *MockIFoodRecipeService_Suggest_Call {
+	return &MockIFoodRecipeService_Suggest_Call{Call: _e.mock.On("Suggest", query)}
 }
 
-func (_c *MockIUserService_GetRecipes_Call) Run(run func(userID string, claims model.Claims)) *MockIUserService_GetRecipes_Call {
+func (_c *MockIFoodRecipeService_Suggest_Call) Run(run func(query model.SuggestQuery)) *MockIFoodRecipeService_Suggest_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 model.SuggestQuery
 		if args[0] != nil {
-			arg0 = args[0].(string)
-		}
-		var arg1 model.Claims
-		if args[1] != nil {
-			arg1 = args[1].(model.Claims)
+			arg0 = args[0].(model.SuggestQuery)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIUserService_GetRecipes_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIUserService_GetRecipes_Call {
-	_c.Call.Return(foodRecipes, err)
+func (_c *MockIFoodRecipeService_Suggest_Call) Return(suggestions model.Suggestions, err error) *MockIFoodRecipeService_Suggest_Call {
+	_c.Call.Return(suggestions, err)
 	return _c
 }
 
-func (_c *MockIUserService_GetRecipes_Call) RunAndReturn(run func(userID string, claims model.Claims) (model.FoodRecipes, error)) *MockIUserService_GetRecipes_Call {
+func (_c *MockIFoodRecipeService_Suggest_Call) RunAndReturn(run func(query model.SuggestQuery) (model.Suggestions, error)) *MockIFoodRecipeService_Suggest_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpsertWithClaims provides a mock function for the type MockIUserService
-func (_mock *MockIUserService) UpsertWithClaims(claims model.Claims) (model.User, error) {
-	ret := _mock.Called(claims)
+// Update provides a mock function for the type MockIFoodRecipeService
+func (_mock *MockIFoodRecipeService) Update(request dto.FoodRecipeRequest, id int, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(request, id, claims)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpsertWithClaims")
+		panic("no return value specified for Update")
 	}
 
-	var r0 model.User
+	var r0 model.FoodRecipe
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
-		return returnFunc(claims)
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, int, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(request, id, claims)
 	}
-	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
-		r0 = returnFunc(claims)
+	if returnFunc, ok := ret.Get(0).(func(dto.FoodRecipeRequest, int, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(request, id, claims)
 	} else {
-		r0 = ret.Get(0).(model.User)
+		r0 = ret.Get(0).(model.FoodRecipe)
 	}
-	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
-		r1 = returnFunc(claims)
+	if returnFunc, ok := ret.Get(1).(func(dto.FoodRecipeRequest, int, model.Claims) error); ok {
+		r1 = returnFunc(request, id, claims)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIUserService_UpsertWithClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertWithClaims'
-type MockIUserService_UpsertWithClaims_Call struct {
+// MockIFoodRecipeService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIFoodRecipeService_Update_Call struct {
 	*mock.Call
 }
 
-// UpsertWithClaims is a helper method to define mock.On call
+// Update is a helper method to define mock.On call
+//   - request dto.FoodRecipeRequest
+//   - id int
 //   - claims model.Claims
-func (_e *MockIUserService_Expecter) UpsertWithClaims(claims interface{}) *MockIUserService_UpsertWithClaims_Call {
-	return &MockIUserService_UpsertWithClaims_Call{Call: _e.mock.On("UpsertWithClaims", claims)}
+func (_e *MockIFoodRecipeService_Expecter) Update(request interface{}, id interface{}, claims interface{}) *MockIFoodRecipeService_Update_Call {
+	return &MockIFoodRecipeService_Update_Call{Call: _e.mock.On("Update", request, id, claims)}
 }
 
-func (_c *MockIUserService_UpsertWithClaims_Call) Run(run func(claims model.Claims)) *MockIUserService_UpsertWithClaims_Call {
+func (_c *MockIFoodRecipeService_Update_Call) Run(run func(request dto.FoodRecipeRequest, id int, claims model.Claims)) *MockIFoodRecipeService_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 model.Claims
+		var arg0 dto.FoodRecipeRequest
 		if args[0] != nil {
-			arg0 = args[0].(model.Claims)
+			arg0 = args[0].(dto.FoodRecipeRequest)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIUserService_UpsertWithClaims_Call) Return(user model.User, err error) *MockIUserService_UpsertWithClaims_Call {
-	_c.Call.Return(user, err)
+func (_c *MockIFoodRecipeService_Update_Call) Return(foodRecipe model.FoodRecipe, err error) *MockIFoodRecipeService_Update_Call {
+	_c.Call.Return(foodRecipe, err)
 	return _c
 }
 
-func (_c *MockIUserService_UpsertWithClaims_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIUserService_UpsertWithClaims_Call {
+func (_c *MockIFoodRecipeService_Update_Call) RunAndReturn(run func(request dto.FoodRecipeRequest, id int, claims model.Claims) (model.FoodRecipe, error)) *MockIFoodRecipeService_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -604,8 +2542,8 @@ func (_m *MockIService) EXPECT() *MockIService_Expecter {
 }
 
 // Create provides a mock function for the type MockIService
-func (_mock *MockIService) Create(request dto.FavoriteRequest, claims model.Claims) (model.Favorite, error) {
-	ret := _mock.Called(request, claims)
+func (_mock *MockIService) Create(id int, claims model.Claims) (model.Favorite, error) {
+	ret := _mock.Called(id, claims)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -613,16 +2551,16 @@ func (_mock *MockIService) Create(request dto.FavoriteRequest, claims model.Clai
 
 	var r0 model.Favorite
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(dto.FavoriteRequest, model.Claims) (model.Favorite, error)); ok {
-		return returnFunc(request, claims)
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) (model.Favorite, error)); ok {
+		return returnFunc(id, claims)
 	}
-	if returnFunc, ok := ret.Get(0).(func(dto.FavoriteRequest, model.Claims) model.Favorite); ok {
-		r0 = returnFunc(request, claims)
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) model.Favorite); ok {
+		r0 = returnFunc(id, claims)
 	} else {
 		r0 = ret.Get(0).(model.Favorite)
 	}
-	if returnFunc, ok := ret.Get(1).(func(dto.FavoriteRequest, model.Claims) error); ok {
-		r1 = returnFunc(request, claims)
+	if returnFunc, ok := ret.Get(1).(func(int, model.Claims) error); ok {
+		r1 = returnFunc(id, claims)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -635,17 +2573,17 @@ type MockIService_Create_Call struct {
 }
 
 // Create is a helper method to define mock.On call
-//   - request dto.FavoriteRequest
+//   - id int
 //   - claims model.Claims
-func (_e *MockIService_Expecter) Create(request interface{}, claims interface{}) *MockIService_Create_Call {
-	return &MockIService_Create_Call{Call: _e.mock.On("Create", request, claims)}
+func (_e *MockIService_Expecter) Create(id interface{}, claims interface{}) *MockIService_Create_Call {
+	return &MockIService_Create_Call{Call: _e.mock.On("Create", id, claims)}
 }
 
-func (_c *MockIService_Create_Call) Run(run func(request dto.FavoriteRequest, claims model.Claims)) *MockIService_Create_Call {
+func (_c *MockIService_Create_Call) Run(run func(id int, claims model.Claims)) *MockIService_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 dto.FavoriteRequest
+		var arg0 int
 		if args[0] != nil {
-			arg0 = args[0].(dto.FavoriteRequest)
+			arg0 = args[0].(int)
 		}
 		var arg1 model.Claims
 		if args[1] != nil {
@@ -664,7 +2602,64 @@ func (_c *MockIService_Create_Call) Return(favorite model.Favorite, err error) *
 	return _c
 }
 
-func (_c *MockIService_Create_Call) RunAndReturn(run func(request dto.FavoriteRequest, claims model.Claims) (model.Favorite, error)) *MockIService_Create_Call {
+func (_c *MockIService_Create_Call) RunAndReturn(run func(id int, claims model.Claims) (model.Favorite, error)) *MockIService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIService
+func (_mock *MockIService) Delete(id int, claims model.Claims) error {
+	ret := _mock.Called(id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) error); ok {
+		r0 = returnFunc(id, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Delete(id interface{}, claims interface{}) *MockIService_Delete_Call {
+	return &MockIService_Delete_Call{Call: _e.mock.On("Delete", id, claims)}
+}
+
+func (_c *MockIService_Delete_Call) Run(run func(id int, claims model.Claims)) *MockIService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Delete_Call) Return(err error) *MockIService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIService_Delete_Call) RunAndReturn(run func(id int, claims model.Claims) error) *MockIService_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -732,31 +2727,43 @@ func (_c *MockIService_Get_Call) RunAndReturn(run func(userID string) (model.Fav
 }
 
 // GetByUser provides a mock function for the type MockIService
-func (_mock *MockIService) GetByUser(claims model.Claims) (model.FoodRecipes, error) {
-	ret := _mock.Called(claims)
+func (_mock *MockIService) GetByUser(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, string, error) {
+	ret := _mock.Called(foodRecipeQuery, claims)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByUser")
 	}
 
 	var r0 model.FoodRecipes
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.FoodRecipes, error)); ok {
-		return returnFunc(claims)
+	var r1 int64
+	var r2 string
+	var r3 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, model.Claims) (model.FoodRecipes, int64, string, error)); ok {
+		return returnFunc(foodRecipeQuery, claims)
 	}
-	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.FoodRecipes); ok {
-		r0 = returnFunc(claims)
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, model.Claims) model.FoodRecipes); ok {
+		r0 = returnFunc(foodRecipeQuery, claims)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(model.FoodRecipes)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
-		r1 = returnFunc(claims)
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery, model.Claims) int64); ok {
+		r1 = returnFunc(foodRecipeQuery, claims)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int64)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(model.FoodRecipeQuery, model.Claims) string); ok {
+		r2 = returnFunc(foodRecipeQuery, claims)
+	} else {
+		r2 = ret.Get(2).(string)
+	}
+	if returnFunc, ok := ret.Get(3).(func(model.FoodRecipeQuery, model.Claims) error); ok {
+		r3 = returnFunc(foodRecipeQuery, claims)
+	} else {
+		r3 = ret.Error(3)
+	}
+	return r0, r1, r2, r3
 }
 
 // MockIService_GetByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUser'
@@ -765,30 +2772,36 @@ type MockIService_GetByUser_Call struct {
 }
 
 // GetByUser is a helper method to define mock.On call
+//   - foodRecipeQuery model.FoodRecipeQuery
 //   - claims model.Claims
-func (_e *MockIService_Expecter) GetByUser(claims interface{}) *MockIService_GetByUser_Call {
-	return &MockIService_GetByUser_Call{Call: _e.mock.On("GetByUser", claims)}
+func (_e *MockIService_Expecter) GetByUser(foodRecipeQuery interface{}, claims interface{}) *MockIService_GetByUser_Call {
+	return &MockIService_GetByUser_Call{Call: _e.mock.On("GetByUser", foodRecipeQuery, claims)}
 }
 
-func (_c *MockIService_GetByUser_Call) Run(run func(claims model.Claims)) *MockIService_GetByUser_Call {
+func (_c *MockIService_GetByUser_Call) Run(run func(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims)) *MockIService_GetByUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 model.Claims
+		var arg0 model.FoodRecipeQuery
 		if args[0] != nil {
-			arg0 = args[0].(model.Claims)
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIService_GetByUser_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIService_GetByUser_Call {
-	_c.Call.Return(foodRecipes, err)
+func (_c *MockIService_GetByUser_Call) Return(foodRecipes model.FoodRecipes, n int64, s string, err error) *MockIService_GetByUser_Call {
+	_c.Call.Return(foodRecipes, n, s, err)
 	return _c
 }
 
-func (_c *MockIService_GetByUser_Call) RunAndReturn(run func(claims model.Claims) (model.FoodRecipes, error)) *MockIService_GetByUser_Call {
+func (_c *MockIService_GetByUser_Call) RunAndReturn(run func(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, string, error)) *MockIService_GetByUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -41,7 +41,7 @@ func (repo Repository) Get(userID string) (model.Favorites, error) {
 // ดึงรายการสูตรอาหารที่ผู้ใช้กด Favorite โดยกรองเฉพาะรายการที่ยังไม่ถูกลบ (soft delete)
 // - JOIN กับตาราง favorites และเช็ค fav.deleted_at IS NULL
 // - Preload ความสัมพันธ์ที่จำเป็น โดย scope ตาม userID (เพื่อรู้ว่าผู้ใช้คนนี้ favorite/rating ไว้ไหม)
-// - รองรับค้นหา + เรียง + แบ่งหน้า
+// - รองรับค้นหา + เรียง + แบ่งหน้า (page/limit หรือ cursor)
 func (repo Repository) GetByUser(query model.FoodRecipeQuery, userID string) (model.FoodRecipes, error) {
 	var recipes = make(model.FoodRecipes, 0)
 	offset := (query.Page - 1) * query.Limit
@@ -55,13 +55,28 @@ func (repo Repository) GetByUser(query model.FoodRecipeQuery, userID string) (mo
 		Preload("CookingDuration").
 		Preload("Difficulty").
 		Preload("User").
-		Preload("Ratings")
+		Preload("Ingredients", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position asc")
+		}).
+		Preload("Tags")
 
 	if query.Search != "" {
-		db = db.Where("food_recipes.name LIKE ? OR food_recipes.description LIKE ?", "%"+query.Search+"%", "%"+query.Search+"%")
+		db = db.Where(model.RecipeSearchCondition, query.Search)
 	}
 
-	if err := db.Order("food_recipes.name asc").Limit(query.Limit).Offset(offset).Find(&recipes).Error; err != nil {
+	if query.Cursor != "" {
+		after, err := query.AfterCursor()
+		if err != nil {
+			return nil, err
+		}
+		db = db.Where(after)
+	} else {
+		db = db.Offset(offset)
+	}
+
+	// ดึงเกินมา 1 แถวเพื่อรู้ว่ายังมีหน้าถัดไป (ตัดออกใน FoodRecipes.Page)
+	selectWithSortKey := query.SelectWithSortKey()
+	if err := db.Select(selectWithSortKey.SQL, selectWithSortKey.Vars...).Clauses(query.OrderBy()).Limit(query.Limit + 1).Find(&recipes).Error; err != nil {
 		return nil, err
 	}
 	return recipes, nil
@@ -124,7 +139,7 @@ func (repo Repository) Count(UserID string, search string) (int64, error) {
 		Where("fav.user_id = ? AND fav.deleted_at IS NULL", UserID)
 
 	if search != "" {
-		db = db.Where("food_recipes.name LIKE ? OR food_recipes.description LIKE ?", "%"+search+"%", "%"+search+"%")
+		db = db.Where(model.RecipeSearchCondition, search)
 	}
 
 	if err := db.Count(&count).Error; err != nil {
@@ -1,8 +1,11 @@
 package favorite
 
 import (
+	"wongnok/internal/collection"
+	"wongnok/internal/foodrecipe"
 	"wongnok/internal/global"
 	"wongnok/internal/model"
+	"wongnok/internal/units"
 	"wongnok/internal/user"
 
 	"github.com/pkg/errors"
@@ -10,37 +13,60 @@ import (
 )
 
 type IUserService user.IService
+type ICollectionService collection.IService
+type IFoodRecipeService foodrecipe.IService
 
 type IService interface {
 	Get(userID string) (model.Favorites, error)
-	GetByUser(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, error)
+	GetByUser(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, string, error)
 	Create(id int, claims model.Claims) (model.Favorite, error)
 	Delete(id int, claims model.Claims) error
 }
 
 type Service struct {
-	Repository  IRepository
-	UserService IUserService
+	Repository        IRepository
+	UserService       IUserService
+	CollectionService ICollectionService
+	FoodRecipeService IFoodRecipeService
 }
 
 func NewService(db *gorm.DB) IService {
 	return &Service{
-		Repository:  NewRepository(db),
-		UserService: user.NewService(db),
+		Repository:        NewRepository(db),
+		UserService:       user.NewService(db),
+		CollectionService: collection.NewService(db),
+		FoodRecipeService: foodrecipe.NewService(db),
 	}
 }
 
-func (service Service) GetByUser(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, error) {
-	total, err := service.Repository.Count(claims.ID, foodRecipeQuery.Search)
+func (service Service) GetByUser(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, string, error) {
+	// prior เดียวกับรายการสูตรทั้งหมด (ค่าเฉลี่ยที่ cache ไว้) ไม่อย่างนั้น Mean เป็น 0 จะคำนวณใหม่ใน SQL ทุกหน้า
+	prior, err := service.FoodRecipeService.BayesianPrior()
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", errors.Wrap(err, "bayesian prior")
+	}
+	foodRecipeQuery = foodRecipeQuery.WithPrior(prior)
+
+	var total int64
+	if foodRecipeQuery.Cursor == "" {
+		count, err := service.Repository.Count(claims.ID, foodRecipeQuery.Search)
+		if err != nil {
+			return nil, 0, "", err
+		}
+		total = count
 	}
 	recipes, err := service.Repository.GetByUser(foodRecipeQuery, claims.ID)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
 	}
+	recipes, nextCursor := recipes.Page(foodRecipeQuery)
 	recipes = recipes.CalculateAverageRatings()
-	return recipes, total, nil
+
+	if system, ok := units.ParseSystem(foodRecipeQuery.Units); ok {
+		recipes = recipes.ConvertUnits(system)
+	}
+
+	return recipes, total, nextCursor, nil
 }
 
 func (service Service) Get(userID string) (model.Favorites, error) {
@@ -52,34 +78,22 @@ func (service Service) Get(userID string) (model.Favorites, error) {
 	return favorites, nil
 }
 
+// Create ปุ่ม favorite คือการเพิ่มสูตรเข้า collection เริ่มต้นของผู้ใช้
+// collection จะสร้าง favorite ใหม่หรือกู้คืน favorite เดิมที่เคยลบไว้ให้ใน transaction เดียวกัน
 func (service Service) Create(id int, claims model.Claims) (model.Favorite, error) {
-
-	userID, err := service.UserService.GetByID(claims)
-	if err != nil {
-		return model.Favorite{}, errors.Wrap(err, "create Favorite")
+	if err := service.CollectionService.AddToDefault(id, claims); err != nil {
+		return model.Favorite{}, errors.Wrap(err, "create favorite")
 	}
 
-	favoriteFromGet, err := service.Repository.GetDeleteByID(id, claims.ID)
+	favorite, err := service.Repository.GetByID(id, claims.ID)
 	if err != nil {
-		var favorite model.Favorite
-		favorite.FoodRecipeID = uint(id)
-		favorite.UserID = userID.ID
-
-		if err := service.Repository.Create(&favorite); err != nil {
-			return model.Favorite{}, errors.Wrap(err, "create favorite")
-		}
-
-		return favorite, nil
+		return model.Favorite{}, errors.Wrap(err, "find favorite")
 	}
 
-	if err := service.Repository.Update(int(favoriteFromGet.ID)); err != nil {
-		return model.Favorite{}, errors.Wrap(err, "update Favorite")
-	}
-
-	return favoriteFromGet, err
-
+	return favorite, nil
 }
 
+// Delete ยกเลิก favorite คือการเอาสูตรออกจาก collection เริ่มต้น
 func (service Service) Delete(id int, claims model.Claims) error {
 	favorite, err := service.Repository.GetByID(id, claims.ID)
 	if err != nil {
@@ -93,5 +107,5 @@ func (service Service) Delete(id int, claims model.Claims) error {
 		return global.ErrForbidden
 	}
 
-	return service.Repository.Delete(int(favorite.ID))
+	return service.CollectionService.RemoveFromDefault(int(favorite.FoodRecipeID), claims)
 }
@@ -0,0 +1,109 @@
+package favorite_test
+
+import (
+	"reflect"
+	"testing"
+	"wongnok/internal/favorite"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewService(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		service := favorite.NewService(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(service))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+type ServiceGetByUserTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service           favorite.IService
+	repo              *MockIRepository
+	foodRecipeService *MockIFoodRecipeService
+
+	// Mock data
+	prior         model.BayesianPrior
+	errPrior      error
+	respGetByUser model.FoodRecipes
+	errGetByUser  error
+	respCount     int64
+}
+
+// This will run before each test
+func (suite *ServiceGetByUserTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.foodRecipeService = new(MockIFoodRecipeService)
+	suite.service = &favorite.Service{
+		Repository:        suite.repo,
+		FoodRecipeService: suite.foodRecipeService,
+	}
+
+	suite.prior = model.BayesianPrior{MinVotes: 5, Mean: 3.8}
+	suite.errPrior = nil
+	suite.respGetByUser = model.FoodRecipes{{Model: gorm.Model{ID: 1}, Name: "Omelet"}}
+	suite.errGetByUser = nil
+	suite.respCount = 1
+
+	suite.foodRecipeService.On("BayesianPrior").Return(func() (model.BayesianPrior, error) {
+		return suite.prior, suite.errPrior
+	})
+	suite.repo.On("Count", mock.Anything, mock.Anything).Return(func(string, string) (int64, error) {
+		return suite.respCount, nil
+	})
+	suite.repo.On("GetByUser", mock.Anything, mock.Anything).Return(func(model.FoodRecipeQuery, string) (model.FoodRecipes, error) {
+		return suite.respGetByUser, suite.errGetByUser
+	})
+}
+
+func (suite *ServiceGetByUserTestSuite) TestUseCachedPrior() {
+	recipes, total, _, err := suite.service.GetByUser(model.FoodRecipeQuery{Sort: model.SortTopRated, Page: 1, Limit: 10}, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.Len(recipes, 1)
+	suite.Equal(int64(1), total)
+	suite.repo.AssertCalled(suite.T(), "GetByUser", mock.MatchedBy(func(query model.FoodRecipeQuery) bool {
+		return query.Prior == suite.prior
+	}), "UID")
+}
+
+func (suite *ServiceGetByUserTestSuite) TestUseMeanFromCursor() {
+	cursor := model.RecipeCursor{Sort: model.SortTopRated, Key: "4.2", ID: 7, Mean: 4.1}
+
+	_, total, _, err := suite.service.GetByUser(model.FoodRecipeQuery{Sort: model.SortTopRated, Limit: 10, Cursor: cursor.Encode()}, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	// หน้าถัดไปใช้ค่าเฉลี่ยเดียวกับตอนสร้าง cursor และไม่นับจำนวนซ้ำ
+	suite.Zero(total)
+	suite.repo.AssertNotCalled(suite.T(), "Count", mock.Anything, mock.Anything)
+	suite.repo.AssertCalled(suite.T(), "GetByUser", mock.MatchedBy(func(query model.FoodRecipeQuery) bool {
+		return query.Prior.Mean == 4.1 && query.Prior.MinVotes == suite.prior.MinVotes
+	}), "UID")
+}
+
+func (suite *ServiceGetByUserTestSuite) TestErrorWhenGetPrior() {
+	suite.errPrior = assert.AnError
+
+	recipes, _, _, err := suite.service.GetByUser(model.FoodRecipeQuery{Page: 1, Limit: 10}, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, assert.AnError)
+
+	suite.Empty(recipes)
+	suite.repo.AssertNotCalled(suite.T(), "GetByUser", mock.Anything, mock.Anything)
+}
+
+func TestServiceGetByUser(t *testing.T) {
+	suite.Run(t, new(ServiceGetByUserTestSuite))
+}
@@ -18,6 +18,9 @@ type IHandler interface {
 	Create(ctx *gin.Context)
 	Get(ctx *gin.Context)
 	GetByID(ctx *gin.Context)
+	Suggest(ctx *gin.Context)
+	GetTop(ctx *gin.Context)
+	MatchPantry(ctx *gin.Context)
 	Update(ctx *gin.Context)
 	Delete(ctx *gin.Context)
 }
@@ -67,6 +70,10 @@ func (handler Handler) Create(ctx *gin.Context) {
 			statusCode = http.StatusBadRequest
 		}
 
+		if errors.Is(err, global.ErrInvalidTag) {
+			statusCode = http.StatusBadRequest
+		}
+
 		if errors.Is(err, global.ErrForbidden) {
 			statusCode = http.StatusForbidden
 		}
@@ -84,9 +91,22 @@ func (handler Handler) Create(ctx *gin.Context) {
 // @Tags food-recipes
 // @Accept json
 // @Produce json
-// @Param page query int true "Page number" (default 1)
+// @Param page query int false "Page number, required unless cursor is given" (default 1)
 // @Param limit query int true "Items per page" (default 10)
+// @Param cursor query string false "nextCursor from the previous page (keyset pagination)"
 // @Param search query string false "Search term"
+// @Param sort query string false "Sort order (newest, oldest, highest-rated, top-rated, most-rated, most-favorited, name)"
+// @Param units query string false "Convert ingredient units (metric or imperial)"
+// @Param tags query string false "Comma-separated tag slugs, recipes must have all of them"
+// @Param difficulty query int false "Difficulty ID"
+// @Param cookingDuration query int false "Cooking duration ID"
+// @Param author query string false "Author user ID"
+// @Param minRating query number false "Minimum average rating"
+// @Param createdAfter query string false "Created on or after date (YYYY-MM-DD)"
+// @Param createdBefore query string false "Created before date (YYYY-MM-DD)"
+// @Param hasImage query bool false "Only recipes with (true) or without (false) an image"
+// @Param exclude_allergens query string false "Comma-separated allergens the recipe must not contain (peanut, tree-nut, shellfish, fish, gluten, dairy, egg, soy, sesame)"
+// @Param diet query string false "Comma-separated diets the recipe must suit (vegetarian, vegan, halal-friendly, no-pork)"
 // @Success 200 {object} dto.FoodRecipesResponse
 // @Failure 400 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
@@ -103,13 +123,131 @@ func (handler Handler) Get(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
 		return
 	}
-	recipes, total, err := handler.Service.Get(foodRecipeQuery, claims)
+	recipes, total, nextCursor, err := handler.Service.Get(foodRecipeQuery, claims)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, global.ErrInvalidCursor) || errors.Is(err, global.ErrInvalidDietary) {
+			statusCode = http.StatusBadRequest
+		}
+
+		ctx.JSON(statusCode, gin.H{"message": err.Error()})
+		return
+	}
+
+	response := recipes.ToResponse(total)
+	response.NextCursor = nextCursor
+
+	// facet นับจากทั้งชุดผลลัพธ์ จึงส่งเฉพาะหน้าแรก ไม่ต้องนับซ้ำทุกครั้งที่เลื่อนด้วย cursor
+	if foodRecipeQuery.Cursor == "" {
+		facets, err := handler.Service.GetFacets(foodRecipeQuery)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+			return
+		}
+		response.Facets = facets.ToResponse()
+	}
+
+	ctx.JSON(http.StatusOK, response)
+
+}
+
+// Suggest godoc
+// @Summary Suggest recipes, tags and ingredients
+// @Description Typo-tolerant autocomplete for the search box
+// @Tags food-recipes
+// @Accept json
+// @Produce json
+// @Param q query string true "Text typed so far"
+// @Param limit query int false "Maximum suggestions per type" (default 5)
+// @Success 200 {object} dto.SuggestionsResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/food-recipes/suggest [get]
+func (handler Handler) Suggest(ctx *gin.Context) {
+	var query model.SuggestQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	suggestions, err := handler.Service.Suggest(query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, suggestions.ToResponse())
+}
+
+// GetTop godoc
+// @Summary Get top rated food recipes
+// @Description Recipes ranked by Bayesian average, so a few high scores do not outrank many consistent ones
+// @Tags food-recipes
+// @Accept json
+// @Produce json
+// @Param limit query int false "Number of recipes" (default 10)
+// @Param units query string false "Convert ingredient units (metric or imperial)"
+// @Success 200 {object} dto.FoodRecipesResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/food-recipes/top [get]
+func (handler Handler) GetTop(ctx *gin.Context) {
+	claims, err := helper.DecodeClaimsFromHeader(ctx, global.Verifier)
+	if err != nil {
+		claims.ID = ""
+	}
+
+	var query model.TopRecipesQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	recipes, err := handler.Service.GetTop(query, claims)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, recipes.ToResponse(0))
+}
+
+// MatchPantry godoc
+// @Summary What can I cook
+// @Description Recipes ranked by the share of their ingredients already in your pantry, with the ingredients still missing
+// @Tags food-recipes
+// @Accept json
+// @Produce json
+// @Param maxMissing query int false "Only recipes missing at most this many ingredients"
+// @Param page query int false "Page number" (default 1)
+// @Param limit query int false "Recipes per page (max 50)" (default 20)
+// @Param units query string false "Convert ingredient units (metric or imperial)"
+// @Success 200 {object} dto.PantryMatchesResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/food-recipes/match-pantry [get]
+func (handler Handler) MatchPantry(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var query model.PantryMatchQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	matches, total, err := handler.Service.MatchPantry(query, claims)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
 		return
 	}
-	ctx.JSON(http.StatusOK, recipes.ToResponse(total))
 
+	ctx.JSON(http.StatusOK, matches.ToResponse(total))
 }
 
 // GetByID godoc
@@ -119,6 +257,8 @@ func (handler Handler) Get(ctx *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path int true "Recipe ID"
+// @Param servings query int false "Scale ingredient quantities to this number of servings"
+// @Param units query string false "Convert ingredient units (metric or imperial)"
 // @Success 200 {object} dto.FoodRecipeResponse
 // @Failure 400 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
@@ -138,7 +278,13 @@ func (handler Handler) GetByID(ctx *gin.Context) {
 		}
 	}
 
-	recipe, err := handler.Service.GetByID(id, claims)
+	var query model.FoodRecipeDetailQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	recipe, err := handler.Service.GetByID(id, query, claims)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			ctx.JSON(http.StatusInternalServerError, gin.H{"message": "Recipe not found"})
@@ -148,7 +294,11 @@ func (handler Handler) GetByID(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, recipe.ToResponse())
+	// หน้ารายละเอียดแสดงการกระจายของคะแนนด้วย
+	response := recipe.ToResponse()
+	response.RatingHistogram = recipe.RatingHistogram.ToResponse()
+
+	ctx.JSON(http.StatusOK, response)
 }
 
 // Update godoc
@@ -195,6 +345,10 @@ func (handler Handler) Update(ctx *gin.Context) {
 			statusCode = http.StatusBadRequest
 		}
 
+		if errors.Is(err, global.ErrInvalidTag) {
+			statusCode = http.StatusBadRequest
+		}
+
 		if errors.Is(err, global.ErrForbidden) {
 			statusCode = http.StatusForbidden
 		}
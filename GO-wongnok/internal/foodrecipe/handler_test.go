@@ -118,10 +118,7 @@ func (suite *HandlerCreateTestSuite) TestResponseRecipeWithStatusCode201() {
 	body := response.Result().Body
 	defer body.Close()
 
-	expectedResponse := dto.FoodRecipeResponse{
-		Name: "Name",
-	}
-	expectedJson, _ := json.Marshal(expectedResponse)
+	expectedJson, _ := json.Marshal(suite.respServiceCreate.ToResponse())
 
 	suite.Equal(http.StatusCreated, response.Code)
 	suite.Equal(string(expectedJson), response.Body.String())
@@ -220,9 +217,13 @@ type HandlerGetTestSuite struct {
 	service *MockIService
 
 	// Mock data
+	query                   string
 	respRecipesInServiceGet model.FoodRecipes
 	respTotalInServiceGet   int64
+	respNextCursor          string
 	errServiceGet           error
+	respFacets              model.FoodRecipeFacets
+	errServiceGetFacets     error
 
 	// Helper
 	server func(payload io.Reader) *httptest.ResponseRecorder
@@ -251,7 +252,7 @@ func (suite *HandlerGetTestSuite) SetupTest() {
 		// Create request
 		request, err := http.NewRequest(
 			http.MethodGet,
-			"/api/v1/food-recipes?search=name&page=1&limit=10",
+			"/api/v1/food-recipes?search=name&limit=10&"+suite.query,
 			payload,
 		)
 
@@ -263,6 +264,7 @@ func (suite *HandlerGetTestSuite) SetupTest() {
 		return recorder
 	}
 
+	suite.query = "page=1"
 	suite.respRecipesInServiceGet = model.FoodRecipes{
 		{
 			Name:        "Name",
@@ -280,10 +282,18 @@ func (suite *HandlerGetTestSuite) SetupTest() {
 		},
 	}
 	suite.respTotalInServiceGet = 10
+	suite.respNextCursor = "NEXT"
 	suite.errServiceGet = nil
+	suite.respFacets = model.FoodRecipeFacets{
+		Difficulties: model.FacetCounts{{ID: 2, Name: "DifficultyName", Count: 10}},
+	}
+	suite.errServiceGetFacets = nil
 
-	suite.service.On("Get", mock.AnythingOfType("model.FoodRecipeQuery")).Return(func(model.FoodRecipeQuery) (model.FoodRecipes, int64, error) {
-		return suite.respRecipesInServiceGet, suite.respTotalInServiceGet, suite.errServiceGet
+	suite.service.On("Get", mock.AnythingOfType("model.FoodRecipeQuery"), mock.AnythingOfType("model.Claims")).Return(func(model.FoodRecipeQuery, model.Claims) (model.FoodRecipes, int64, string, error) {
+		return suite.respRecipesInServiceGet, suite.respTotalInServiceGet, suite.respNextCursor, suite.errServiceGet
+	})
+	suite.service.On("GetFacets", mock.AnythingOfType("model.FoodRecipeQuery")).Return(func(model.FoodRecipeQuery) (model.FoodRecipeFacets, error) {
+		return suite.respFacets, suite.errServiceGetFacets
 	})
 }
 
@@ -294,31 +304,65 @@ func (suite *HandlerGetTestSuite) TestResponseRecipesWithStatus200() {
 	body := response.Result().Body
 	defer body.Close()
 
-	expectedResponse := dto.FoodRecipesResponse{
-		Total: 10,
-		Results: []dto.FoodRecipeResponse{
-			{
-				Name:        "Name",
-				Description: "Description",
-				Ingredient:  "Ingredient",
-				Instruction: "Instruction",
-				CookingDuration: dto.CookingDurationResponse{
-					ID:   1,
-					Name: "CookingDurationName",
-				},
-				Difficulty: dto.DifficultyResponse{
-					ID:   2,
-					Name: "DifficultyName",
-				},
-			},
-		},
-	}
+	expectedResponse := suite.respRecipesInServiceGet.ToResponse(10)
+	expectedResponse.NextCursor = "NEXT"
+	expectedResponse.Facets = suite.respFacets.ToResponse()
 	expectedJson, _ := json.Marshal(expectedResponse)
 
 	suite.Equal(http.StatusOK, response.Code)
 	suite.Equal(string(expectedJson), response.Body.String())
 }
 
+func (suite *HandlerGetTestSuite) TestResponseNextCursor() {
+	response := suite.server(nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	var result dto.FoodRecipesResponse
+	suite.NoError(json.Unmarshal(response.Body.Bytes(), &result))
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal("NEXT", result.NextCursor)
+}
+
+func (suite *HandlerGetTestSuite) TestOmitNextCursorOnLastPage() {
+	suite.respNextCursor = ""
+
+	response := suite.server(nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.NotContains(response.Body.String(), "nextCursor")
+}
+
+func (suite *HandlerGetTestSuite) TestSkipFacetsWhenCursor() {
+	suite.query = "cursor=NEXT"
+
+	response := suite.server(nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.NotContains(response.Body.String(), "facets")
+	suite.service.AssertNotCalled(suite.T(), "GetFacets", mock.Anything)
+}
+
+func (suite *HandlerGetTestSuite) TestErrorWhenCursorInvalid() {
+	suite.query = "cursor=invalid"
+	suite.errServiceGet = global.ErrInvalidCursor
+
+	response := suite.server(nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+}
+
 func (suite *HandlerGetTestSuite) TestErrorWhenGetRecipes() {
 	suite.errServiceGet = assert.AnError
 
@@ -332,6 +376,18 @@ func (suite *HandlerGetTestSuite) TestErrorWhenGetRecipes() {
 	suite.Equal(`{"message":"assert.AnError general error for testing"}`, response.Body.String())
 }
 
+func (suite *HandlerGetTestSuite) TestErrorWhenGetFacets() {
+	suite.errServiceGetFacets = assert.AnError
+
+	response := suite.server(nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+	suite.Equal(`{"message":"assert.AnError general error for testing"}`, response.Body.String())
+}
+
 func TestHandlerGet(t *testing.T) {
 	suite.Run(t, new(HandlerGetTestSuite))
 }
@@ -400,7 +456,7 @@ func (suite *HandlerGetByIDTestSuite) SetupTest() {
 	}
 
 	suite.errServiceGetByID = nil
-	suite.service.On("GetByID", mock.AnythingOfType("int")).Return(func(id int) (model.FoodRecipe, error) {
+	suite.service.On("GetByID", mock.AnythingOfType("int"), mock.AnythingOfType("model.FoodRecipeDetailQuery"), mock.AnythingOfType("model.Claims")).Return(func(id int, query model.FoodRecipeDetailQuery, claims model.Claims) (model.FoodRecipe, error) {
 		if id == 1 {
 			return suite.respRecipeInServiceGetByID, suite.errServiceGetByID
 		}
@@ -414,21 +470,8 @@ func (suite *HandlerGetByIDTestSuite) TestResponseRecipeWithStatus200() {
 	body := response.Result().Body
 	defer body.Close()
 
-	expectedResponse := dto.FoodRecipeResponse{
-		ID:          1,
-		Name:        "Name",
-		Description: "Description",
-		Ingredient:  "Ingredient",
-		Instruction: "Instruction",
-		CookingDuration: dto.CookingDurationResponse{
-			ID:   1,
-			Name: "CookingDurationName",
-		},
-		Difficulty: dto.DifficultyResponse{
-			ID:   1,
-			Name: "DifficultyName",
-		},
-	}
+	expectedResponse := suite.respRecipeInServiceGetByID.ToResponse()
+	expectedResponse.RatingHistogram = suite.respRecipeInServiceGetByID.RatingHistogram.ToResponse()
 	expectedJson, _ := json.Marshal(expectedResponse)
 
 	suite.Equal(http.StatusOK, response.Code)
@@ -539,10 +582,7 @@ func (suite *HandlerUpdateTestSuite) TestResponseRecipeWithStatus200() {
 	body := response.Result().Body
 	defer body.Close()
 
-	expectedResponse := dto.FoodRecipeResponse{
-		Name: "Name",
-	}
-	expectedJson, _ := json.Marshal(expectedResponse)
+	expectedJson, _ := json.Marshal(suite.respServiceUpdate.ToResponse())
 
 	suite.Equal(http.StatusOK, response.Code)
 	suite.Equal(string(expectedJson), response.Body.String())
@@ -199,6 +199,126 @@ func (_c *MockIHandler_GetByID_Call) RunAndReturn(run func(ctx *gin.Context)) *M
 	return _c
 }
 
+// GetTop provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) GetTop(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_GetTop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTop'
+type MockIHandler_GetTop_Call struct {
+	*mock.Call
+}
+
+// GetTop is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) GetTop(ctx interface{}) *MockIHandler_GetTop_Call {
+	return &MockIHandler_GetTop_Call{Call: _e.mock.On("GetTop", ctx)}
+}
+
+func (_c *MockIHandler_GetTop_Call) Run(run func(ctx *gin.Context)) *MockIHandler_GetTop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_GetTop_Call) Return() *MockIHandler_GetTop_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_GetTop_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_GetTop_Call {
+	_c.Run(run)
+	return _c
+}
+
+// MatchPantry provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) MatchPantry(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_MatchPantry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MatchPantry'
+type MockIHandler_MatchPantry_Call struct {
+	*mock.Call
+}
+
+// MatchPantry is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) MatchPantry(ctx interface{}) *MockIHandler_MatchPantry_Call {
+	return &MockIHandler_MatchPantry_Call{Call: _e.mock.On("MatchPantry", ctx)}
+}
+
+func (_c *MockIHandler_MatchPantry_Call) Run(run func(ctx *gin.Context)) *MockIHandler_MatchPantry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_MatchPantry_Call) Return() *MockIHandler_MatchPantry_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_MatchPantry_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_MatchPantry_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Suggest provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Suggest(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Suggest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Suggest'
+type MockIHandler_Suggest_Call struct {
+	*mock.Call
+}
+
+// Suggest is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Suggest(ctx interface{}) *MockIHandler_Suggest_Call {
+	return &MockIHandler_Suggest_Call{Call: _e.mock.On("Suggest", ctx)}
+}
+
+func (_c *MockIHandler_Suggest_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Suggest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Suggest_Call) Return() *MockIHandler_Suggest_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Suggest_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Suggest_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockIHandler
 func (_mock *MockIHandler) Update(ctx *gin.Context) {
 	_mock.Called(ctx)
@@ -267,8 +387,8 @@ func (_m *MockIRepository) EXPECT() *MockIRepository_Expecter {
 }
 
 // Count provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) Count() (int64, error) {
-	ret := _mock.Called()
+func (_mock *MockIRepository) Count(query model.FoodRecipeQuery) (int64, error) {
+	ret := _mock.Called(query)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Count")
@@ -276,16 +396,16 @@ func (_mock *MockIRepository) Count() (int64, error) {
 
 	var r0 int64
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() (int64, error)); ok {
-		return returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) (int64, error)); ok {
+		return returnFunc(query)
 	}
-	if returnFunc, ok := ret.Get(0).(func() int64); ok {
-		r0 = returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) int64); ok {
+		r0 = returnFunc(query)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery) error); ok {
+		r1 = returnFunc(query)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -298,13 +418,20 @@ type MockIRepository_Count_Call struct {
 }
 
 // Count is a helper method to define mock.On call
-func (_e *MockIRepository_Expecter) Count() *MockIRepository_Count_Call {
-	return &MockIRepository_Count_Call{Call: _e.mock.On("Count")}
+//   - query model.FoodRecipeQuery
+func (_e *MockIRepository_Expecter) Count(query interface{}) *MockIRepository_Count_Call {
+	return &MockIRepository_Count_Call{Call: _e.mock.On("Count", query)}
 }
 
-func (_c *MockIRepository_Count_Call) Run(run func()) *MockIRepository_Count_Call {
+func (_c *MockIRepository_Count_Call) Run(run func(query model.FoodRecipeQuery)) *MockIRepository_Count_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
@@ -314,7 +441,73 @@ func (_c *MockIRepository_Count_Call) Return(n int64, err error) *MockIRepositor
 	return _c
 }
 
-func (_c *MockIRepository_Count_Call) RunAndReturn(run func() (int64, error)) *MockIRepository_Count_Call {
+func (_c *MockIRepository_Count_Call) RunAndReturn(run func(query model.FoodRecipeQuery) (int64, error)) *MockIRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountPantryMatches provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) CountPantryMatches(userID string, query model.PantryMatchQuery) (int64, error) {
+	ret := _mock.Called(userID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountPantryMatches")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.PantryMatchQuery) (int64, error)); ok {
+		return returnFunc(userID, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.PantryMatchQuery) int64); ok {
+		r0 = returnFunc(userID, query)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.PantryMatchQuery) error); ok {
+		r1 = returnFunc(userID, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_CountPantryMatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountPantryMatches'
+type MockIRepository_CountPantryMatches_Call struct {
+	*mock.Call
+}
+
+// CountPantryMatches is a helper method to define mock.On call
+//   - userID string
+//   - query model.PantryMatchQuery
+func (_e *MockIRepository_Expecter) CountPantryMatches(userID interface{}, query interface{}) *MockIRepository_CountPantryMatches_Call {
+	return &MockIRepository_CountPantryMatches_Call{Call: _e.mock.On("CountPantryMatches", userID, query)}
+}
+
+func (_c *MockIRepository_CountPantryMatches_Call) Run(run func(userID string, query model.PantryMatchQuery)) *MockIRepository_CountPantryMatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.PantryMatchQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.PantryMatchQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_CountPantryMatches_Call) Return(n int64, err error) *MockIRepository_CountPantryMatches_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIRepository_CountPantryMatches_Call) RunAndReturn(run func(userID string, query model.PantryMatchQuery) (int64, error)) *MockIRepository_CountPantryMatches_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -421,6 +614,117 @@ func (_c *MockIRepository_Delete_Call) RunAndReturn(run func(id int) error) *Moc
 	return _c
 }
 
+// Exists provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Exists(id int) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exists")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Exists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exists'
+type MockIRepository_Exists_Call struct {
+	*mock.Call
+}
+
+// Exists is a helper method to define mock.On call
+//   - id int
+func (_e *MockIRepository_Expecter) Exists(id interface{}) *MockIRepository_Exists_Call {
+	return &MockIRepository_Exists_Call{Call: _e.mock.On("Exists", id)}
+}
+
+func (_c *MockIRepository_Exists_Call) Run(run func(id int)) *MockIRepository_Exists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Exists_Call) Return(err error) *MockIRepository_Exists_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Exists_Call) RunAndReturn(run func(id int) error) *MockIRepository_Exists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Facets provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Facets(query model.FoodRecipeQuery) (model.FoodRecipeFacets, error) {
+	ret := _mock.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Facets")
+	}
+
+	var r0 model.FoodRecipeFacets
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) (model.FoodRecipeFacets, error)); ok {
+		return returnFunc(query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) model.FoodRecipeFacets); ok {
+		r0 = returnFunc(query)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipeFacets)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery) error); ok {
+		r1 = returnFunc(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_Facets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Facets'
+type MockIRepository_Facets_Call struct {
+	*mock.Call
+}
+
+// Facets is a helper method to define mock.On call
+//   - query model.FoodRecipeQuery
+func (_e *MockIRepository_Expecter) Facets(query interface{}) *MockIRepository_Facets_Call {
+	return &MockIRepository_Facets_Call{Call: _e.mock.On("Facets", query)}
+}
+
+func (_c *MockIRepository_Facets_Call) Run(run func(query model.FoodRecipeQuery)) *MockIRepository_Facets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Facets_Call) Return(foodRecipeFacets model.FoodRecipeFacets, err error) *MockIRepository_Facets_Call {
+	_c.Call.Return(foodRecipeFacets, err)
+	return _c
+}
+
+func (_c *MockIRepository_Facets_Call) RunAndReturn(run func(query model.FoodRecipeQuery) (model.FoodRecipeFacets, error)) *MockIRepository_Facets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function for the type MockIRepository
 func (_mock *MockIRepository) Get(foodRecipeQuery model.FoodRecipeQuery, claimsID string) (model.FoodRecipes, error) {
 	ret := _mock.Called(foodRecipeQuery, claimsID)
@@ -490,8 +794,8 @@ func (_c *MockIRepository_Get_Call) RunAndReturn(run func(foodRecipeQuery model.
 }
 
 // GetByID provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) GetByID(id int) (model.FoodRecipe, error) {
-	ret := _mock.Called(id)
+func (_mock *MockIRepository) GetByID(id int, claimsID string) (model.FoodRecipe, error) {
+	ret := _mock.Called(id, claimsID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -499,16 +803,16 @@ func (_mock *MockIRepository) GetByID(id int) (model.FoodRecipe, error) {
 
 	var r0 model.FoodRecipe
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int) (model.FoodRecipe, error)); ok {
-		return returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(int, string) (model.FoodRecipe, error)); ok {
+		return returnFunc(id, claimsID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int) model.FoodRecipe); ok {
-		r0 = returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(int, string) model.FoodRecipe); ok {
+		r0 = returnFunc(id, claimsID)
 	} else {
 		r0 = ret.Get(0).(model.FoodRecipe)
 	}
-	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
-		r1 = returnFunc(id)
+	if returnFunc, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = returnFunc(id, claimsID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -522,18 +826,24 @@ type MockIRepository_GetByID_Call struct {
 
 // GetByID is a helper method to define mock.On call
 //   - id int
-func (_e *MockIRepository_Expecter) GetByID(id interface{}) *MockIRepository_GetByID_Call {
-	return &MockIRepository_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+//   - claimsID string
+func (_e *MockIRepository_Expecter) GetByID(id interface{}, claimsID interface{}) *MockIRepository_GetByID_Call {
+	return &MockIRepository_GetByID_Call{Call: _e.mock.On("GetByID", id, claimsID)}
 }
 
-func (_c *MockIRepository_GetByID_Call) Run(run func(id int)) *MockIRepository_GetByID_Call {
+func (_c *MockIRepository_GetByID_Call) Run(run func(id int, claimsID string)) *MockIRepository_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
 			arg0 = args[0].(int)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -544,37 +854,288 @@ func (_c *MockIRepository_GetByID_Call) Return(foodRecipe model.FoodRecipe, err
 	return _c
 }
 
-func (_c *MockIRepository_GetByID_Call) RunAndReturn(run func(id int) (model.FoodRecipe, error)) *MockIRepository_GetByID_Call {
+func (_c *MockIRepository_GetByID_Call) RunAndReturn(run func(id int, claimsID string) (model.FoodRecipe, error)) *MockIRepository_GetByID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) Update(recipe *model.FoodRecipe) error {
-	ret := _mock.Called(recipe)
+// GetByIDs provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetByIDs(ids []uint, claimsID string) (model.FoodRecipes, error) {
+	ret := _mock.Called(ids, claimsID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Update")
+		panic("no return value specified for GetByIDs")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.FoodRecipe) error); ok {
-		r0 = returnFunc(recipe)
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]uint, string) (model.FoodRecipes, error)); ok {
+		return returnFunc(ids, claimsID)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]uint, string) model.FoodRecipes); ok {
+		r0 = returnFunc(ids, claimsID)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func([]uint, string) error); ok {
+		r1 = returnFunc(ids, claimsID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockIRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
-type MockIRepository_Update_Call struct {
+// MockIRepository_GetByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDs'
+type MockIRepository_GetByIDs_Call struct {
 	*mock.Call
 }
 
-// Update is a helper method to define mock.On call
-//   - recipe *model.FoodRecipe
-func (_e *MockIRepository_Expecter) Update(recipe interface{}) *MockIRepository_Update_Call {
-	return &MockIRepository_Update_Call{Call: _e.mock.On("Update", recipe)}
+// GetByIDs is a helper method to define mock.On call
+//   - ids []uint
+//   - claimsID string
+func (_e *MockIRepository_Expecter) GetByIDs(ids interface{}, claimsID interface{}) *MockIRepository_GetByIDs_Call {
+	return &MockIRepository_GetByIDs_Call{Call: _e.mock.On("GetByIDs", ids, claimsID)}
+}
+
+func (_c *MockIRepository_GetByIDs_Call) Run(run func(ids []uint, claimsID string)) *MockIRepository_GetByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []uint
+		if args[0] != nil {
+			arg0 = args[0].([]uint)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetByIDs_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIRepository_GetByIDs_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetByIDs_Call) RunAndReturn(run func(ids []uint, claimsID string) (model.FoodRecipes, error)) *MockIRepository_GetByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MatchPantry provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) MatchPantry(userID string, query model.PantryMatchQuery) (model.PantryMatches, error) {
+	ret := _mock.Called(userID, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MatchPantry")
+	}
+
+	var r0 model.PantryMatches
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.PantryMatchQuery) (model.PantryMatches, error)); ok {
+		return returnFunc(userID, query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.PantryMatchQuery) model.PantryMatches); ok {
+		r0 = returnFunc(userID, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.PantryMatches)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.PantryMatchQuery) error); ok {
+		r1 = returnFunc(userID, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_MatchPantry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MatchPantry'
+type MockIRepository_MatchPantry_Call struct {
+	*mock.Call
+}
+
+// MatchPantry is a helper method to define mock.On call
+//   - userID string
+//   - query model.PantryMatchQuery
+func (_e *MockIRepository_Expecter) MatchPantry(userID interface{}, query interface{}) *MockIRepository_MatchPantry_Call {
+	return &MockIRepository_MatchPantry_Call{Call: _e.mock.On("MatchPantry", userID, query)}
+}
+
+func (_c *MockIRepository_MatchPantry_Call) Run(run func(userID string, query model.PantryMatchQuery)) *MockIRepository_MatchPantry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 model.PantryMatchQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.PantryMatchQuery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_MatchPantry_Call) Return(pantryMatches model.PantryMatches, err error) *MockIRepository_MatchPantry_Call {
+	_c.Call.Return(pantryMatches, err)
+	return _c
+}
+
+func (_c *MockIRepository_MatchPantry_Call) RunAndReturn(run func(userID string, query model.PantryMatchQuery) (model.PantryMatches, error)) *MockIRepository_MatchPantry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MeanRating provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) MeanRating() (float64, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MeanRating")
+	}
+
+	var r0 float64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (float64, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() float64); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_MeanRating_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MeanRating'
+type MockIRepository_MeanRating_Call struct {
+	*mock.Call
+}
+
+// MeanRating is a helper method to define mock.On call
+func (_e *MockIRepository_Expecter) MeanRating() *MockIRepository_MeanRating_Call {
+	return &MockIRepository_MeanRating_Call{Call: _e.mock.On("MeanRating")}
+}
+
+func (_c *MockIRepository_MeanRating_Call) Run(run func()) *MockIRepository_MeanRating_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIRepository_MeanRating_Call) Return(f float64, err error) *MockIRepository_MeanRating_Call {
+	_c.Call.Return(f, err)
+	return _c
+}
+
+func (_c *MockIRepository_MeanRating_Call) RunAndReturn(run func() (float64, error)) *MockIRepository_MeanRating_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Suggest provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Suggest(query model.SuggestQuery) (model.Suggestions, error) {
+	ret := _mock.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Suggest")
+	}
+
+	var r0 model.Suggestions
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.SuggestQuery) (model.Suggestions, error)); ok {
+		return returnFunc(query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.SuggestQuery) model.Suggestions); ok {
+		r0 = returnFunc(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Suggestions)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.SuggestQuery) error); ok {
+		r1 = returnFunc(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_Suggest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Suggest'
+type MockIRepository_Suggest_Call struct {
+	*mock.Call
+}
+
+// Suggest is a helper method to define mock.On call
+//   - query model.SuggestQuery
+func (_e *MockIRepository_Expecter) Suggest(query interface{}) *MockIRepository_Suggest_Call {
+	return &MockIRepository_Suggest_Call{Call: _e.mock.On("Suggest", query)}
+}
+
+func (_c *MockIRepository_Suggest_Call) Run(run func(query model.SuggestQuery)) *MockIRepository_Suggest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.SuggestQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.SuggestQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Suggest_Call) Return(suggestions model.Suggestions, err error) *MockIRepository_Suggest_Call {
+	_c.Call.Return(suggestions, err)
+	return _c
+}
+
+func (_c *MockIRepository_Suggest_Call) RunAndReturn(run func(query model.SuggestQuery) (model.Suggestions, error)) *MockIRepository_Suggest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Update(recipe *model.FoodRecipe) error {
+	ret := _mock.Called(recipe)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.FoodRecipe) error); ok {
+		r0 = returnFunc(recipe)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - recipe *model.FoodRecipe
+func (_e *MockIRepository_Expecter) Update(recipe interface{}) *MockIRepository_Update_Call {
+	return &MockIRepository_Update_Call{Call: _e.mock.On("Update", recipe)}
 }
 
 func (_c *MockIRepository_Update_Call) Run(run func(recipe *model.FoodRecipe)) *MockIRepository_Update_Call {
@@ -600,31 +1161,1070 @@ func (_c *MockIRepository_Update_Call) RunAndReturn(run func(recipe *model.FoodR
 	return _c
 }
 
-// NewMockIService creates a new instance of MockIService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockIService(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockIService {
-	mock := &MockIService{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+// NewMockIPantryService creates a new instance of MockIPantryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIPantryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIPantryService {
+	mock := &MockIPantryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIPantryService is an autogenerated mock type for the IPantryService type
+type MockIPantryService struct {
+	mock.Mock
+}
+
+type MockIPantryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIPantryService) EXPECT() *MockIPantryService_Expecter {
+	return &MockIPantryService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockIPantryService
+func (_mock *MockIPantryService) Create(request dto.PantryRequest, claims model.Claims) (model.PantryItems, error) {
+	ret := _mock.Called(request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.PantryItems
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.PantryRequest, model.Claims) (model.PantryItems, error)); ok {
+		return returnFunc(request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.PantryRequest, model.Claims) model.PantryItems); ok {
+		r0 = returnFunc(request, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.PantryItems)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.PantryRequest, model.Claims) error); ok {
+		r1 = returnFunc(request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIPantryService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIPantryService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - request dto.PantryRequest
+//   - claims model.Claims
+func (_e *MockIPantryService_Expecter) Create(request interface{}, claims interface{}) *MockIPantryService_Create_Call {
+	return &MockIPantryService_Create_Call{Call: _e.mock.On("Create", request, claims)}
+}
+
+func (_c *MockIPantryService_Create_Call) Run(run func(request dto.PantryRequest, claims model.Claims)) *MockIPantryService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.PantryRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.PantryRequest)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPantryService_Create_Call) Return(pantryItems model.PantryItems, err error) *MockIPantryService_Create_Call {
+	_c.Call.Return(pantryItems, err)
+	return _c
+}
+
+func (_c *MockIPantryService_Create_Call) RunAndReturn(run func(request dto.PantryRequest, claims model.Claims) (model.PantryItems, error)) *MockIPantryService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIPantryService
+func (_mock *MockIPantryService) Delete(id int, claims model.Claims) error {
+	ret := _mock.Called(id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) error); ok {
+		r0 = returnFunc(id, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIPantryService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIPantryService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id int
+//   - claims model.Claims
+func (_e *MockIPantryService_Expecter) Delete(id interface{}, claims interface{}) *MockIPantryService_Delete_Call {
+	return &MockIPantryService_Delete_Call{Call: _e.mock.On("Delete", id, claims)}
+}
+
+func (_c *MockIPantryService_Delete_Call) Run(run func(id int, claims model.Claims)) *MockIPantryService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPantryService_Delete_Call) Return(err error) *MockIPantryService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIPantryService_Delete_Call) RunAndReturn(run func(id int, claims model.Claims) error) *MockIPantryService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIPantryService
+func (_mock *MockIPantryService) Get(claims model.Claims) (model.PantryItems, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.PantryItems
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.PantryItems, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.PantryItems); ok {
+		r0 = returnFunc(claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.PantryItems)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIPantryService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIPantryService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIPantryService_Expecter) Get(claims interface{}) *MockIPantryService_Get_Call {
+	return &MockIPantryService_Get_Call{Call: _e.mock.On("Get", claims)}
+}
+
+func (_c *MockIPantryService_Get_Call) Run(run func(claims model.Claims)) *MockIPantryService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIPantryService_Get_Call) Return(pantryItems model.PantryItems, err error) *MockIPantryService_Get_Call {
+	_c.Call.Return(pantryItems, err)
+	return _c
+}
+
+func (_c *MockIPantryService_Get_Call) RunAndReturn(run func(claims model.Claims) (model.PantryItems, error)) *MockIPantryService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIIngredientService creates a new instance of MockIIngredientService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIIngredientService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIIngredientService {
+	mock := &MockIIngredientService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIIngredientService is an autogenerated mock type for the IIngredientService type
+type MockIIngredientService struct {
+	mock.Mock
+}
+
+type MockIIngredientService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIIngredientService) EXPECT() *MockIIngredientService_Expecter {
+	return &MockIIngredientService_Expecter{mock: &_m.Mock}
+}
+
+// BackfillNutrition provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) BackfillNutrition() (int, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BackfillNutrition")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (int, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIIngredientService_BackfillNutrition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BackfillNutrition'
+type MockIIngredientService_BackfillNutrition_Call struct {
+	*mock.Call
+}
+
+// BackfillNutrition is a helper method to define mock.On call
+func (_e *MockIIngredientService_Expecter) BackfillNutrition() *MockIIngredientService_BackfillNutrition_Call {
+	return &MockIIngredientService_BackfillNutrition_Call{Call: _e.mock.On("BackfillNutrition")}
+}
+
+func (_c *MockIIngredientService_BackfillNutrition_Call) Run(run func()) *MockIIngredientService_BackfillNutrition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_BackfillNutrition_Call) Return(n int, err error) *MockIIngredientService_BackfillNutrition_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIIngredientService_BackfillNutrition_Call) RunAndReturn(run func() (int, error)) *MockIIngredientService_BackfillNutrition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) Create(request dto.IngredientRequest, claims model.Claims) (model.Ingredient, error) {
+	ret := _mock.Called(request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Ingredient
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.IngredientRequest, model.Claims) (model.Ingredient, error)); ok {
+		return returnFunc(request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.IngredientRequest, model.Claims) model.Ingredient); ok {
+		r0 = returnFunc(request, claims)
+	} else {
+		r0 = ret.Get(0).(model.Ingredient)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.IngredientRequest, model.Claims) error); ok {
+		r1 = returnFunc(request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIIngredientService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIIngredientService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - request dto.IngredientRequest
+//   - claims model.Claims
+func (_e *MockIIngredientService_Expecter) Create(request interface{}, claims interface{}) *MockIIngredientService_Create_Call {
+	return &MockIIngredientService_Create_Call{Call: _e.mock.On("Create", request, claims)}
+}
+
+func (_c *MockIIngredientService_Create_Call) Run(run func(request dto.IngredientRequest, claims model.Claims)) *MockIIngredientService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.IngredientRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.IngredientRequest)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_Create_Call) Return(ingredient model.Ingredient, err error) *MockIIngredientService_Create_Call {
+	_c.Call.Return(ingredient, err)
+	return _c
+}
+
+func (_c *MockIIngredientService_Create_Call) RunAndReturn(run func(request dto.IngredientRequest, claims model.Claims) (model.Ingredient, error)) *MockIIngredientService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) Delete(id int, claims model.Claims) error {
+	ret := _mock.Called(id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) error); ok {
+		r0 = returnFunc(id, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIIngredientService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIIngredientService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id int
+//   - claims model.Claims
+func (_e *MockIIngredientService_Expecter) Delete(id interface{}, claims interface{}) *MockIIngredientService_Delete_Call {
+	return &MockIIngredientService_Delete_Call{Call: _e.mock.On("Delete", id, claims)}
+}
+
+func (_c *MockIIngredientService_Delete_Call) Run(run func(id int, claims model.Claims)) *MockIIngredientService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_Delete_Call) Return(err error) *MockIIngredientService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIIngredientService_Delete_Call) RunAndReturn(run func(id int, claims model.Claims) error) *MockIIngredientService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeriveDietary provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) DeriveDietary(ingredients model.RecipeIngredients) (model.Dietary, error) {
+	ret := _mock.Called(ingredients)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeriveDietary")
+	}
+
+	var r0 model.Dietary
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.RecipeIngredients) (model.Dietary, error)); ok {
+		return returnFunc(ingredients)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.RecipeIngredients) model.Dietary); ok {
+		r0 = returnFunc(ingredients)
+	} else {
+		r0 = ret.Get(0).(model.Dietary)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.RecipeIngredients) error); ok {
+		r1 = returnFunc(ingredients)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIIngredientService_DeriveDietary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeriveDietary'
+type MockIIngredientService_DeriveDietary_Call struct {
+	*mock.Call
+}
+
+// DeriveDietary is a helper method to define mock.On call
+//   - ingredients model.RecipeIngredients
+func (_e *MockIIngredientService_Expecter) DeriveDietary(ingredients interface{}) *MockIIngredientService_DeriveDietary_Call {
+	return &MockIIngredientService_DeriveDietary_Call{Call: _e.mock.On("DeriveDietary", ingredients)}
+}
+
+func (_c *MockIIngredientService_DeriveDietary_Call) Run(run func(ingredients model.RecipeIngredients)) *MockIIngredientService_DeriveDietary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.RecipeIngredients
+		if args[0] != nil {
+			arg0 = args[0].(model.RecipeIngredients)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_DeriveDietary_Call) Return(dietary model.Dietary, err error) *MockIIngredientService_DeriveDietary_Call {
+	_c.Call.Return(dietary, err)
+	return _c
+}
+
+func (_c *MockIIngredientService_DeriveDietary_Call) RunAndReturn(run func(ingredients model.RecipeIngredients) (model.Dietary, error)) *MockIIngredientService_DeriveDietary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DismissUnlinked provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) DismissUnlinked(id int, claims model.Claims) error {
+	ret := _mock.Called(id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DismissUnlinked")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) error); ok {
+		r0 = returnFunc(id, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIIngredientService_DismissUnlinked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DismissUnlinked'
+type MockIIngredientService_DismissUnlinked_Call struct {
+	*mock.Call
+}
+
+// DismissUnlinked is a helper method to define mock.On call
+//   - id int
+//   - claims model.Claims
+func (_e *MockIIngredientService_Expecter) DismissUnlinked(id interface{}, claims interface{}) *MockIIngredientService_DismissUnlinked_Call {
+	return &MockIIngredientService_DismissUnlinked_Call{Call: _e.mock.On("DismissUnlinked", id, claims)}
+}
+
+func (_c *MockIIngredientService_DismissUnlinked_Call) Run(run func(id int, claims model.Claims)) *MockIIngredientService_DismissUnlinked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_DismissUnlinked_Call) Return(err error) *MockIIngredientService_DismissUnlinked_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIIngredientService_DismissUnlinked_Call) RunAndReturn(run func(id int, claims model.Claims) error) *MockIIngredientService_DismissUnlinked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EstimateNutrition provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) EstimateNutrition(ingredients model.RecipeIngredients) (model.Nutrition, error) {
+	ret := _mock.Called(ingredients)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EstimateNutrition")
+	}
+
+	var r0 model.Nutrition
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.RecipeIngredients) (model.Nutrition, error)); ok {
+		return returnFunc(ingredients)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.RecipeIngredients) model.Nutrition); ok {
+		r0 = returnFunc(ingredients)
+	} else {
+		r0 = ret.Get(0).(model.Nutrition)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.RecipeIngredients) error); ok {
+		r1 = returnFunc(ingredients)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIIngredientService_EstimateNutrition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EstimateNutrition'
+type MockIIngredientService_EstimateNutrition_Call struct {
+	*mock.Call
+}
+
+// EstimateNutrition is a helper method to define mock.On call
+//   - ingredients model.RecipeIngredients
+func (_e *MockIIngredientService_Expecter) EstimateNutrition(ingredients interface{}) *MockIIngredientService_EstimateNutrition_Call {
+	return &MockIIngredientService_EstimateNutrition_Call{Call: _e.mock.On("EstimateNutrition", ingredients)}
+}
+
+func (_c *MockIIngredientService_EstimateNutrition_Call) Run(run func(ingredients model.RecipeIngredients)) *MockIIngredientService_EstimateNutrition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.RecipeIngredients
+		if args[0] != nil {
+			arg0 = args[0].(model.RecipeIngredients)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_EstimateNutrition_Call) Return(nutrition model.Nutrition, err error) *MockIIngredientService_EstimateNutrition_Call {
+	_c.Call.Return(nutrition, err)
+	return _c
+}
+
+func (_c *MockIIngredientService_EstimateNutrition_Call) RunAndReturn(run func(ingredients model.RecipeIngredients) (model.Nutrition, error)) *MockIIngredientService_EstimateNutrition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) Get(query model.IngredientQuery) (model.Ingredients, error) {
+	ret := _mock.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.Ingredients
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.IngredientQuery) (model.Ingredients, error)); ok {
+		return returnFunc(query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.IngredientQuery) model.Ingredients); ok {
+		r0 = returnFunc(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Ingredients)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.IngredientQuery) error); ok {
+		r1 = returnFunc(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIIngredientService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIIngredientService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - query model.IngredientQuery
+func (_e *MockIIngredientService_Expecter) Get(query interface{}) *MockIIngredientService_Get_Call {
+	return &MockIIngredientService_Get_Call{Call: _e.mock.On("Get", query)}
+}
+
+func (_c *MockIIngredientService_Get_Call) Run(run func(query model.IngredientQuery)) *MockIIngredientService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.IngredientQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.IngredientQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_Get_Call) Return(ingredients model.Ingredients, err error) *MockIIngredientService_Get_Call {
+	_c.Call.Return(ingredients, err)
+	return _c
+}
+
+func (_c *MockIIngredientService_Get_Call) RunAndReturn(run func(query model.IngredientQuery) (model.Ingredients, error)) *MockIIngredientService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUnlinked provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) GetUnlinked(claims model.Claims) (model.UnlinkedIngredients, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnlinked")
+	}
+
+	var r0 model.UnlinkedIngredients
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.UnlinkedIngredients, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.UnlinkedIngredients); ok {
+		r0 = returnFunc(claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.UnlinkedIngredients)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIIngredientService_GetUnlinked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnlinked'
+type MockIIngredientService_GetUnlinked_Call struct {
+	*mock.Call
+}
+
+// GetUnlinked is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIIngredientService_Expecter) GetUnlinked(claims interface{}) *MockIIngredientService_GetUnlinked_Call {
+	return &MockIIngredientService_GetUnlinked_Call{Call: _e.mock.On("GetUnlinked", claims)}
+}
+
+func (_c *MockIIngredientService_GetUnlinked_Call) Run(run func(claims model.Claims)) *MockIIngredientService_GetUnlinked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_GetUnlinked_Call) Return(unlinkedIngredients model.UnlinkedIngredients, err error) *MockIIngredientService_GetUnlinked_Call {
+	_c.Call.Return(unlinkedIngredients, err)
+	return _c
+}
+
+func (_c *MockIIngredientService_GetUnlinked_Call) RunAndReturn(run func(claims model.Claims) (model.UnlinkedIngredients, error)) *MockIIngredientService_GetUnlinked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Link provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) Link(ingredients model.RecipeIngredients) (model.RecipeIngredients, error) {
+	ret := _mock.Called(ingredients)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Link")
+	}
+
+	var r0 model.RecipeIngredients
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.RecipeIngredients) (model.RecipeIngredients, error)); ok {
+		return returnFunc(ingredients)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.RecipeIngredients) model.RecipeIngredients); ok {
+		r0 = returnFunc(ingredients)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.RecipeIngredients)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.RecipeIngredients) error); ok {
+		r1 = returnFunc(ingredients)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIIngredientService_Link_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Link'
+type MockIIngredientService_Link_Call struct {
+	*mock.Call
+}
+
+// Link is a helper method to define mock.On call
+//   - ingredients model.RecipeIngredients
+func (_e *MockIIngredientService_Expecter) Link(ingredients interface{}) *MockIIngredientService_Link_Call {
+	return &MockIIngredientService_Link_Call{Call: _e.mock.On("Link", ingredients)}
+}
+
+func (_c *MockIIngredientService_Link_Call) Run(run func(ingredients model.RecipeIngredients)) *MockIIngredientService_Link_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.RecipeIngredients
+		if args[0] != nil {
+			arg0 = args[0].(model.RecipeIngredients)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_Link_Call) Return(recipeIngredients model.RecipeIngredients, err error) *MockIIngredientService_Link_Call {
+	_c.Call.Return(recipeIngredients, err)
+	return _c
+}
+
+func (_c *MockIIngredientService_Link_Call) RunAndReturn(run func(ingredients model.RecipeIngredients) (model.RecipeIngredients, error)) *MockIIngredientService_Link_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LinkPantry provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) LinkPantry(items model.PantryItems) (model.PantryItems, error) {
+	ret := _mock.Called(items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkPantry")
+	}
+
+	var r0 model.PantryItems
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.PantryItems) (model.PantryItems, error)); ok {
+		return returnFunc(items)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.PantryItems) model.PantryItems); ok {
+		r0 = returnFunc(items)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.PantryItems)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.PantryItems) error); ok {
+		r1 = returnFunc(items)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIIngredientService_LinkPantry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkPantry'
+type MockIIngredientService_LinkPantry_Call struct {
+	*mock.Call
+}
+
+// LinkPantry is a helper method to define mock.On call
+//   - items model.PantryItems
+func (_e *MockIIngredientService_Expecter) LinkPantry(items interface{}) *MockIIngredientService_LinkPantry_Call {
+	return &MockIIngredientService_LinkPantry_Call{Call: _e.mock.On("LinkPantry", items)}
+}
+
+func (_c *MockIIngredientService_LinkPantry_Call) Run(run func(items model.PantryItems)) *MockIIngredientService_LinkPantry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.PantryItems
+		if args[0] != nil {
+			arg0 = args[0].(model.PantryItems)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_LinkPantry_Call) Return(pantryItems model.PantryItems, err error) *MockIIngredientService_LinkPantry_Call {
+	_c.Call.Return(pantryItems, err)
+	return _c
+}
+
+func (_c *MockIIngredientService_LinkPantry_Call) RunAndReturn(run func(items model.PantryItems) (model.PantryItems, error)) *MockIIngredientService_LinkPantry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LinkUnlinked provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) LinkUnlinked(request dto.UnlinkedIngredientLinkRequest, id int, claims model.Claims) (model.Ingredient, error) {
+	ret := _mock.Called(request, id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkUnlinked")
+	}
+
+	var r0 model.Ingredient
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.UnlinkedIngredientLinkRequest, int, model.Claims) (model.Ingredient, error)); ok {
+		return returnFunc(request, id, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.UnlinkedIngredientLinkRequest, int, model.Claims) model.Ingredient); ok {
+		r0 = returnFunc(request, id, claims)
+	} else {
+		r0 = ret.Get(0).(model.Ingredient)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.UnlinkedIngredientLinkRequest, int, model.Claims) error); ok {
+		r1 = returnFunc(request, id, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIIngredientService_LinkUnlinked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkUnlinked'
+type MockIIngredientService_LinkUnlinked_Call struct {
+	*mock.Call
+}
+
+// LinkUnlinked is a helper method to define mock.On call
+//   - request dto.UnlinkedIngredientLinkRequest
+//   - id int
+//   - claims model.Claims
+func (_e *MockIIngredientService_Expecter) LinkUnlinked(request interface{}, id interface{}, claims interface{}) *MockIIngredientService_LinkUnlinked_Call {
+	return &MockIIngredientService_LinkUnlinked_Call{Call: _e.mock.On("LinkUnlinked", request, id, claims)}
+}
+
+func (_c *MockIIngredientService_LinkUnlinked_Call) Run(run func(request dto.UnlinkedIngredientLinkRequest, id int, claims model.Claims)) *MockIIngredientService_LinkUnlinked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.UnlinkedIngredientLinkRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.UnlinkedIngredientLinkRequest)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_LinkUnlinked_Call) Return(ingredient model.Ingredient, err error) *MockIIngredientService_LinkUnlinked_Call {
+	_c.Call.Return(ingredient, err)
+	return _c
+}
+
+func (_c *MockIIngredientService_LinkUnlinked_Call) RunAndReturn(run func(request dto.UnlinkedIngredientLinkRequest, id int, claims model.Claims) (model.Ingredient, error)) *MockIIngredientService_LinkUnlinked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) Update(request dto.IngredientRequest, id int, claims model.Claims) (model.Ingredient, error) {
+	ret := _mock.Called(request, id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.Ingredient
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.IngredientRequest, int, model.Claims) (model.Ingredient, error)); ok {
+		return returnFunc(request, id, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.IngredientRequest, int, model.Claims) model.Ingredient); ok {
+		r0 = returnFunc(request, id, claims)
+	} else {
+		r0 = ret.Get(0).(model.Ingredient)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.IngredientRequest, int, model.Claims) error); ok {
+		r1 = returnFunc(request, id, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIIngredientService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIIngredientService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - request dto.IngredientRequest
+//   - id int
+//   - claims model.Claims
+func (_e *MockIIngredientService_Expecter) Update(request interface{}, id interface{}, claims interface{}) *MockIIngredientService_Update_Call {
+	return &MockIIngredientService_Update_Call{Call: _e.mock.On("Update", request, id, claims)}
+}
+
+func (_c *MockIIngredientService_Update_Call) Run(run func(request dto.IngredientRequest, id int, claims model.Claims)) *MockIIngredientService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.IngredientRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.IngredientRequest)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_Update_Call) Return(ingredient model.Ingredient, err error) *MockIIngredientService_Update_Call {
+	_c.Call.Return(ingredient, err)
+	return _c
+}
+
+func (_c *MockIIngredientService_Update_Call) RunAndReturn(run func(request dto.IngredientRequest, id int, claims model.Claims) (model.Ingredient, error)) *MockIIngredientService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIService creates a new instance of MockIService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIService {
+	mock := &MockIService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIService is an autogenerated mock type for the IService type
+type MockIService struct {
+	mock.Mock
+}
+
+type MockIService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIService) EXPECT() *MockIService_Expecter {
+	return &MockIService_Expecter{mock: &_m.Mock}
+}
+
+// BayesianPrior provides a mock function for the type MockIService
+func (_mock *MockIService) BayesianPrior() (model.BayesianPrior, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BayesianPrior")
+	}
+
+	var r0 model.BayesianPrior
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (model.BayesianPrior, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() model.BayesianPrior); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(model.BayesianPrior)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_BayesianPrior_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BayesianPrior'
+type MockIService_BayesianPrior_Call struct {
+	*mock.Call
+}
 
-	return mock
+// BayesianPrior is a helper method to define mock.On call
+func (_e *MockIService_Expecter) BayesianPrior() *MockIService_BayesianPrior_Call {
+	return &MockIService_BayesianPrior_Call{Call: _e.mock.On("BayesianPrior")}
 }
 
-// MockIService is an autogenerated mock type for the IService type
-type MockIService struct {
-	mock.Mock
+func (_c *MockIService_BayesianPrior_Call) Run(run func()) *MockIService_BayesianPrior_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
 }
 
-type MockIService_Expecter struct {
-	mock *mock.Mock
+func (_c *MockIService_BayesianPrior_Call) Return(bayesianPrior model.BayesianPrior, err error) *MockIService_BayesianPrior_Call {
+	_c.Call.Return(bayesianPrior, err)
+	return _c
 }
 
-func (_m *MockIService) EXPECT() *MockIService_Expecter {
-	return &MockIService_Expecter{mock: &_m.Mock}
+func (_c *MockIService_BayesianPrior_Call) RunAndReturn(run func() (model.BayesianPrior, error)) *MockIService_BayesianPrior_Call {
+	_c.Call.Return(run)
+	return _c
 }
 
 // Create provides a mock function for the type MockIService
@@ -750,8 +2350,59 @@ func (_c *MockIService_Delete_Call) RunAndReturn(run func(id int, claims model.C
 	return _c
 }
 
+// Exists provides a mock function for the type MockIService
+func (_mock *MockIService) Exists(id int) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exists")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIService_Exists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exists'
+type MockIService_Exists_Call struct {
+	*mock.Call
+}
+
+// Exists is a helper method to define mock.On call
+//   - id int
+func (_e *MockIService_Expecter) Exists(id interface{}) *MockIService_Exists_Call {
+	return &MockIService_Exists_Call{Call: _e.mock.On("Exists", id)}
+}
+
+func (_c *MockIService_Exists_Call) Run(run func(id int)) *MockIService_Exists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Exists_Call) Return(err error) *MockIService_Exists_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIService_Exists_Call) RunAndReturn(run func(id int) error) *MockIService_Exists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function for the type MockIService
-func (_mock *MockIService) Get(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, error) {
+func (_mock *MockIService) Get(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, string, error) {
 	ret := _mock.Called(foodRecipeQuery, claims)
 
 	if len(ret) == 0 {
@@ -760,8 +2411,9 @@ func (_mock *MockIService) Get(foodRecipeQuery model.FoodRecipeQuery, claims mod
 
 	var r0 model.FoodRecipes
 	var r1 int64
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, model.Claims) (model.FoodRecipes, int64, error)); ok {
+	var r2 string
+	var r3 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, model.Claims) (model.FoodRecipes, int64, string, error)); ok {
 		return returnFunc(foodRecipeQuery, claims)
 	}
 	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery, model.Claims) model.FoodRecipes); ok {
@@ -776,12 +2428,17 @@ func (_mock *MockIService) Get(foodRecipeQuery model.FoodRecipeQuery, claims mod
 	} else {
 		r1 = ret.Get(1).(int64)
 	}
-	if returnFunc, ok := ret.Get(2).(func(model.FoodRecipeQuery, model.Claims) error); ok {
+	if returnFunc, ok := ret.Get(2).(func(model.FoodRecipeQuery, model.Claims) string); ok {
 		r2 = returnFunc(foodRecipeQuery, claims)
 	} else {
-		r2 = ret.Error(2)
+		r2 = ret.Get(2).(string)
 	}
-	return r0, r1, r2
+	if returnFunc, ok := ret.Get(3).(func(model.FoodRecipeQuery, model.Claims) error); ok {
+		r3 = returnFunc(foodRecipeQuery, claims)
+	} else {
+		r3 = ret.Error(3)
+	}
+	return r0, r1, r2, r3
 }
 
 // MockIService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
@@ -814,19 +2471,19 @@ func (_c *MockIService_Get_Call) Run(run func(foodRecipeQuery model.FoodRecipeQu
 	return _c
 }
 
-func (_c *MockIService_Get_Call) Return(foodRecipes model.FoodRecipes, n int64, err error) *MockIService_Get_Call {
-	_c.Call.Return(foodRecipes, n, err)
+func (_c *MockIService_Get_Call) Return(foodRecipes model.FoodRecipes, n int64, s string, err error) *MockIService_Get_Call {
+	_c.Call.Return(foodRecipes, n, s, err)
 	return _c
 }
 
-func (_c *MockIService_Get_Call) RunAndReturn(run func(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, error)) *MockIService_Get_Call {
+func (_c *MockIService_Get_Call) RunAndReturn(run func(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, string, error)) *MockIService_Get_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetByID provides a mock function for the type MockIService
-func (_mock *MockIService) GetByID(id int) (model.FoodRecipe, error) {
-	ret := _mock.Called(id)
+func (_mock *MockIService) GetByID(id int, query model.FoodRecipeDetailQuery, claims model.Claims) (model.FoodRecipe, error) {
+	ret := _mock.Called(id, query, claims)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetByID")
@@ -834,16 +2491,16 @@ func (_mock *MockIService) GetByID(id int) (model.FoodRecipe, error) {
 
 	var r0 model.FoodRecipe
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int) (model.FoodRecipe, error)); ok {
-		return returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(int, model.FoodRecipeDetailQuery, model.Claims) (model.FoodRecipe, error)); ok {
+		return returnFunc(id, query, claims)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int) model.FoodRecipe); ok {
-		r0 = returnFunc(id)
+	if returnFunc, ok := ret.Get(0).(func(int, model.FoodRecipeDetailQuery, model.Claims) model.FoodRecipe); ok {
+		r0 = returnFunc(id, query, claims)
 	} else {
 		r0 = ret.Get(0).(model.FoodRecipe)
 	}
-	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
-		r1 = returnFunc(id)
+	if returnFunc, ok := ret.Get(1).(func(int, model.FoodRecipeDetailQuery, model.Claims) error); ok {
+		r1 = returnFunc(id, query, claims)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -857,18 +2514,30 @@ type MockIService_GetByID_Call struct {
 
 // GetByID is a helper method to define mock.On call
 //   - id int
-func (_e *MockIService_Expecter) GetByID(id interface{}) *MockIService_GetByID_Call {
-	return &MockIService_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+//   - query model.FoodRecipeDetailQuery
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetByID(id interface{}, query interface{}, claims interface{}) *MockIService_GetByID_Call {
+	return &MockIService_GetByID_Call{Call: _e.mock.On("GetByID", id, query, claims)}
 }
 
-func (_c *MockIService_GetByID_Call) Run(run func(id int)) *MockIService_GetByID_Call {
+func (_c *MockIService_GetByID_Call) Run(run func(id int, query model.FoodRecipeDetailQuery, claims model.Claims)) *MockIService_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
 			arg0 = args[0].(int)
 		}
+		var arg1 model.FoodRecipeDetailQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.FoodRecipeDetailQuery)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -879,7 +2548,271 @@ func (_c *MockIService_GetByID_Call) Return(foodRecipe model.FoodRecipe, err err
 	return _c
 }
 
-func (_c *MockIService_GetByID_Call) RunAndReturn(run func(id int) (model.FoodRecipe, error)) *MockIService_GetByID_Call {
+func (_c *MockIService_GetByID_Call) RunAndReturn(run func(id int, query model.FoodRecipeDetailQuery, claims model.Claims) (model.FoodRecipe, error)) *MockIService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFacets provides a mock function for the type MockIService
+func (_mock *MockIService) GetFacets(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipeFacets, error) {
+	ret := _mock.Called(foodRecipeQuery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFacets")
+	}
+
+	var r0 model.FoodRecipeFacets
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) (model.FoodRecipeFacets, error)); ok {
+		return returnFunc(foodRecipeQuery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.FoodRecipeQuery) model.FoodRecipeFacets); ok {
+		r0 = returnFunc(foodRecipeQuery)
+	} else {
+		r0 = ret.Get(0).(model.FoodRecipeFacets)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.FoodRecipeQuery) error); ok {
+		r1 = returnFunc(foodRecipeQuery)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_GetFacets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFacets'
+type MockIService_GetFacets_Call struct {
+	*mock.Call
+}
+
+// GetFacets is a helper method to define mock.On call
+//   - foodRecipeQuery model.FoodRecipeQuery
+func (_e *MockIService_Expecter) GetFacets(foodRecipeQuery interface{}) *MockIService_GetFacets_Call {
+	return &MockIService_GetFacets_Call{Call: _e.mock.On("GetFacets", foodRecipeQuery)}
+}
+
+func (_c *MockIService_GetFacets_Call) Run(run func(foodRecipeQuery model.FoodRecipeQuery)) *MockIService_GetFacets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.FoodRecipeQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.FoodRecipeQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetFacets_Call) Return(foodRecipeFacets model.FoodRecipeFacets, err error) *MockIService_GetFacets_Call {
+	_c.Call.Return(foodRecipeFacets, err)
+	return _c
+}
+
+func (_c *MockIService_GetFacets_Call) RunAndReturn(run func(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipeFacets, error)) *MockIService_GetFacets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTop provides a mock function for the type MockIService
+func (_mock *MockIService) GetTop(query model.TopRecipesQuery, claims model.Claims) (model.FoodRecipes, error) {
+	ret := _mock.Called(query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTop")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.TopRecipesQuery, model.Claims) (model.FoodRecipes, error)); ok {
+		return returnFunc(query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.TopRecipesQuery, model.Claims) model.FoodRecipes); ok {
+		r0 = returnFunc(query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.TopRecipesQuery, model.Claims) error); ok {
+		r1 = returnFunc(query, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_GetTop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTop'
+type MockIService_GetTop_Call struct {
+	*mock.Call
+}
+
+// GetTop is a helper method to define mock.On call
+//   - query model.TopRecipesQuery
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetTop(query interface{}, claims interface{}) *MockIService_GetTop_Call {
+	return &MockIService_GetTop_Call{Call: _e.mock.On("GetTop", query, claims)}
+}
+
+func (_c *MockIService_GetTop_Call) Run(run func(query model.TopRecipesQuery, claims model.Claims)) *MockIService_GetTop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.TopRecipesQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.TopRecipesQuery)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetTop_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIService_GetTop_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIService_GetTop_Call) RunAndReturn(run func(query model.TopRecipesQuery, claims model.Claims) (model.FoodRecipes, error)) *MockIService_GetTop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MatchPantry provides a mock function for the type MockIService
+func (_mock *MockIService) MatchPantry(query model.PantryMatchQuery, claims model.Claims) (model.PantryMatches, int64, error) {
+	ret := _mock.Called(query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MatchPantry")
+	}
+
+	var r0 model.PantryMatches
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(model.PantryMatchQuery, model.Claims) (model.PantryMatches, int64, error)); ok {
+		return returnFunc(query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.PantryMatchQuery, model.Claims) model.PantryMatches); ok {
+		r0 = returnFunc(query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.PantryMatches)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.PantryMatchQuery, model.Claims) int64); ok {
+		r1 = returnFunc(query, claims)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(model.PantryMatchQuery, model.Claims) error); ok {
+		r2 = returnFunc(query, claims)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockIService_MatchPantry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MatchPantry'
+type MockIService_MatchPantry_Call struct {
+	*mock.Call
+}
+
+// MatchPantry is a helper method to define mock.On call
+//   - query model.PantryMatchQuery
+//   - claims model.Claims
+func (_e *MockIService_Expecter) MatchPantry(query interface{}, claims interface{}) *MockIService_MatchPantry_Call {
+	return &MockIService_MatchPantry_Call{Call: _e.mock.On("MatchPantry", query, claims)}
+}
+
+func (_c *MockIService_MatchPantry_Call) Run(run func(query model.PantryMatchQuery, claims model.Claims)) *MockIService_MatchPantry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.PantryMatchQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.PantryMatchQuery)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_MatchPantry_Call) Return(pantryMatches model.PantryMatches, n int64, err error) *MockIService_MatchPantry_Call {
+	_c.Call.Return(pantryMatches, n, err)
+	return _c
+}
+
+func (_c *MockIService_MatchPantry_Call) RunAndReturn(run func(query model.PantryMatchQuery, claims model.Claims) (model.PantryMatches, int64, error)) *MockIService_MatchPantry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Suggest provides a mock function for the type MockIService
+func (_mock *MockIService) Suggest(query model.SuggestQuery) (model.Suggestions, error) {
+	ret := _mock.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Suggest")
+	}
+
+	var r0 model.Suggestions
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.SuggestQuery) (model.Suggestions, error)); ok {
+		return returnFunc(query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.SuggestQuery) model.Suggestions); ok {
+		r0 = returnFunc(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Suggestions)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.SuggestQuery) error); ok {
+		r1 = returnFunc(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Suggest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Suggest'
+type MockIService_Suggest_Call struct {
+	*mock.Call
+}
+
+// Suggest is a helper method to define mock.On call
+//   - query model.SuggestQuery
+func (_e *MockIService_Expecter) Suggest(query interface{}) *MockIService_Suggest_Call {
+	return &MockIService_Suggest_Call{Call: _e.mock.On("Suggest", query)}
+}
+
+func (_c *MockIService_Suggest_Call) Run(run func(query model.SuggestQuery)) *MockIService_Suggest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.SuggestQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.SuggestQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Suggest_Call) Return(suggestions model.Suggestions, err error) *MockIService_Suggest_Call {
+	_c.Call.Return(suggestions, err)
+	return _c
+}
+
+func (_c *MockIService_Suggest_Call) RunAndReturn(run func(query model.SuggestQuery) (model.Suggestions, error)) *MockIService_Suggest_Call {
 	_c.Call.Return(run)
 	return _c
 }
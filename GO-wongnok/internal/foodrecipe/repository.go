@@ -2,6 +2,7 @@ package foodrecipe
 
 import (
 	"fmt"
+	"wongnok/internal/global"
 	"wongnok/internal/model"
 
 	"gorm.io/gorm"
@@ -12,7 +13,14 @@ type IRepository interface {
 	Create(recipe *model.FoodRecipe) error
 	Get(foodRecipeQuery model.FoodRecipeQuery, claimsID string) (model.FoodRecipes, error)
 	Count(query model.FoodRecipeQuery) (int64, error)
+	Facets(query model.FoodRecipeQuery) (model.FoodRecipeFacets, error)
+	Suggest(query model.SuggestQuery) (model.Suggestions, error)
+	MeanRating() (float64, error)
+	MatchPantry(userID string, query model.PantryMatchQuery) (model.PantryMatches, error)
+	CountPantryMatches(userID string, query model.PantryMatchQuery) (int64, error)
+	GetByIDs(ids []uint, claimsID string) (model.FoodRecipes, error)
 	GetByID(id int, claimsID string) (model.FoodRecipe, error)
+	Exists(id int) error
 	Update(recipe *model.FoodRecipe) error
 	Delete(id int) error
 }
@@ -28,7 +36,23 @@ func NewRepository(db *gorm.DB) IRepository {
 }
 
 func (repo Repository) Create(recipe *model.FoodRecipe) error {
-	return repo.DB.Preload(clause.Associations).Create(recipe).First(&recipe).Error
+	// สูตรใหม่ต้องได้ขั้นตอนใหม่เสมอ ไม่ใช้ id ที่ client ส่งมา
+	for index := range recipe.Steps {
+		recipe.Steps[index].ID = 0
+	}
+
+	err := repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Tags").Create(recipe).Error; err != nil {
+			return err
+		}
+
+		return replaceTags(tx, recipe)
+	})
+	if err != nil {
+		return err
+	}
+
+	return repo.DB.Preload(clause.Associations).Preload("Ingredients", orderByPosition).Preload("Steps", orderByPosition).First(recipe, recipe.ID).Error
 }
 
 // ดึงรายการสูตรอาหารทั้งหมด
@@ -43,13 +67,23 @@ func (repo Repository) Get(query model.FoodRecipeQuery, claimsID string) (model.
 	db = db.Preload("CookingDuration")
 	db = db.Preload("Difficulty")
 	db = db.Preload("User")
-	db = db.Preload("Ratings")
+	db = db.Preload("Ingredients", orderByPosition)
+	db = db.Preload("Tags")
+	db = filter(db, query)
 
-	if query.Search != "" {
-		db = db.Where("name LIKE ?", "%"+query.Search+"%").Or("description LIKE ?", "%"+query.Search+"%")
+	if query.Cursor != "" {
+		after, err := query.AfterCursor()
+		if err != nil {
+			return nil, err
+		}
+		db = db.Where(after)
+	} else {
+		db = db.Offset(offset)
 	}
 
-	if err := db.Order("name asc").Limit(query.Limit).Offset(offset).Find(&recipes).Error; err != nil {
+	// ดึงเกินมา 1 แถวเพื่อรู้ว่ายังมีหน้าถัดไป (ตัดออกใน FoodRecipes.Page)
+	selectWithSortKey := query.SelectWithSortKey()
+	if err := db.Select(selectWithSortKey.SQL, selectWithSortKey.Vars...).Clauses(query.OrderBy()).Limit(query.Limit + 1).Find(&recipes).Error; err != nil {
 		return nil, err
 	}
 
@@ -60,39 +94,419 @@ func (repo Repository) Count(query model.FoodRecipeQuery) (int64, error) {
 	var count int64
 
 	db := repo.DB.Model(&model.FoodRecipes{})
+	db = filter(db, query)
+
+	if err := db.Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// Facets นับจำนวนสูตรตามระดับความยากและระยะเวลาทำ
+// แต่ละกลุ่มไม่กรองด้วยตัวเอง เพื่อให้ UI แสดงจำนวนของตัวเลือกอื่นในกลุ่มเดียวกันได้
+func (repo Repository) Facets(query model.FoodRecipeQuery) (model.FoodRecipeFacets, error) {
+	var facets model.FoodRecipeFacets
+
+	byDifficulty := query
+	byDifficulty.DifficultyID = 0
+	if err := repo.countBy("difficulties", "difficulty_id", byDifficulty, &facets.Difficulties); err != nil {
+		return model.FoodRecipeFacets{}, err
+	}
+
+	byCookingDuration := query
+	byCookingDuration.CookingDurationID = 0
+	if err := repo.countBy("cooking_durations", "cooking_duration_id", byCookingDuration, &facets.CookingDurations); err != nil {
+		return model.FoodRecipeFacets{}, err
+	}
+
+	return facets, nil
+}
+
+// Suggest หาชื่อสูตร tag และวัตถุดิบที่ใกล้เคียงกับคำที่พิมพ์ (รองรับพิมพ์ไม่ครบหรือสะกดผิด)
+// ใช้ word_similarity ของ pg_trgm ผ่าน operator <% ซึ่งใช้ GIN index ได้
+// ILIKE ช่วยกรณีข้อความภาษาไทยที่ trigram แยกคำได้ไม่ดี
+func (repo Repository) Suggest(query model.SuggestQuery) (model.Suggestions, error) {
+	var recipes, tags, ingredients model.Suggestions
+	pattern := "%" + query.Q + "%"
+
+	if err := repo.DB.Model(&model.FoodRecipe{}).
+		Select("food_recipes.id, food_recipes.name AS text, word_similarity(?, food_recipes.name) AS score", query.Q).
+		Where("? <% food_recipes.name OR food_recipes.name ILIKE ?", query.Q, pattern).
+		Order("score desc, food_recipes.name asc").
+		Limit(query.Limit).
+		Scan(&recipes).Error; err != nil {
+		return nil, err
+	}
+
+	if err := repo.DB.Model(&model.Tag{}).
+		Select("tags.id, tags.name AS text, tags.slug, word_similarity(?, tags.name) AS score", query.Q).
+		Where("? <% tags.name OR tags.name ILIKE ?", query.Q, pattern).
+		Order("score desc, tags.name asc").
+		Limit(query.Limit).
+		Scan(&tags).Error; err != nil {
+		return nil, err
+	}
+
+	// ชื่อวัตถุดิบซ้ำกันได้หลายสูตร จึงรวมตามชื่อ (ไม่สนตัวพิมพ์เล็กใหญ่)
+	if err := repo.DB.Model(&model.RecipeIngredient{}).
+		Select("MIN(recipe_ingredients.name) AS text, MAX(word_similarity(?, recipe_ingredients.name)) AS score", query.Q).
+		Joins("JOIN food_recipes ON food_recipes.id = recipe_ingredients.food_recipe_id AND food_recipes.deleted_at IS NULL").
+		Where("? <% recipe_ingredients.name OR recipe_ingredients.name ILIKE ?", query.Q, pattern).
+		Group("lower(recipe_ingredients.name)").
+		Order("score desc, text asc").
+		Limit(query.Limit).
+		Scan(&ingredients).Error; err != nil {
+		return nil, err
+	}
+
+	suggestions := make(model.Suggestions, 0, len(recipes)+len(tags)+len(ingredients))
+	for _, suggestion := range recipes {
+		suggestion.Type = model.SuggestionRecipe
+		suggestions = append(suggestions, suggestion)
+	}
+	for _, suggestion := range tags {
+		suggestion.Type = model.SuggestionTag
+		suggestions = append(suggestions, suggestion)
+	}
+	for _, suggestion := range ingredients {
+		suggestion.Type = model.SuggestionIngredient
+		suggestions = append(suggestions, suggestion)
+	}
 
+	return suggestions, nil
+}
+
+// นับสูตรที่ผ่าน filter แยกตามตาราง lookup โดยตัวเลือกที่ไม่มีสูตรจะได้ 0
+func (repo Repository) countBy(table string, column string, query model.FoodRecipeQuery, facets *model.FacetCounts) error {
+	recipes := filter(repo.DB.Model(&model.FoodRecipe{}).Select("food_recipes.id, food_recipes."+column), query)
+
+	return repo.DB.Table(table).
+		Select(fmt.Sprintf("%[1]s.id, %[1]s.name, COUNT(recipes.id) AS count", table)).
+		Joins(fmt.Sprintf("LEFT JOIN (?) recipes ON recipes.%s = %s.id", column, table), recipes).
+		Where(table + ".deleted_at IS NULL").
+		Group(table + ".id, " + table + ".name").
+		Order(table + ".id asc").
+		Scan(facets).Error
+}
+
+// เงื่อนไขค้นหาที่ใช้ร่วมกันระหว่าง Get และ Count เพื่อให้ total ตรงกับรายการที่แบ่งหน้า
+func filter(db *gorm.DB, query model.FoodRecipeQuery) *gorm.DB {
 	if query.Search != "" {
-		db = db.Where("name LIKE ?", "%"+query.Search+"%").Or("description LIKE ?", "%"+query.Search+"%")
+		db = db.Where(model.RecipeSearchCondition, query.Search)
 	}
 
-	if err := db.Count(&count).Error; err != nil {
+	if query.DifficultyID != 0 {
+		db = db.Where("food_recipes.difficulty_id = ?", query.DifficultyID)
+	}
+
+	if query.CookingDurationID != 0 {
+		db = db.Where("food_recipes.cooking_duration_id = ?", query.CookingDurationID)
+	}
+
+	if query.Author != "" {
+		db = db.Where("food_recipes.user_id = ?", query.Author)
+	}
+
+	if query.MinRating > 0 {
+		db = db.Where(model.RecipeAverageRating+" >= ?", query.MinRating)
+	}
+
+	if !query.CreatedAfter.IsZero() {
+		db = db.Where("food_recipes.created_at >= ?", query.CreatedAfter)
+	}
+
+	if !query.CreatedBefore.IsZero() {
+		db = db.Where("food_recipes.created_at < ?", query.CreatedBefore)
+	}
+
+	if query.HasImage != nil {
+		if *query.HasImage {
+			db = db.Where("food_recipes.image_url IS NOT NULL AND food_recipes.image_url <> ''")
+		} else {
+			db = db.Where("(food_recipes.image_url IS NULL OR food_recipes.image_url = '')")
+		}
+	}
+
+	// ค่าที่ไม่รู้จักถูกตรวจไปแล้วใน Service.Get
+	// ต้องไม่มีสารก่อภูมิแพ้ที่ระบุเลยสักตัว
+	if allergens, _ := query.ExcludedAllergens(); len(allergens) > 0 {
+		db = db.Where(`NOT EXISTS (
+			SELECT 1 FROM jsonb_array_elements_text(food_recipes.allergens) AS allergen
+			WHERE allergen IN ?
+		)`, []string(allergens))
+	}
+
+	// ต้องเข้ากันได้กับทุกรูปแบบการกินที่ระบุ
+	if diets, _ := query.DietList(); len(diets) > 0 {
+		db = db.Where("food_recipes.diets @> ?::jsonb", diets)
+	}
+
+	// ต้องมีครบทุก tag ที่ระบุ
+	if slugs := query.TagSlugs(); len(slugs) > 0 {
+		db = db.Where(`food_recipes.id IN (
+			SELECT frt.food_recipe_id FROM food_recipe_tags frt
+			JOIN tags ON tags.id = frt.tag_id AND tags.deleted_at IS NULL
+			WHERE tags.slug IN ?
+			GROUP BY frt.food_recipe_id
+			HAVING COUNT(DISTINCT tags.id) = ?
+		)`, slugs, len(slugs))
+	}
+
+	return db
+}
+
+// MeanRating ค่าเฉลี่ยของคะแนนทุกรายการในระบบ
+func (repo Repository) MeanRating() (float64, error) {
+	var mean float64
+
+	if err := repo.DB.Raw("SELECT " + model.RecipeMeanRating).Scan(&mean).Error; err != nil {
+		return 0, err
+	}
+
+	return mean, nil
+}
+
+// pantryMatchCounts จำนวนวัตถุดิบทั้งหมดและที่อยู่ในตู้ของผู้ใช้ ของสูตรที่มีของในตู้อย่างน้อยหนึ่งอย่าง
+// ถือว่ามีเมื่อผูกกับวัตถุดิบเดียวกันในพจนานุกรม หรือชื่อตรงกันทั้งชื่อ (ตรงกับ model.PantryItems.Covers)
+// เริ่มจากของในตู้แล้ว join ผ่าน idx_recipe_ingredients_ingredient และ idx_recipe_ingredients_term
+// จึงไม่ต้องไล่ทุกแถวของ recipe_ingredients (UNION ตัดแถวที่ตรงทั้งสองแบบออก)
+const pantryMatchCounts = `
+	SELECT
+		owned.food_recipe_id,
+		(
+			SELECT COUNT(*) FROM recipe_ingredients
+			WHERE recipe_ingredients.food_recipe_id = owned.food_recipe_id AND recipe_ingredients.deleted_at IS NULL
+		) AS ingredient_count,
+		owned.owned_count
+	FROM (
+		SELECT owned_ingredients.food_recipe_id, COUNT(*) AS owned_count
+		FROM (
+			SELECT recipe_ingredients.food_recipe_id, recipe_ingredients.id
+			FROM pantry_items
+			JOIN recipe_ingredients ON recipe_ingredients.ingredient_id = pantry_items.ingredient_id
+				AND recipe_ingredients.deleted_at IS NULL
+			WHERE pantry_items.user_id = ? AND pantry_items.deleted_at IS NULL
+			UNION
+			SELECT recipe_ingredients.food_recipe_id, recipe_ingredients.id
+			FROM pantry_items
+			JOIN recipe_ingredients ON LOWER(BTRIM(recipe_ingredients.name)) = LOWER(pantry_items.name)
+				AND recipe_ingredients.deleted_at IS NULL
+			WHERE pantry_items.user_id = ? AND pantry_items.deleted_at IS NULL
+		) AS owned_ingredients
+		GROUP BY owned_ingredients.food_recipe_id
+	) AS owned`
+
+// pantryMatches สูตรที่มีวัตถุดิบในตู้อย่างน้อยหนึ่งอย่าง และขาดไม่เกิน MaxMissing
+func (repo Repository) pantryMatches(userID string, query model.PantryMatchQuery) *gorm.DB {
+	db := repo.DB.Table("(?) AS pantry_matches", repo.DB.Raw(pantryMatchCounts, userID, userID)).
+		Joins("JOIN food_recipes ON food_recipes.id = pantry_matches.food_recipe_id AND food_recipes.deleted_at IS NULL")
+
+	if query.MaxMissing != nil {
+		db = db.Where("pantry_matches.ingredient_count - pantry_matches.owned_count <= ?", *query.MaxMissing)
+	}
+
+	return db
+}
+
+// MatchPantry เรียงสูตรตามสัดส่วนวัตถุดิบที่มีแล้ว แล้วตามจำนวนที่ขาด (น้อยก่อน)
+func (repo Repository) MatchPantry(userID string, query model.PantryMatchQuery) (model.PantryMatches, error) {
+	var matches = make(model.PantryMatches, 0)
+	offset := (query.Page - 1) * query.Limit
+
+	if err := repo.pantryMatches(userID, query).
+		Select("pantry_matches.*").
+		Order("pantry_matches.owned_count::NUMERIC / pantry_matches.ingredient_count desc, pantry_matches.ingredient_count - pantry_matches.owned_count asc, food_recipes.id desc").
+		Limit(query.Limit).
+		Offset(offset).
+		Scan(&matches).Error; err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+func (repo Repository) CountPantryMatches(userID string, query model.PantryMatchQuery) (int64, error) {
+	var count int64
+
+	if err := repo.pantryMatches(userID, query).Count(&count).Error; err != nil {
 		return 0, err
 	}
 
 	return count, nil
 }
 
+// GetByIDs สูตรตาม id พร้อมความสัมพันธ์เหมือนรายการสูตร (ลำดับไม่ตรงกับ ids)
+func (repo Repository) GetByIDs(ids []uint, claimsID string) (model.FoodRecipes, error) {
+	var recipes = make(model.FoodRecipes, 0)
+
+	if err := repo.DB.Preload("Favorite", "user_id = ?", claimsID).
+		Preload("Rating", "user_id = ?", claimsID).
+		Preload("CookingDuration").
+		Preload("Difficulty").
+		Preload("User").
+		Preload("Ingredients", orderByPosition).
+		Preload("Tags").
+		Where("id IN ?", ids).
+		Find(&recipes).Error; err != nil {
+		return nil, err
+	}
+
+	return recipes, nil
+}
+
 // ดึงรายละเอียดสูตรอาหารตาม id (พร้อม preload ความสัมพันธ์)
 // - ใส่ Favorite/Rating ของผู้ใช้ปัจจุบันมาด้วย (จาก claimsID)
 func (repo Repository) GetByID(id int, claimsID string) (model.FoodRecipe, error) {
 	var recipe model.FoodRecipe
 	fmt.Println("FGDD", claimsID)
-	if err := repo.DB.Preload("Favorite", "user_id = ?", claimsID).Preload("Rating", "user_id = ?", claimsID).Preload("CookingDuration").Preload("Difficulty").Preload("Difficulty").Preload("User").Preload("Ratings").First(&recipe, id).Error; err != nil {
+	if err := repo.DB.Select("food_recipes.*, "+model.RecipeCookCount+" AS cook_count").Preload("Favorite", "user_id = ?", claimsID).Preload("Rating", "user_id = ?", claimsID).Preload("CookingDuration").Preload("Difficulty").Preload("User").Preload("Ingredients", orderByPosition).Preload("Steps", orderByPosition).Preload("Tags").First(&recipe, id).Error; err != nil {
 		return model.FoodRecipe{}, err
 	}
 
 	return recipe, nil
 }
 
+// Exists เลือกแค่ id ไม่ preload อะไร ใช้ตรวจว่ามีสูตรก่อนบันทึกข้อมูลที่อ้างถึง
+func (repo Repository) Exists(id int) error {
+	return repo.DB.Select("id").First(&model.FoodRecipe{}, id).Error
+}
+
+// แก้ไขสูตรอาหาร พร้อมวัตถุดิบและขั้นตอนภายใน transaction เดียวกัน
+// - วัตถุดิบ: แทนที่ชุดเดิมทั้งหมด
+// - ขั้นตอน: ลบขั้นตอนที่ไม่ได้ส่งมา แก้ไข/เรียงลำดับขั้นตอนเดิม และเพิ่มขั้นตอนใหม่
 func (repo Repository) Update(recipe *model.FoodRecipe) error {
-	// update
-	if err := repo.DB.Model(&recipe).Where("id = ?", recipe.ID).Updates(recipe).Error; err != nil {
+	err := repo.DB.Transaction(func(tx *gorm.DB) error {
+		// update
+		// คอลัมน์สรุปคะแนนดูแลโดย rating repository ห้ามเขียนทับจากที่นี่
+		omit := append([]string{"Ingredients", "Steps", "Tags"}, model.RatingAggregateColumns...)
+		if err := tx.Model(&recipe).Omit(omit...).Where("id = ?", recipe.ID).Updates(recipe).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&model.FoodRecipe{}).Where("id = ?", recipe.ID).Updates(derivedColumns(recipe)).Error; err != nil {
+			return err
+		}
+
+		if err := replaceIngredients(tx, recipe); err != nil {
+			return err
+		}
+
+		if err := replaceTags(tx, recipe); err != nil {
+			return err
+		}
+
+		return syncSteps(tx, recipe)
+	})
+	if err != nil {
 		return err
 	}
 
-	return repo.DB.Preload(clause.Associations).First(&recipe, recipe.ID).Error
+	return repo.DB.Preload(clause.Associations).Preload("Ingredients", orderByPosition).Preload("Steps", orderByPosition).First(&recipe, recipe.ID).Error
 }
 
 func (repo Repository) Delete(id int) error {
 	return repo.DB.Delete(&model.FoodRecipes{}, id).Error
 }
+
+// derivedColumns ค่าที่คำนวณจากวัตถุดิบอาจกลับเป็นศูนย์หรือว่างได้ (เช่น ลบวัตถุดิบออกหมด)
+// Updates แบบ struct จะข้ามค่าเหล่านี้ จึงต้องเขียนแบบ map
+func derivedColumns(recipe *model.FoodRecipe) map[string]interface{} {
+	columns := recipe.Nutrition.Columns()
+	for column, value := range recipe.Dietary.Columns() {
+		columns[column] = value
+	}
+	columns["dietary_override"] = recipe.DietaryOverride
+
+	return columns
+}
+
+func replaceIngredients(tx *gorm.DB, recipe *model.FoodRecipe) error {
+	if err := tx.Unscoped().Where("food_recipe_id = ?", recipe.ID).Delete(&model.RecipeIngredient{}).Error; err != nil {
+		return err
+	}
+
+	if len(recipe.Ingredients) == 0 {
+		return nil
+	}
+
+	for index := range recipe.Ingredients {
+		recipe.Ingredients[index].FoodRecipeID = recipe.ID
+	}
+
+	return tx.Create(&recipe.Ingredients).Error
+}
+
+// แทนที่ tag ของสูตรด้วยชุดใหม่ ทุก tag ต้องมีอยู่จริง
+func replaceTags(tx *gorm.DB, recipe *model.FoodRecipe) error {
+	if err := tx.Where("food_recipe_id = ?", recipe.ID).Delete(&model.FoodRecipeTag{}).Error; err != nil {
+		return err
+	}
+
+	ids := recipe.Tags.IDs()
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var count int64
+	if err := tx.Model(&model.Tag{}).Where("id IN ?", ids).Count(&count).Error; err != nil {
+		return err
+	}
+	if count != int64(len(ids)) {
+		return global.ErrInvalidTag
+	}
+
+	links := make([]model.FoodRecipeTag, 0, len(ids))
+	for _, id := range ids {
+		links = append(links, model.FoodRecipeTag{FoodRecipeID: recipe.ID, TagID: id})
+	}
+
+	return tx.Create(&links).Error
+}
+
+func syncSteps(tx *gorm.DB, recipe *model.FoodRecipe) error {
+	// ลบขั้นตอนเดิมที่ไม่อยู่ในชุดใหม่
+	remove := tx.Unscoped().Where("food_recipe_id = ?", recipe.ID)
+	if ids := recipe.Steps.IDs(); len(ids) > 0 {
+		remove = remove.Where("id NOT IN ?", ids)
+	}
+	if err := remove.Delete(&model.RecipeStep{}).Error; err != nil {
+		return err
+	}
+
+	for index := range recipe.Steps {
+		step := &recipe.Steps[index]
+		step.FoodRecipeID = recipe.ID
+
+		if step.ID != 0 {
+			result := tx.Model(&model.RecipeStep{}).
+				Where("id = ? AND food_recipe_id = ?", step.ID, recipe.ID).
+				Updates(map[string]interface{}{
+					"position":         step.Position,
+					"text":             step.Text,
+					"duration_seconds": step.DurationSeconds,
+					"image_url":        step.ImageURL,
+				})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected > 0 {
+				continue
+			}
+
+			// id ไม่ใช่ของสูตรนี้ ให้ถือเป็นขั้นตอนใหม่
+			step.ID = 0
+		}
+
+		if err := tx.Create(step).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// เรียงวัตถุดิบ/ขั้นตอนตามลำดับที่ผู้เขียนสูตรกำหนด
+func orderByPosition(db *gorm.DB) *gorm.DB {
+	return db.Order("position asc")
+}
@@ -294,7 +294,7 @@ func (suite *RepositoryGetTestSuite) TestGetRecipe() {
 		Limit:  10,
 	}
 
-	response, err := suite.repo.Get(foodRecipeQuery)
+	response, err := suite.repo.Get(foodRecipeQuery, "")
 
 	suite.NoError(err)
 	suite.Equal(2, len(response))
@@ -310,21 +310,22 @@ func (suite *RepositoryGetTestSuite) TestGetRecipeLimit() {
 		Limit:  1,
 	}
 
-	response, err := suite.repo.Get(foodRecipeQuery)
+	response, err := suite.repo.Get(foodRecipeQuery, "")
 
+	// ดึงเกิน limit มา 1 แถวไว้ดูว่ามีหน้าถัดไป (ตัดออกใน FoodRecipes.Page)
 	suite.NoError(err)
-	suite.Equal(1, len(response))
+	suite.Equal(2, len(response))
 }
 
 func (suite *RepositoryGetTestSuite) TestGetRecipeSearch() {
 
 	foodRecipeQuery := model.FoodRecipeQuery{
-		Search: "mle",
+		Search: "Oml",
 		Page:   1,
 		Limit:  10,
 	}
 
-	response, err := suite.repo.Get(foodRecipeQuery)
+	response, err := suite.repo.Get(foodRecipeQuery, "")
 
 	suite.NoError(err)
 	suite.Equal(1, len(response))
@@ -361,7 +362,7 @@ func (suite *RepositoryCountTestSuite) SetupTest() {
 
 func (suite *RepositoryCountTestSuite) TestCount() {
 
-	count, err := suite.repo.Count()
+	count, err := suite.repo.Count(model.FoodRecipeQuery{})
 	suite.NoError(err)
 
 	suite.Equal(int64(2), count)
@@ -397,7 +398,7 @@ func (suite *RepositoryGetByIDTestSuite) SetupTest() {
 
 func (suite *RepositoryGetByIDTestSuite) TestGetByID() {
 
-	result, err := suite.repo.GetByID(1)
+	result, err := suite.repo.GetByID(1, "")
 	suite.NoError(err)
 
 	suite.Equal(uint(1), result.ID)
@@ -405,7 +406,7 @@ func (suite *RepositoryGetByIDTestSuite) TestGetByID() {
 
 func (suite *RepositoryGetByIDTestSuite) TestErrorGetByID() {
 
-	result, err := suite.repo.GetByID(99)
+	result, err := suite.repo.GetByID(99, "")
 
 	suite.Equal(model.FoodRecipe{}, result)
 	suite.ErrorIs(err, gorm.ErrRecordNotFound)
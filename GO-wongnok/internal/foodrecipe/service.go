@@ -1,33 +1,64 @@
 package foodrecipe
 
 import (
+	"strings"
+	"sync"
+	"time"
 	"wongnok/internal/global"
+	"wongnok/internal/ingredient"
 	"wongnok/internal/model"
 	"wongnok/internal/model/dto"
+	"wongnok/internal/pantry"
+	"wongnok/internal/units"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
 
+type IPantryService pantry.IService
+type IIngredientService ingredient.IService
+
 type IService interface {
 	Create(request dto.FoodRecipeRequest, claims model.Claims) (model.FoodRecipe, error)
-	Get(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, error)
-	GetByID(id int, claims model.Claims) (model.FoodRecipe, error)
+	Get(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, string, error)
+	GetFacets(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipeFacets, error)
+	Suggest(query model.SuggestQuery) (model.Suggestions, error)
+	GetTop(query model.TopRecipesQuery, claims model.Claims) (model.FoodRecipes, error)
+	MatchPantry(query model.PantryMatchQuery, claims model.Claims) (model.PantryMatches, int64, error)
+	GetByID(id int, query model.FoodRecipeDetailQuery, claims model.Claims) (model.FoodRecipe, error)
+	Exists(id int) error
+	BayesianPrior() (model.BayesianPrior, error)
 	Update(request dto.FoodRecipeRequest, id int, claims model.Claims) (model.FoodRecipe, error)
 	Delete(id int, claims model.Claims) error
 }
 
 type Service struct {
-	Repository IRepository
+	Repository        IRepository
+	PantryService     IPantryService
+	IngredientService IIngredientService
+	MeanRatingCache   *MeanRatingCache // nil จะ query ค่าเฉลี่ยทุกครั้ง
 }
 
 func NewService(db *gorm.DB) IService {
 	return &Service{
-		Repository: NewRepository(db),
+		Repository:        NewRepository(db),
+		PantryService:     pantry.NewService(db),
+		IngredientService: ingredient.NewService(db),
+		MeanRatingCache:   &MeanRatingCache{},
 	}
 }
 
+// MeanRatingTTL ระยะเวลาที่ใช้ค่าเฉลี่ยคะแนนของระบบซ้ำ ค่านี้เปลี่ยนช้าจึงไม่ต้อง query ทุก request
+const MeanRatingTTL = 5 * time.Minute
+
+// MeanRatingCache เก็บค่าเฉลี่ยคะแนนของระบบไว้ใช้เป็น prior เมื่อไม่ได้กำหนด RANKING_GLOBAL_MEAN
+type MeanRatingCache struct {
+	mutex     sync.Mutex
+	mean      float64
+	expiresAt time.Time
+}
+
 func (service Service) Create(request dto.FoodRecipeRequest, claims model.Claims) (model.FoodRecipe, error) {
 	validate := validator.New()
 	if err := validate.Struct(request); err != nil {
@@ -37,6 +68,26 @@ func (service Service) Create(request dto.FoodRecipeRequest, claims model.Claims
 	var recipe model.FoodRecipe
 	recipe = recipe.FromRequest(request, claims)
 
+	ingredients, err := service.IngredientService.Link(recipe.Ingredients)
+	if err != nil {
+		return model.FoodRecipe{}, errors.Wrap(err, "link ingredients")
+	}
+	recipe.Ingredients = ingredients
+
+	nutrition, err := service.IngredientService.EstimateNutrition(recipe.Ingredients)
+	if err != nil {
+		return model.FoodRecipe{}, errors.Wrap(err, "estimate nutrition")
+	}
+	recipe.Nutrition = nutrition
+
+	if !recipe.DietaryOverride {
+		dietary, err := service.IngredientService.DeriveDietary(recipe.Ingredients)
+		if err != nil {
+			return model.FoodRecipe{}, errors.Wrap(err, "derive dietary")
+		}
+		recipe.Dietary = dietary
+	}
+
 	if err := service.Repository.Create(&recipe); err != nil {
 		return model.FoodRecipe{}, errors.Wrap(err, "create recipe")
 	}
@@ -44,33 +95,232 @@ func (service Service) Create(request dto.FoodRecipeRequest, claims model.Claims
 	return recipe, nil
 }
 
-func (service Service) Get(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, error) {
-	total, err := service.Repository.Count(foodRecipeQuery)
+// Get คืนรายการสูตรอาหาร จำนวนทั้งหมด และ cursor ของหน้าถัดไป
+// เมื่อใช้ cursor จะไม่นับจำนวนทั้งหมดซ้ำ (client ได้ไปแล้วจากหน้าแรก)
+func (service Service) Get(foodRecipeQuery model.FoodRecipeQuery, claims model.Claims) (model.FoodRecipes, int64, string, error) {
+	if _, err := foodRecipeQuery.ExcludedAllergens(); err != nil {
+		return nil, 0, "", err
+	}
+	if _, err := foodRecipeQuery.DietList(); err != nil {
+		return nil, 0, "", err
+	}
+
+	prior, err := service.BayesianPrior()
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, "", err
+	}
+	foodRecipeQuery = foodRecipeQuery.WithPrior(prior)
+
+	var total int64
+	if foodRecipeQuery.Cursor == "" {
+		count, err := service.Repository.Count(foodRecipeQuery)
+		if err != nil {
+			return nil, 0, "", err
+		}
+		total = count
 	}
 
 	results, err := service.Repository.Get(foodRecipeQuery, claims.ID)
+	if err != nil {
+		return nil, 0, "", errors.Wrap(err, "get recipes")
+	}
+
+	results, nextCursor := results.Page(foodRecipeQuery)
+	results = results.CalculateAverageRatings().CalculateBayesianRatings(foodRecipeQuery.Prior)
+
+	if system, ok := units.ParseSystem(foodRecipeQuery.Units); ok {
+		results = results.ConvertUnits(system)
+	}
+
+	return results, total, nextCursor, nil
+}
+
+// GetTop คืนสูตรที่คะแนน Bayesian สูงสุด
+func (service Service) GetTop(query model.TopRecipesQuery, claims model.Claims) (model.FoodRecipes, error) {
+	if query.Limit == 0 {
+		query.Limit = model.DefaultTopRecipesLimit
+	}
+
+	prior, err := service.BayesianPrior()
+	if err != nil {
+		return nil, err
+	}
+
+	foodRecipeQuery := model.FoodRecipeQuery{
+		Page:  1,
+		Limit: query.Limit,
+		Sort:  model.SortTopRated,
+		Prior: prior,
+	}
+
+	results, err := service.Repository.Get(foodRecipeQuery, claims.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get top recipes")
+	}
+
+	results, _ = results.Page(foodRecipeQuery)
+	results = results.CalculateAverageRatings().CalculateBayesianRatings(prior)
+
+	if system, ok := units.ParseSystem(query.Units); ok {
+		results = results.ConvertUnits(system)
+	}
+
+	return results, nil
+}
+
+// MatchPantry สูตรที่ทำได้จากวัตถุดิบในตู้ของผู้ใช้ พร้อมรายการที่ยังขาด
+func (service Service) MatchPantry(query model.PantryMatchQuery, claims model.Claims) (model.PantryMatches, int64, error) {
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.Limit == 0 {
+		query.Limit = model.DefaultPantryMatchLimit
+	}
+
+	items, err := service.PantryService.Get(claims)
 	if err != nil {
 		return nil, 0, err
 	}
+	if len(items) == 0 {
+		return model.PantryMatches{}, 0, nil
+	}
+
+	total, err := service.Repository.CountPantryMatches(claims.ID, query)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "count pantry matches")
+	}
+
+	matches, err := service.Repository.MatchPantry(claims.ID, query)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "match pantry")
+	}
+
+	ids := make([]uint, 0, len(matches))
+	for _, match := range matches {
+		ids = append(ids, match.FoodRecipeID)
+	}
 
-	results = results.CalculateAverageRatings()
+	recipes, err := service.Repository.GetByIDs(ids, claims.ID)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "get recipes")
+	}
+
+	system, convert := units.ParseSystem(query.Units)
+	byID := make(map[uint]model.FoodRecipe, len(recipes))
+	for _, recipe := range recipes.CalculateAverageRatings() {
+		byID[recipe.ID] = recipe
+	}
+
+	for i, match := range matches {
+		recipe := byID[match.FoodRecipeID]
+		matches[i].Missing = items.Missing(recipe.Ingredients)
+		if convert {
+			recipe = recipe.ConvertUnits(system)
+		}
+		matches[i].FoodRecipe = recipe
+	}
+
+	return matches, total, nil
+}
+
+// BayesianPrior ค่าตั้งต้นจาก config ถ้าไม่ได้กำหนดค่าเฉลี่ยรวมไว้จะใช้ค่าเฉลี่ยของคะแนนทั้งหมด (cache ไว้)
+// ส่งต่อให้ repository ผ่าน FoodRecipeQuery.Prior เพื่อให้ลำดับใน SQL ตรงกับ BayesianRating ที่คืนไป
+// รายการอื่นที่เรียง top-rated (เช่น favorite) ใช้ค่านี้ด้วย ค่าใน cursor จึงตรงกันทุกรายการ
+func (service Service) BayesianPrior() (model.BayesianPrior, error) {
+	prior := model.BayesianPrior{MinVotes: global.Ranking.MinVotes, Mean: global.Ranking.GlobalMean}
+	if prior.Mean > 0 {
+		return prior, nil
+	}
+
+	mean, err := service.meanRating()
+	if err != nil {
+		return model.BayesianPrior{}, errors.Wrap(err, "mean rating")
+	}
+	prior.Mean = mean
+
+	return prior, nil
+}
+
+func (service Service) meanRating() (float64, error) {
+	cache := service.MeanRatingCache
+	if cache == nil {
+		return service.Repository.MeanRating()
+	}
+
+	// ถือ lock ระหว่าง query เพื่อให้ request ที่เข้ามาพร้อมกันตอนหมดอายุ query แค่ครั้งเดียว
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if time.Now().Before(cache.expiresAt) {
+		return cache.mean, nil
+	}
+
+	mean, err := service.Repository.MeanRating()
+	if err != nil {
+		return 0, err
+	}
+	cache.mean, cache.expiresAt = mean, time.Now().Add(MeanRatingTTL)
+
+	return mean, nil
+}
+
+func (service Service) GetFacets(foodRecipeQuery model.FoodRecipeQuery) (model.FoodRecipeFacets, error) {
+	facets, err := service.Repository.Facets(foodRecipeQuery)
+	if err != nil {
+		return model.FoodRecipeFacets{}, errors.Wrap(err, "count facets")
+	}
 
-	return results, total, nil
+	return facets, nil
 }
 
-func (service Service) GetByID(id int, claims model.Claims) (model.FoodRecipe, error) {
+func (service Service) Suggest(query model.SuggestQuery) (model.Suggestions, error) {
+	query.Q = strings.TrimSpace(query.Q)
+	if query.Q == "" {
+		return model.Suggestions{}, nil
+	}
+
+	if query.Limit == 0 {
+		query.Limit = model.DefaultSuggestionLimit
+	}
+
+	suggestions, err := service.Repository.Suggest(query)
+	if err != nil {
+		return nil, errors.Wrap(err, "suggest")
+	}
+
+	return suggestions, nil
+}
+
+func (service Service) GetByID(id int, query model.FoodRecipeDetailQuery, claims model.Claims) (model.FoodRecipe, error) {
 	results, err := service.Repository.GetByID(id, claims.ID)
 	if err != nil {
 		return model.FoodRecipe{}, err
 	}
 
-	results = results.CalculateAverageRating()
+	prior, err := service.BayesianPrior()
+	if err != nil {
+		return model.FoodRecipe{}, err
+	}
+
+	results = results.CalculateAverageRating().CalculateBayesianRating(prior)
+
+	if query.Servings > 0 {
+		results = results.ScaleServings(query.Servings)
+	}
+
+	if system, ok := units.ParseSystem(query.Units); ok {
+		results = results.ConvertUnits(system)
+	}
 
 	return results, nil
 }
 
+// Exists คืน gorm.ErrRecordNotFound เมื่อไม่มีสูตรนี้ (หรือถูกลบไปแล้ว)
+// ให้แพ็กเกจอื่นตรวจก่อนบันทึกข้อมูลที่อ้างถึงสูตร เพราะ foreign key ที่ล้มจะกลายเป็น 500
+func (service Service) Exists(id int) error {
+	return service.Repository.Exists(id)
+}
+
 func (service Service) Update(request dto.FoodRecipeRequest, id int, claims model.Claims) (model.FoodRecipe, error) {
 
 	validate := validator.New()
@@ -91,6 +341,26 @@ func (service Service) Update(request dto.FoodRecipeRequest, id int, claims mode
 
 	recipe = recipe.FromRequest(request, claims)
 
+	ingredients, err := service.IngredientService.Link(recipe.Ingredients)
+	if err != nil {
+		return model.FoodRecipe{}, errors.Wrap(err, "link ingredients")
+	}
+	recipe.Ingredients = ingredients
+
+	nutrition, err := service.IngredientService.EstimateNutrition(recipe.Ingredients)
+	if err != nil {
+		return model.FoodRecipe{}, errors.Wrap(err, "estimate nutrition")
+	}
+	recipe.Nutrition = nutrition
+
+	if !recipe.DietaryOverride {
+		dietary, err := service.IngredientService.DeriveDietary(recipe.Ingredients)
+		if err != nil {
+			return model.FoodRecipe{}, errors.Wrap(err, "derive dietary")
+		}
+		recipe.Dietary = dietary
+	}
+
 	if err := service.Repository.Update(&recipe); err != nil {
 		return model.FoodRecipe{}, errors.Wrap(err, "update recipe")
 	}
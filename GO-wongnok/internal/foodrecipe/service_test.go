@@ -34,8 +34,9 @@ type ServiceCreateTestSuite struct {
 	suite.Suite
 
 	// Dependencies
-	service foodrecipe.IService
-	repo    *MockIRepository
+	service           foodrecipe.IService
+	repo              *MockIRepository
+	ingredientService *MockIIngredientService
 
 	// Mock data
 	errRepositoryCreate error
@@ -44,10 +45,18 @@ type ServiceCreateTestSuite struct {
 // This will run before each test
 func (suite *ServiceCreateTestSuite) SetupTest() {
 	suite.repo = new(MockIRepository)
+	suite.ingredientService = new(MockIIngredientService)
 	suite.service = &foodrecipe.Service{
-		Repository: suite.repo,
+		Repository:        suite.repo,
+		IngredientService: suite.ingredientService,
 	}
 
+	suite.ingredientService.On("Link", mock.Anything).Return(func(ingredients model.RecipeIngredients) (model.RecipeIngredients, error) {
+		return ingredients, nil
+	})
+	suite.ingredientService.On("EstimateNutrition", mock.Anything).Return(model.Nutrition{}, nil)
+	suite.ingredientService.On("DeriveDietary", mock.Anything).Return(model.Dietary{}, nil)
+
 	suite.errRepositoryCreate = nil
 
 	suite.repo.On("Create", mock.Anything).Run(func(args mock.Arguments) {
@@ -167,11 +176,13 @@ func (suite *ServiceGetTestSuite) SetupTest() {
 	}
 	suite.errRepositoryGet = nil
 
-	suite.repo.On("Count").Return(func() (int64, error) {
+	suite.repo.On("MeanRating").Return(4.0, nil)
+
+	suite.repo.On("Count", mock.AnythingOfType("model.FoodRecipeQuery")).Return(func(model.FoodRecipeQuery) (int64, error) {
 		return suite.respRepositoryCount, suite.errRepositoryCount
 	})
 
-	suite.repo.On("Get", mock.AnythingOfType("model.FoodRecipeQuery")).Return(func(model.FoodRecipeQuery) (model.FoodRecipes, error) {
+	suite.repo.On("Get", mock.AnythingOfType("model.FoodRecipeQuery"), mock.AnythingOfType("string")).Return(func(model.FoodRecipeQuery, string) (model.FoodRecipes, error) {
 		return suite.respRepositoryGet, suite.errRepositoryGet
 	})
 }
@@ -183,7 +194,7 @@ func (suite *ServiceGetTestSuite) TestReturnRecipes() {
 		Page:   1,
 		Limit:  10,
 	}
-	recipes, total, err := suite.service.Get(foodRecipeQuery)
+	recipes, total, _, err := suite.service.Get(foodRecipeQuery, model.Claims{})
 
 	suite.NoError(err)
 	suite.Equal(model.FoodRecipes{
@@ -194,6 +205,54 @@ func (suite *ServiceGetTestSuite) TestReturnRecipes() {
 	suite.Equal(int64(10), total)
 }
 
+func (suite *ServiceGetTestSuite) TestReturnNextCursorWhenMoreRecipes() {
+	// repository ดึงเกิน limit มา 1 แถว แปลว่ายังมีหน้าถัดไป
+	suite.respRepositoryGet = model.FoodRecipes{
+		{Model: gorm.Model{ID: 3}, Name: "Third"},
+		{Model: gorm.Model{ID: 2}, Name: "Second"},
+	}
+
+	foodRecipeQuery := model.FoodRecipeQuery{
+		Page:  1,
+		Limit: 1,
+	}
+	recipes, _, nextCursor, err := suite.service.Get(foodRecipeQuery, model.Claims{})
+
+	suite.NoError(err)
+	suite.Len(recipes, 1)
+	suite.NotEmpty(nextCursor)
+
+	cursor, err := model.DecodeRecipeCursor(nextCursor)
+	suite.NoError(err)
+	suite.Equal(uint(3), cursor.ID)
+}
+
+func (suite *ServiceGetTestSuite) TestReturnEmptyNextCursorOnLastPage() {
+	foodRecipeQuery := model.FoodRecipeQuery{
+		Page:  1,
+		Limit: 10,
+	}
+	_, _, nextCursor, err := suite.service.Get(foodRecipeQuery, model.Claims{})
+
+	suite.NoError(err)
+	suite.Empty(nextCursor)
+}
+
+func (suite *ServiceGetTestSuite) TestSkipCountWhenCursor() {
+	cursor := model.RecipeCursor{Sort: model.SortNewest, Key: "2025-01-01T00:00:00Z", ID: 3}.Encode()
+
+	foodRecipeQuery := model.FoodRecipeQuery{
+		Limit:  10,
+		Sort:   model.SortNewest,
+		Cursor: cursor,
+	}
+	_, total, _, err := suite.service.Get(foodRecipeQuery, model.Claims{})
+
+	suite.NoError(err)
+	suite.Empty(total)
+	suite.repo.AssertNotCalled(suite.T(), "Count", mock.Anything)
+}
+
 func (suite *ServiceGetTestSuite) TestErrorWhenGet() {
 	suite.respRepositoryGet = nil
 	suite.errRepositoryGet = assert.AnError
@@ -203,7 +262,7 @@ func (suite *ServiceGetTestSuite) TestErrorWhenGet() {
 		Page:   1,
 		Limit:  10,
 	}
-	recipes, total, err := suite.service.Get(foodRecipeQuery)
+	recipes, total, _, err := suite.service.Get(foodRecipeQuery, model.Claims{})
 
 	suite.ErrorIs(err, assert.AnError)
 
@@ -222,7 +281,7 @@ func (suite *ServiceGetTestSuite) TestErrorWhenCount() {
 		Limit:  10,
 	}
 
-	recipes, total, err := suite.service.Get(foodRecipeQuery)
+	recipes, total, _, err := suite.service.Get(foodRecipeQuery, model.Claims{})
 
 	suite.ErrorIs(err, assert.AnError)
 
@@ -259,7 +318,8 @@ func (suite *ServiceGetByIDTestSuite) SetupTest() {
 	}
 	suite.errRepositoryGetByID = nil
 
-	suite.repo.On("GetByID", mock.AnythingOfType("int")).Return(func(id int) (model.FoodRecipe, error) {
+	suite.repo.On("MeanRating").Return(4.0, nil)
+	suite.repo.On("GetByID", mock.AnythingOfType("int"), mock.AnythingOfType("string")).Return(func(id int, claimsID string) (model.FoodRecipe, error) {
 		if id == 1 {
 			return suite.respRepositoryGetByID, suite.errRepositoryGetByID
 		}
@@ -268,7 +328,7 @@ func (suite *ServiceGetByIDTestSuite) SetupTest() {
 }
 
 func (suite *ServiceGetByIDTestSuite) TestReturnRecipeWhenFound() {
-	recipe, err := suite.service.GetByID(1)
+	recipe, err := suite.service.GetByID(1, model.FoodRecipeDetailQuery{}, model.Claims{})
 	suite.NoError(err)
 
 	expectedRecipe := model.FoodRecipe{
@@ -276,27 +336,48 @@ func (suite *ServiceGetByIDTestSuite) TestReturnRecipeWhenFound() {
 	}
 
 	suite.Equal(expectedRecipe, recipe)
-	suite.repo.AssertCalled(suite.T(), "GetByID", 1)
+	suite.repo.AssertCalled(suite.T(), "GetByID", 1, "")
 }
 
 func (suite *ServiceGetByIDTestSuite) TestErrorWhenNotFound() {
-	recipe, err := suite.service.GetByID(2)
+	recipe, err := suite.service.GetByID(2, model.FoodRecipeDetailQuery{}, model.Claims{})
 
 	suite.ErrorIs(err, gorm.ErrRecordNotFound)
 	suite.Empty(recipe)
-	suite.repo.AssertCalled(suite.T(), "GetByID", 2)
+	suite.repo.AssertCalled(suite.T(), "GetByID", 2, "")
 }
 
 func TestServiceGetRecipeByID(t *testing.T) {
 	suite.Run(t, new(ServiceGetByIDTestSuite))
 }
 
+func TestServiceExists(t *testing.T) {
+
+	t.Run("ShouldReturnNilWhenRecipeExists", func(t *testing.T) {
+		repo := new(MockIRepository)
+		repo.On("Exists", 1).Return(nil)
+		service := &foodrecipe.Service{Repository: repo}
+
+		assert.NoError(t, service.Exists(1))
+	})
+
+	t.Run("ShouldReturnNotFoundWhenRecipeMissing", func(t *testing.T) {
+		repo := new(MockIRepository)
+		repo.On("Exists", 2).Return(gorm.ErrRecordNotFound)
+		service := &foodrecipe.Service{Repository: repo}
+
+		assert.ErrorIs(t, service.Exists(2), gorm.ErrRecordNotFound)
+	})
+
+}
+
 type ServiceUpdateTestSuite struct {
 	suite.Suite
 
 	// Dependencies
-	service foodrecipe.IService
-	repo    *MockIRepository
+	service           foodrecipe.IService
+	repo              *MockIRepository
+	ingredientService *MockIIngredientService
 
 	// Mock data
 	respGetByID          model.FoodRecipe
@@ -307,10 +388,18 @@ type ServiceUpdateTestSuite struct {
 
 func (suite *ServiceUpdateTestSuite) SetupTest() {
 	suite.repo = new(MockIRepository)
+	suite.ingredientService = new(MockIIngredientService)
 	suite.service = &foodrecipe.Service{
-		Repository: suite.repo,
+		Repository:        suite.repo,
+		IngredientService: suite.ingredientService,
 	}
 
+	suite.ingredientService.On("Link", mock.Anything).Return(func(ingredients model.RecipeIngredients) (model.RecipeIngredients, error) {
+		return ingredients, nil
+	})
+	suite.ingredientService.On("EstimateNutrition", mock.Anything).Return(model.Nutrition{}, nil)
+	suite.ingredientService.On("DeriveDietary", mock.Anything).Return(model.Dietary{}, nil)
+
 	suite.respGetByID = model.FoodRecipe{
 		Model:             gorm.Model{ID: 1},
 		Name:              "Name",
@@ -334,7 +423,7 @@ func (suite *ServiceUpdateTestSuite) SetupTest() {
 	}
 	suite.errRepositoryUpdate = nil
 
-	suite.repo.On("GetByID", mock.Anything).Return(func(int) (model.FoodRecipe, error) {
+	suite.repo.On("GetByID", mock.Anything, mock.Anything).Return(func(int, string) (model.FoodRecipe, error) {
 		return suite.respGetByID, suite.errGetByID
 	})
 	suite.repo.On("Update", mock.Anything).Run(func(args mock.Arguments) {
@@ -375,7 +464,7 @@ func (suite *ServiceUpdateTestSuite) TestReturnRecipeUpdated() {
 	suite.NoError(err)
 
 	suite.Equal(expectedRecipe, recipe)
-	suite.repo.AssertCalled(suite.T(), "GetByID", 1)
+	suite.repo.AssertCalled(suite.T(), "GetByID", 1, "UID")
 	suite.repo.AssertCalled(suite.T(), "Update", &model.FoodRecipe{
 		Model:             gorm.Model{ID: 1},
 		Name:              "NameUpdated",
@@ -394,7 +483,7 @@ func (suite *ServiceUpdateTestSuite) TestErrorWhenRequestValidate() {
 	suite.True(strings.HasPrefix(err.Error(), "request invalid"))
 
 	suite.Empty(recipe)
-	suite.repo.AssertNotCalled(suite.T(), "GetByID", mock.Anything)
+	suite.repo.AssertNotCalled(suite.T(), "GetByID", mock.Anything, mock.Anything)
 	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
 }
 
@@ -502,7 +591,7 @@ func (suite *ServiceDeleteTestSuite) SetupTest() {
 	suite.errGetByID = nil
 	suite.errRepositoryDelete = nil
 
-	suite.repo.On("GetByID", mock.Anything).Return(func(int) (model.FoodRecipe, error) {
+	suite.repo.On("GetByID", mock.Anything, mock.Anything).Return(func(int, string) (model.FoodRecipe, error) {
 		return suite.respGetByID, suite.errGetByID
 	})
 	suite.repo.On("Delete", mock.AnythingOfType("int")).Return(func(int) error {
@@ -518,7 +607,7 @@ func (suite *ServiceDeleteTestSuite) TestRecipeDeleted() {
 	err := suite.service.Delete(1, claims)
 	suite.NoError(err)
 
-	suite.repo.AssertCalled(suite.T(), "GetByID", 1)
+	suite.repo.AssertCalled(suite.T(), "GetByID", 1, "UID")
 	suite.repo.AssertCalled(suite.T(), "Delete", 1)
 }
 
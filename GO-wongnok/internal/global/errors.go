@@ -6,7 +6,17 @@ import (
 )
 
 var (
-	ErrForbidden error = errors.New("forbidden")
+	ErrForbidden        error = errors.New("forbidden")
+	ErrConflict         error = errors.New("conflict")
+	ErrInvalidTag       error = errors.New("invalid tag")
+	ErrInvalidCursor    error = errors.New("invalid cursor")
+	ErrInvalidParent    error = errors.New("invalid parent comment")
+	ErrSameWeek         error = errors.New("cannot copy a week onto itself")
+	ErrInvalidDateRange error = errors.New("invalid date range")
+	ErrInvalidDietary   error = errors.New("unknown allergen or diet")
 )
 
 var Verifier config.IOIDCTokenVerifier
+
+// Ranking ค่าตั้งต้นของคะแนน Bayesian (กำหนดตอนเริ่ม server)
+var Ranking config.Ranking
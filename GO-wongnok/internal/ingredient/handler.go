@@ -0,0 +1,304 @@
+package ingredient
+
+import (
+	"net/http"
+	"strconv"
+	"wongnok/internal/global"
+	"wongnok/internal/helper"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IHandler interface {
+	Get(ctx *gin.Context)
+	Create(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	GetUnlinked(ctx *gin.Context)
+	LinkUnlinked(ctx *gin.Context)
+	DismissUnlinked(ctx *gin.Context)
+}
+
+type Handler struct {
+	Service IService
+}
+
+func NewHandler(db *gorm.DB) *Handler {
+	return &Handler{
+		Service: NewService(db),
+	}
+}
+
+// Get godoc
+// @Summary Get ingredients
+// @Description Canonical ingredient catalogue, searchable by English name, Thai name or synonym
+// @Tags ingredients
+// @Accept json
+// @Produce json
+// @Param search query string false "Any name of the ingredient"
+// @Param category query string false "Aisle category"
+// @Success 200 {object} dto.IngredientsResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/v1/ingredients [get]
+func (handler Handler) Get(ctx *gin.Context) {
+	var query model.IngredientQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	ingredients, err := handler.Service.Get(query)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ingredients.ToResponse())
+}
+
+// Create godoc
+// @Summary Create an ingredient
+// @Description Add a canonical ingredient with its Thai name and synonyms (admin only). Recipe ingredients with a matching name are linked to it
+// @Tags ingredients
+// @Accept json
+// @Produce json
+// @Param ingredient body dto.IngredientRequest true "Ingredient data"
+// @Success 201 {object} dto.IngredientResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/ingredients [post]
+func (handler Handler) Create(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var request dto.IngredientRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	ingredient, err := handler.Service.Create(request, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, ingredient.ToResponse())
+}
+
+// Update godoc
+// @Summary Update an ingredient
+// @Description Update names, category and synonyms (admin only). Recipe ingredients are relinked to match the new names
+// @Tags ingredients
+// @Accept json
+// @Produce json
+// @Param id path int true "Ingredient ID"
+// @Param ingredient body dto.IngredientRequest true "Ingredient data"
+// @Success 200 {object} dto.IngredientResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/ingredients/{id} [put]
+func (handler Handler) Update(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid ingredient id"})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var request dto.IngredientRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	ingredient, err := handler.Service.Update(request, id, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ingredient.ToResponse())
+}
+
+// Delete godoc
+// @Summary Delete an ingredient
+// @Description Delete an ingredient and unlink it from recipes (admin only)
+// @Tags ingredients
+// @Accept json
+// @Produce json
+// @Param id path int true "Ingredient ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/ingredients/{id} [delete]
+func (handler Handler) Delete(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid ingredient id"})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	if err := handler.Service.Delete(id, claims); err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Ingredient deleted successfully"})
+}
+
+// GetUnlinked godoc
+// @Summary Get unlinked ingredient names
+// @Description Ingredient names used in recipes that are not in the catalogue yet, most frequent first (admin only)
+// @Tags ingredients
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.UnlinkedIngredientsResponse
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/ingredients/unlinked [get]
+func (handler Handler) GetUnlinked(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	unlinked, err := handler.Service.GetUnlinked(claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, unlinked.ToResponse())
+}
+
+// LinkUnlinked godoc
+// @Summary Link an unlinked name to an ingredient
+// @Description Add the name as a synonym of the ingredient and link every recipe ingredient using it (admin only)
+// @Tags ingredients
+// @Accept json
+// @Produce json
+// @Param id path int true "Unlinked ingredient ID"
+// @Param link body dto.UnlinkedIngredientLinkRequest true "Target ingredient"
+// @Success 200 {object} dto.IngredientResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/ingredients/unlinked/{id}/link [post]
+func (handler Handler) LinkUnlinked(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid unlinked ingredient id"})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var request dto.UnlinkedIngredientLinkRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	ingredient, err := handler.Service.LinkUnlinked(request, id, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ingredient.ToResponse())
+}
+
+// DismissUnlinked godoc
+// @Summary Dismiss an unlinked name
+// @Description Remove a name from the review queue without linking it (admin only)
+// @Tags ingredients
+// @Accept json
+// @Produce json
+// @Param id path int true "Unlinked ingredient ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/ingredients/unlinked/{id} [delete]
+func (handler Handler) DismissUnlinked(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid unlinked ingredient id"})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	if err := handler.Service.DismissUnlinked(id, claims); err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Unlinked ingredient dismissed successfully"})
+}
+
+func statusCode(err error) int {
+	switch {
+	case errors.As(err, &validator.ValidationErrors{}):
+		return http.StatusBadRequest
+	case errors.Is(err, global.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, global.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,426 @@
+package ingredient_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"wongnok/internal/global"
+	"wongnok/internal/ingredient"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewHandler(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		handler := ingredient.NewHandler(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(handler))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler ingredient.IHandler
+	service *MockIService
+
+	// Mock data
+	admin model.Claims
+	user  model.Claims
+	egg   model.Ingredient
+
+	// Helper
+	server func(method string, url string, payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+// This will run once before all tests in the suite
+func (suite *HandlerTestSuite) SetupSuite() {
+	// Gin testing mode
+	gin.SetMode(gin.TestMode)
+}
+
+// This will run before each test
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = ingredient.Handler{
+		Service: suite.service,
+	}
+
+	suite.admin = model.Claims{ID: "UID", RealmAccess: &model.RealmAccess{Roles: []string{model.AdminRole}}}
+	suite.user = model.Claims{ID: "UID"}
+	suite.egg = model.Ingredient{
+		Model:         gorm.Model{ID: 1},
+		Name:          "egg",
+		NameTH:        "ไข่",
+		Category:      "dairy-eggs",
+		Synonyms:      model.StringList{"eggs"},
+		Allergens:     model.StringList{model.AllergenEgg},
+		ExcludedDiets: model.StringList{model.DietVegan},
+	}
+
+	suite.server = func(method string, url string, payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder {
+		// Create router
+		router := gin.Default()
+
+		// Set context
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+
+		router.GET("/api/v1/ingredients", suite.handler.Get)
+		router.POST("/api/v1/ingredients", suite.handler.Create)
+		router.PUT("/api/v1/ingredients/:id", suite.handler.Update)
+		router.DELETE("/api/v1/ingredients/:id", suite.handler.Delete)
+		router.GET("/api/v1/ingredients/unlinked", suite.handler.GetUnlinked)
+		router.POST("/api/v1/ingredients/unlinked/:id/link", suite.handler.LinkUnlinked)
+		router.DELETE("/api/v1/ingredients/unlinked/:id", suite.handler.DismissUnlinked)
+
+		recorder := httptest.NewRecorder()
+
+		// Create request
+		request, err := http.NewRequest(method, url, payload)
+		suite.NoError(err)
+
+		// Start testing server
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+}
+
+const eggPayload = `{"name": "egg", "nameTH": "ไข่", "category": "dairy-eggs", "synonyms": ["eggs"], "allergens": ["egg"], "excludedDiets": ["vegan"]}`
+
+// Extend
+type HandlerGetTestSuite struct {
+	HandlerTestSuite
+}
+
+func (suite *HandlerGetTestSuite) TestResponseIngredients() {
+	suite.service.On("Get", model.IngredientQuery{Search: "ไข่"}).Return(model.Ingredients{suite.egg}, nil)
+
+	// ดูพจนานุกรมได้โดยไม่ต้อง login
+	response := suite.server(http.MethodGet, "/api/v1/ingredients?search=%E0%B9%84%E0%B8%82%E0%B9%88", nil, nil)
+
+	expectedJson, _ := json.Marshal(model.Ingredients{suite.egg}.ToResponse())
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+}
+
+func (suite *HandlerGetTestSuite) TestErrorWhenServiceFailed() {
+	suite.service.On("Get", mock.Anything).Return(nil, errors.Wrap(assert.AnError, "find ingredients"))
+
+	response := suite.server(http.MethodGet, "/api/v1/ingredients", nil, nil)
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+}
+
+func TestHandlerGet(t *testing.T) {
+	suite.Run(t, new(HandlerGetTestSuite))
+}
+
+// Extend
+type HandlerCreateTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	errServiceCreate error
+}
+
+// This will run before each test
+func (suite *HandlerCreateTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.errServiceCreate = nil
+
+	suite.service.On("Create", mock.Anything, mock.Anything).Return(func(dto.IngredientRequest, model.Claims) (model.Ingredient, error) {
+		if suite.errServiceCreate != nil {
+			return model.Ingredient{}, suite.errServiceCreate
+		}
+		return suite.egg, nil
+	})
+}
+
+func (suite *HandlerCreateTestSuite) TestResponseIngredientWithStatusCode201() {
+	response := suite.server(http.MethodPost, "/api/v1/ingredients", strings.NewReader(eggPayload), &suite.admin)
+
+	expectedJson, _ := json.Marshal(suite.egg.ToResponse())
+
+	suite.Equal(http.StatusCreated, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Create", dto.IngredientRequest{
+		Name:          "egg",
+		NameTH:        "ไข่",
+		Category:      "dairy-eggs",
+		Synonyms:      []string{"eggs"},
+		Allergens:     []string{"egg"},
+		ExcludedDiets: []string{"vegan"},
+	}, suite.admin)
+}
+
+func (suite *HandlerCreateTestSuite) TestErrorWhenNotAdmin() {
+	suite.errServiceCreate = global.ErrForbidden
+
+	response := suite.server(http.MethodPost, "/api/v1/ingredients", strings.NewReader(eggPayload), &suite.user)
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func (suite *HandlerCreateTestSuite) TestErrorWhenNoClaims() {
+	response := suite.server(http.MethodPost, "/api/v1/ingredients", strings.NewReader(eggPayload), nil)
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerCreateTestSuite) TestErrorWhenNameExists() {
+	suite.errServiceCreate = errors.Wrapf(global.ErrConflict, "ingredient name %q already exists", "egg")
+
+	response := suite.server(http.MethodPost, "/api/v1/ingredients", strings.NewReader(eggPayload), &suite.admin)
+
+	suite.Equal(http.StatusConflict, response.Code)
+}
+
+func (suite *HandlerCreateTestSuite) TestErrorWhenRequestInvalid() {
+	suite.errServiceCreate = errors.Wrap(validator.ValidationErrors{}, "request invalid")
+
+	response := suite.server(http.MethodPost, "/api/v1/ingredients", strings.NewReader(`{"name": "egg"}`), &suite.admin)
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+}
+
+func TestHandlerCreate(t *testing.T) {
+	suite.Run(t, new(HandlerCreateTestSuite))
+}
+
+// Extend
+type HandlerUpdateTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	errServiceUpdate error
+}
+
+// This will run before each test
+func (suite *HandlerUpdateTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.errServiceUpdate = nil
+
+	suite.service.On("Update", mock.Anything, mock.Anything, mock.Anything).Return(func(dto.IngredientRequest, int, model.Claims) (model.Ingredient, error) {
+		if suite.errServiceUpdate != nil {
+			return model.Ingredient{}, suite.errServiceUpdate
+		}
+		return suite.egg, nil
+	})
+}
+
+func (suite *HandlerUpdateTestSuite) TestResponseIngredient() {
+	response := suite.server(http.MethodPut, "/api/v1/ingredients/1", strings.NewReader(eggPayload), &suite.admin)
+
+	expectedJson, _ := json.Marshal(suite.egg.ToResponse())
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Update", mock.Anything, 1, suite.admin)
+}
+
+func (suite *HandlerUpdateTestSuite) TestErrorWhenNotAdmin() {
+	suite.errServiceUpdate = global.ErrForbidden
+
+	response := suite.server(http.MethodPut, "/api/v1/ingredients/1", strings.NewReader(eggPayload), &suite.user)
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func (suite *HandlerUpdateTestSuite) TestErrorWhenNotFound() {
+	suite.errServiceUpdate = errors.Wrap(gorm.ErrRecordNotFound, "find ingredient")
+
+	response := suite.server(http.MethodPut, "/api/v1/ingredients/99", strings.NewReader(eggPayload), &suite.admin)
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerUpdateTestSuite) TestErrorWhenInvalidID() {
+	response := suite.server(http.MethodPut, "/api/v1/ingredients/abc", strings.NewReader(eggPayload), &suite.admin)
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.Equal(`{"message":"invalid ingredient id"}`, response.Body.String())
+	suite.service.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandlerUpdate(t *testing.T) {
+	suite.Run(t, new(HandlerUpdateTestSuite))
+}
+
+// Extend
+type HandlerDeleteTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	errServiceDelete error
+}
+
+// This will run before each test
+func (suite *HandlerDeleteTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.errServiceDelete = nil
+
+	suite.service.On("Delete", mock.Anything, mock.Anything).Return(func(int, model.Claims) error {
+		return suite.errServiceDelete
+	})
+}
+
+func (suite *HandlerDeleteTestSuite) TestResponseMessageWithStatusCode200() {
+	response := suite.server(http.MethodDelete, "/api/v1/ingredients/1", nil, &suite.admin)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(`{"message":"Ingredient deleted successfully"}`, response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Delete", 1, suite.admin)
+}
+
+func (suite *HandlerDeleteTestSuite) TestErrorWhenNotAdmin() {
+	suite.errServiceDelete = global.ErrForbidden
+
+	response := suite.server(http.MethodDelete, "/api/v1/ingredients/1", nil, &suite.user)
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func (suite *HandlerDeleteTestSuite) TestErrorWhenNotFound() {
+	suite.errServiceDelete = errors.Wrap(gorm.ErrRecordNotFound, "find ingredient")
+
+	response := suite.server(http.MethodDelete, "/api/v1/ingredients/99", nil, &suite.admin)
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func TestHandlerDelete(t *testing.T) {
+	suite.Run(t, new(HandlerDeleteTestSuite))
+}
+
+// Extend
+type HandlerUnlinkedTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	unlinked model.UnlinkedIngredients
+	err      error
+}
+
+// This will run before each test
+func (suite *HandlerUnlinkedTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.unlinked = model.UnlinkedIngredients{{ID: 5, Name: "ไข่ไก่", Occurrences: 3}}
+	suite.err = nil
+
+	suite.service.On("GetUnlinked", mock.Anything).Return(func(model.Claims) (model.UnlinkedIngredients, error) {
+		if suite.err != nil {
+			return nil, suite.err
+		}
+		return suite.unlinked, nil
+	})
+	suite.service.On("LinkUnlinked", mock.Anything, mock.Anything, mock.Anything).Return(func(dto.UnlinkedIngredientLinkRequest, int, model.Claims) (model.Ingredient, error) {
+		if suite.err != nil {
+			return model.Ingredient{}, suite.err
+		}
+		return suite.egg, nil
+	})
+	suite.service.On("DismissUnlinked", mock.Anything, mock.Anything).Return(func(int, model.Claims) error {
+		return suite.err
+	})
+}
+
+func (suite *HandlerUnlinkedTestSuite) TestResponseUnlinked() {
+	response := suite.server(http.MethodGet, "/api/v1/ingredients/unlinked", nil, &suite.admin)
+
+	expectedJson, _ := json.Marshal(suite.unlinked.ToResponse())
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+}
+
+func (suite *HandlerUnlinkedTestSuite) TestErrorWhenGetNotAdmin() {
+	suite.err = global.ErrForbidden
+
+	response := suite.server(http.MethodGet, "/api/v1/ingredients/unlinked", nil, &suite.user)
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func (suite *HandlerUnlinkedTestSuite) TestResponseLinkedIngredient() {
+	response := suite.server(http.MethodPost, "/api/v1/ingredients/unlinked/5/link", strings.NewReader(`{"ingredientId": 1}`), &suite.admin)
+
+	expectedJson, _ := json.Marshal(suite.egg.ToResponse())
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "LinkUnlinked", dto.UnlinkedIngredientLinkRequest{IngredientID: 1}, 5, suite.admin)
+}
+
+func (suite *HandlerUnlinkedTestSuite) TestErrorWhenLinkNotAdmin() {
+	suite.err = global.ErrForbidden
+
+	response := suite.server(http.MethodPost, "/api/v1/ingredients/unlinked/5/link", strings.NewReader(`{"ingredientId": 1}`), &suite.user)
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func (suite *HandlerUnlinkedTestSuite) TestErrorWhenLinkNotFound() {
+	suite.err = errors.Wrap(gorm.ErrRecordNotFound, "find unlinked ingredient")
+
+	response := suite.server(http.MethodPost, "/api/v1/ingredients/unlinked/99/link", strings.NewReader(`{"ingredientId": 1}`), &suite.admin)
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerUnlinkedTestSuite) TestErrorWhenLinkInvalidID() {
+	response := suite.server(http.MethodPost, "/api/v1/ingredients/unlinked/abc/link", strings.NewReader(`{"ingredientId": 1}`), &suite.admin)
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.Equal(`{"message":"invalid unlinked ingredient id"}`, response.Body.String())
+}
+
+func (suite *HandlerUnlinkedTestSuite) TestResponseDismissMessage() {
+	response := suite.server(http.MethodDelete, "/api/v1/ingredients/unlinked/5", nil, &suite.admin)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(`{"message":"Unlinked ingredient dismissed successfully"}`, response.Body.String())
+	suite.service.AssertCalled(suite.T(), "DismissUnlinked", 5, suite.admin)
+}
+
+func (suite *HandlerUnlinkedTestSuite) TestErrorWhenDismissNotAdmin() {
+	suite.err = global.ErrForbidden
+
+	response := suite.server(http.MethodDelete, "/api/v1/ingredients/unlinked/5", nil, &suite.user)
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func TestHandlerUnlinked(t *testing.T) {
+	suite.Run(t, new(HandlerUnlinkedTestSuite))
+}
@@ -0,0 +1,260 @@
+package ingredient
+
+import (
+	"time"
+	"wongnok/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IRepository interface {
+	Get(query model.IngredientQuery) (model.Ingredients, error)
+	GetByID(id int) (model.Ingredient, error)
+	GetByIDs(ids []uint) (model.Ingredients, error)
+	Create(ingredient *model.Ingredient) error
+	Update(ingredient *model.Ingredient) error
+	Delete(id uint) error
+	GetLinkCandidates(ingredientID uint) (model.RecipeIngredients, error)
+	SetIngredientID(recipeIngredientIDs []uint, ingredientID *uint) error
+	GetRecipes(recipeIDs []uint) (model.FoodRecipes, error)
+	UpdateRecipeDerived(recipe model.FoodRecipe) error
+	GetUnestimatedRecipeIDs() ([]uint, error)
+	GetPantryLinkCandidates(ingredientID uint) (model.PantryItems, error)
+	SetPantryIngredientID(pantryItemIDs []uint, ingredientID *uint) error
+	GetUnlinked() (model.UnlinkedIngredients, error)
+	GetUnlinkedByID(id int) (model.UnlinkedIngredient, error)
+	QueueUnlinked(names []string) error
+	DeleteUnlinked(names []string) error
+	GetNutrition(ingredientIDs []uint) (model.IngredientNutritions, error)
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+// Get ค้นหาจากชื่อหลัก ชื่อไทย หรือคำพ้อง
+func (repo Repository) Get(query model.IngredientQuery) (model.Ingredients, error) {
+	var ingredients = make(model.Ingredients, 0)
+
+	db := repo.DB
+	if query.Search != "" {
+		search := "%" + query.Search + "%"
+		db = db.Where("name ILIKE ? OR name_th ILIKE ? OR synonyms::TEXT ILIKE ?", search, search, search)
+	}
+	if query.Category != "" {
+		db = db.Where("category = ?", query.Category)
+	}
+
+	if err := db.Order("name asc").Find(&ingredients).Error; err != nil {
+		return nil, err
+	}
+
+	return ingredients, nil
+}
+
+func (repo Repository) GetByID(id int) (model.Ingredient, error) {
+	var ingredient model.Ingredient
+
+	if err := repo.DB.First(&ingredient, id).Error; err != nil {
+		return model.Ingredient{}, err
+	}
+
+	return ingredient, nil
+}
+
+func (repo Repository) GetByIDs(ids []uint) (model.Ingredients, error) {
+	var ingredients = make(model.Ingredients, 0)
+
+	if len(ids) == 0 {
+		return ingredients, nil
+	}
+
+	if err := repo.DB.Where("id IN ?", ids).Find(&ingredients).Error; err != nil {
+		return nil, err
+	}
+
+	return ingredients, nil
+}
+
+func (repo Repository) Create(ingredient *model.Ingredient) error {
+	return repo.DB.Create(ingredient).Error
+}
+
+func (repo Repository) Update(ingredient *model.Ingredient) error {
+	return repo.DB.Model(ingredient).Select("name", "name_th", "category", "synonyms", "allergens", "excluded_diets").Updates(ingredient).Error
+}
+
+// Delete ลบวัตถุดิบ และปลดการผูกของวัตถุดิบในสูตรและของในตู้ที่ชี้มาที่นี่
+func (repo Repository) Delete(id uint) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.RecipeIngredient{}).Where("ingredient_id = ?", id).Update("ingredient_id", nil).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&model.PantryItem{}).Where("ingredient_id = ?", id).Update("ingredient_id", nil).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&model.Ingredient{}, id).Error
+	})
+}
+
+// GetLinkCandidates วัตถุดิบในสูตรที่ยังไม่ได้ผูก หรือผูกกับ ingredientID อยู่ (ใช้ตอนผูกใหม่หลังแก้พจนานุกรม)
+func (repo Repository) GetLinkCandidates(ingredientID uint) (model.RecipeIngredients, error) {
+	var ingredients = make(model.RecipeIngredients, 0)
+
+	if err := repo.DB.Select("id", "food_recipe_id", "name", "ingredient_id").
+		Where("ingredient_id IS NULL OR ingredient_id = ?", ingredientID).
+		Find(&ingredients).Error; err != nil {
+		return nil, err
+	}
+
+	return ingredients, nil
+}
+
+func (repo Repository) SetIngredientID(recipeIngredientIDs []uint, ingredientID *uint) error {
+	if len(recipeIngredientIDs) == 0 {
+		return nil
+	}
+
+	return repo.DB.Model(&model.RecipeIngredient{}).Where("id IN ?", recipeIngredientIDs).Update("ingredient_id", ingredientID).Error
+}
+
+// GetRecipes สูตรพร้อมวัตถุดิบ ใช้คำนวณค่าโภชนาการและสารก่อภูมิแพ้ใหม่หลังแก้พจนานุกรม
+func (repo Repository) GetRecipes(recipeIDs []uint) (model.FoodRecipes, error) {
+	var recipes = make(model.FoodRecipes, 0)
+
+	if len(recipeIDs) == 0 {
+		return recipes, nil
+	}
+
+	if err := repo.DB.Select("id", "dietary_override").
+		Preload("Ingredients", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position asc")
+		}).
+		Where("id IN ?", recipeIDs).
+		Find(&recipes).Error; err != nil {
+		return nil, err
+	}
+
+	return recipes, nil
+}
+
+// UpdateRecipeDerived บันทึกค่าที่คำนวณจากวัตถุดิบ สูตรที่ผู้เขียนกำหนดรูปแบบการกินเองจะไม่แก้ allergens/diets
+func (repo Repository) UpdateRecipeDerived(recipe model.FoodRecipe) error {
+	columns := recipe.Nutrition.Columns()
+	if !recipe.DietaryOverride {
+		for column, value := range recipe.Dietary.Columns() {
+			columns[column] = value
+		}
+	}
+
+	return repo.DB.Model(&model.FoodRecipe{}).Where("id = ?", recipe.ID).UpdateColumns(columns).Error
+}
+
+// GetUnestimatedRecipeIDs สูตรที่มีวัตถุดิบแต่ยังไม่เคยคำนวณค่าโภชนาการ (สูตรที่สร้างก่อน migration add_nutrition_estimates)
+func (repo Repository) GetUnestimatedRecipeIDs() ([]uint, error) {
+	var ids = make([]uint, 0)
+
+	if err := repo.DB.Model(&model.FoodRecipe{}).
+		Where("nutrition_total_ingredients = 0").
+		Where("EXISTS (SELECT 1 FROM recipe_ingredients WHERE recipe_ingredients.food_recipe_id = food_recipes.id AND recipe_ingredients.deleted_at IS NULL)").
+		Order("id asc").
+		Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// GetPantryLinkCandidates ของในตู้ที่ยังไม่ได้ผูก หรือผูกกับ ingredientID อยู่ (เหมือน GetLinkCandidates)
+func (repo Repository) GetPantryLinkCandidates(ingredientID uint) (model.PantryItems, error) {
+	var items = make(model.PantryItems, 0)
+
+	if err := repo.DB.Select("id", "name", "ingredient_id").
+		Where("ingredient_id IS NULL OR ingredient_id = ?", ingredientID).
+		Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (repo Repository) SetPantryIngredientID(pantryItemIDs []uint, ingredientID *uint) error {
+	if len(pantryItemIDs) == 0 {
+		return nil
+	}
+
+	return repo.DB.Model(&model.PantryItem{}).Where("id IN ?", pantryItemIDs).Update("ingredient_id", ingredientID).Error
+}
+
+// GetUnlinked ชื่อที่รอตรวจ ที่พบบ่อยก่อน
+func (repo Repository) GetUnlinked() (model.UnlinkedIngredients, error) {
+	var unlinked = make(model.UnlinkedIngredients, 0)
+
+	if err := repo.DB.Order("occurrences desc, last_seen_at desc").Find(&unlinked).Error; err != nil {
+		return nil, err
+	}
+
+	return unlinked, nil
+}
+
+func (repo Repository) GetUnlinkedByID(id int) (model.UnlinkedIngredient, error) {
+	var unlinked model.UnlinkedIngredient
+
+	if err := repo.DB.First(&unlinked, id).Error; err != nil {
+		return model.UnlinkedIngredient{}, err
+	}
+
+	return unlinked, nil
+}
+
+// QueueUnlinked เพิ่มชื่อเข้าคิวรอตรวจ ถ้ามีอยู่แล้วเพิ่มจำนวนครั้งที่พบ
+func (repo Repository) QueueUnlinked(names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	rows := make(model.UnlinkedIngredients, 0, len(names))
+	for _, name := range names {
+		rows = append(rows, model.UnlinkedIngredient{Name: name, Occurrences: 1, CreatedAt: now, LastSeenAt: now})
+	}
+
+	return repo.DB.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "name"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"occurrences":  gorm.Expr("unlinked_ingredients.occurrences + 1"),
+			"last_seen_at": gorm.Expr("EXCLUDED.last_seen_at"),
+		}),
+	}).Create(&rows).Error
+}
+
+func (repo Repository) DeleteUnlinked(names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	return repo.DB.Where("name IN ?", names).Delete(&model.UnlinkedIngredient{}).Error
+}
+
+func (repo Repository) GetNutrition(ingredientIDs []uint) (model.IngredientNutritions, error) {
+	var references = make(model.IngredientNutritions, 0)
+
+	if len(ingredientIDs) == 0 {
+		return references, nil
+	}
+
+	if err := repo.DB.Where("ingredient_id IN ?", ingredientIDs).Find(&references).Error; err != nil {
+		return nil, err
+	}
+
+	return references, nil
+}
@@ -0,0 +1,138 @@
+package ingredient_test
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+	"wongnok/internal/ingredient"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	driver "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestNewRepository(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		repo := ingredient.NewRepository(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(repo))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+type RepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	container  *postgres.PostgresContainer
+	db         *gorm.DB
+	repository ingredient.IRepository
+}
+
+// This will run once before all tests in the suite
+func (suite *RepositoryTestSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	container, err := postgres.Run(
+		suite.ctx,
+		"postgres:17-alpine",
+		postgres.WithInitScripts(filepath.Join("../..", "tests", "init-db.sql")),
+		postgres.WithDatabase("wongnok-test"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").WithOccurrence(2).WithStartupTimeout(
+				(5 * time.Second),
+			),
+		),
+	)
+	suite.NoError(err)
+	suite.container = container
+}
+
+// This will run once after all tests in the suite
+func (suite *RepositoryTestSuite) TearDownSuite() {
+	err := suite.container.Terminate(suite.ctx)
+	suite.NoError(err)
+}
+
+// This will run before each test
+func (suite *RepositoryTestSuite) SetupTest() {
+	conn, err := suite.container.ConnectionString(suite.ctx, "sslmode=disable")
+	suite.NoError(err)
+
+	db, err := gorm.Open(driver.Open(conn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	suite.NoError(err)
+
+	suite.repository = &ingredient.Repository{
+		DB: db,
+	}
+
+	suite.db = db
+}
+
+// This will run after each test
+func (suite *RepositoryTestSuite) TearDownTest() {
+	sqldb, _ := suite.db.DB()
+	sqldb.Close()
+}
+
+// Extend
+type RepositoryUpdateIngredientTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryUpdateIngredientTestSuite) TestSaveDietaryFields() {
+	// egg (id 1) ใน initial data มี allergens ["egg"] และ excluded_diets ["vegan"]
+	egg, err := suite.repository.GetByID(1)
+	suite.NoError(err)
+
+	egg.Allergens = model.StringList{model.AllergenEgg, model.AllergenDairy}
+	egg.ExcludedDiets = model.StringList{model.DietVegan, model.DietVegetarian}
+
+	err = suite.repository.Update(&egg)
+	suite.NoError(err)
+
+	// อ่านกลับจากฐานข้อมูล ไม่ใช่จาก struct ที่ส่งเข้าไป
+	result, err := suite.repository.GetByID(1)
+	suite.NoError(err)
+
+	suite.Equal(model.StringList{model.AllergenEgg, model.AllergenDairy}, result.Allergens)
+	suite.Equal(model.StringList{model.DietVegan, model.DietVegetarian}, result.ExcludedDiets)
+}
+
+func (suite *RepositoryUpdateIngredientTestSuite) TestClearDietaryFields() {
+	egg, err := suite.repository.GetByID(1)
+	suite.NoError(err)
+
+	egg.Allergens = model.StringList{}
+	egg.ExcludedDiets = model.StringList{}
+
+	err = suite.repository.Update(&egg)
+	suite.NoError(err)
+
+	result, err := suite.repository.GetByID(1)
+	suite.NoError(err)
+
+	suite.Empty(result.Allergens)
+	suite.Empty(result.ExcludedDiets)
+}
+
+func TestRepositoryUpdateIngredient(t *testing.T) {
+	suite.Run(t, new(RepositoryUpdateIngredientTestSuite))
+}
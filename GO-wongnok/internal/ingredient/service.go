@@ -0,0 +1,416 @@
+package ingredient
+
+import (
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IService interface {
+	Get(query model.IngredientQuery) (model.Ingredients, error)
+	Create(request dto.IngredientRequest, claims model.Claims) (model.Ingredient, error)
+	Update(request dto.IngredientRequest, id int, claims model.Claims) (model.Ingredient, error)
+	Delete(id int, claims model.Claims) error
+	Link(ingredients model.RecipeIngredients) (model.RecipeIngredients, error)
+	LinkPantry(items model.PantryItems) (model.PantryItems, error)
+	EstimateNutrition(ingredients model.RecipeIngredients) (model.Nutrition, error)
+	DeriveDietary(ingredients model.RecipeIngredients) (model.Dietary, error)
+	GetUnlinked(claims model.Claims) (model.UnlinkedIngredients, error)
+	LinkUnlinked(request dto.UnlinkedIngredientLinkRequest, id int, claims model.Claims) (model.Ingredient, error)
+	DismissUnlinked(id int, claims model.Claims) error
+	BackfillNutrition() (int, error)
+}
+
+// backfillBatchSize จำนวนสูตรที่โหลดพร้อมวัตถุดิบต่อรอบตอน backfill
+const backfillBatchSize = 100
+
+type Service struct {
+	Repository IRepository
+}
+
+func NewService(db *gorm.DB) IService {
+	return &Service{
+		Repository: NewRepository(db),
+	}
+}
+
+func (service Service) Get(query model.IngredientQuery) (model.Ingredients, error) {
+	ingredients, err := service.Repository.Get(query)
+	if err != nil {
+		return nil, errors.Wrap(err, "find ingredients")
+	}
+
+	return ingredients, nil
+}
+
+func (service Service) Create(request dto.IngredientRequest, claims model.Claims) (model.Ingredient, error) {
+	if !claims.IsAdmin() {
+		// จัดการพจนานุกรมได้เฉพาะ admin
+		return model.Ingredient{}, global.ErrForbidden
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Ingredient{}, errors.Wrap(err, "request invalid")
+	}
+
+	ingredient := model.Ingredient{}.FromRequest(request)
+
+	if err := service.ensureUniqueTerms(ingredient); err != nil {
+		return model.Ingredient{}, err
+	}
+
+	if err := service.Repository.Create(&ingredient); err != nil {
+		return model.Ingredient{}, errors.Wrap(err, "create ingredient")
+	}
+
+	if err := service.relink(ingredient); err != nil {
+		return model.Ingredient{}, err
+	}
+
+	return ingredient, nil
+}
+
+func (service Service) Update(request dto.IngredientRequest, id int, claims model.Claims) (model.Ingredient, error) {
+	if !claims.IsAdmin() {
+		return model.Ingredient{}, global.ErrForbidden
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Ingredient{}, errors.Wrap(err, "request invalid")
+	}
+
+	ingredient, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.Ingredient{}, errors.Wrap(err, "find ingredient")
+	}
+
+	return service.save(ingredient.FromRequest(request))
+}
+
+func (service Service) Delete(id int, claims model.Claims) error {
+	if !claims.IsAdmin() {
+		return global.ErrForbidden
+	}
+
+	ingredient, err := service.Repository.GetByID(id)
+	if err != nil {
+		return errors.Wrap(err, "find ingredient")
+	}
+
+	candidates, err := service.Repository.GetLinkCandidates(ingredient.ID)
+	if err != nil {
+		return errors.Wrap(err, "find recipe ingredients")
+	}
+
+	recipeIDs := make([]uint, 0)
+	for _, candidate := range candidates {
+		if candidate.IngredientID != nil {
+			recipeIDs = append(recipeIDs, candidate.FoodRecipeID)
+		}
+	}
+
+	if err := service.Repository.Delete(ingredient.ID); err != nil {
+		return err
+	}
+
+	return service.refreshRecipes(recipeIDs)
+}
+
+// Link ผูกวัตถุดิบของสูตรเข้ากับพจนานุกรม ชื่อที่ยังไม่รู้จักจะเข้าคิวให้ admin ตรวจ
+// เรียกจาก foodrecipe.Service ก่อนบันทึกสูตร
+func (service Service) Link(ingredients model.RecipeIngredients) (model.RecipeIngredients, error) {
+	if len(ingredients) == 0 {
+		return ingredients, nil
+	}
+
+	catalogue, err := service.Repository.Get(model.IngredientQuery{})
+	if err != nil {
+		return nil, errors.Wrap(err, "find ingredients")
+	}
+
+	ingredients, unlinked := catalogue.Index().Link(ingredients)
+
+	terms := make([]string, 0, len(unlinked))
+	for _, name := range unlinked {
+		terms = append(terms, model.NormalizeIngredientName(name))
+	}
+
+	if err := service.Repository.QueueUnlinked(terms); err != nil {
+		return nil, errors.Wrap(err, "queue unlinked ingredients")
+	}
+
+	return ingredients, nil
+}
+
+// LinkPantry ผูกของในตู้เข้ากับพจนานุกรม เรียกจาก pantry.Service ก่อนบันทึก
+func (service Service) LinkPantry(items model.PantryItems) (model.PantryItems, error) {
+	if len(items) == 0 {
+		return items, nil
+	}
+
+	catalogue, err := service.Repository.Get(model.IngredientQuery{})
+	if err != nil {
+		return nil, errors.Wrap(err, "find ingredients")
+	}
+
+	return catalogue.Index().LinkPantry(items), nil
+}
+
+// EstimateNutrition ค่าโภชนาการโดยประมาณจากวัตถุดิบที่ผูกกับพจนานุกรมแล้ว (เรียกหลัง Link)
+func (service Service) EstimateNutrition(ingredients model.RecipeIngredients) (model.Nutrition, error) {
+	ids := make([]uint, 0, len(ingredients))
+	for _, ingredient := range ingredients {
+		if ingredient.IngredientID != nil {
+			ids = append(ids, *ingredient.IngredientID)
+		}
+	}
+
+	references, err := service.Repository.GetNutrition(ids)
+	if err != nil {
+		return model.Nutrition{}, errors.Wrap(err, "find nutrition")
+	}
+
+	return model.EstimateNutrition(ingredients, references.ByIngredientID()), nil
+}
+
+// DeriveDietary สารก่อภูมิแพ้และรูปแบบการกินจากวัตถุดิบที่ผูกกับพจนานุกรมแล้ว (เรียกหลัง Link)
+func (service Service) DeriveDietary(ingredients model.RecipeIngredients) (model.Dietary, error) {
+	ids := make([]uint, 0, len(ingredients))
+	for _, ingredient := range ingredients {
+		if ingredient.IngredientID != nil {
+			ids = append(ids, *ingredient.IngredientID)
+		}
+	}
+
+	linked, err := service.Repository.GetByIDs(ids)
+	if err != nil {
+		return model.Dietary{}, errors.Wrap(err, "find ingredients")
+	}
+
+	catalogue := make(map[uint]model.Ingredient, len(linked))
+	for _, ingredient := range linked {
+		catalogue[ingredient.ID] = ingredient
+	}
+
+	return model.DeriveDietary(ingredients, catalogue), nil
+}
+
+func (service Service) GetUnlinked(claims model.Claims) (model.UnlinkedIngredients, error) {
+	if !claims.IsAdmin() {
+		return nil, global.ErrForbidden
+	}
+
+	unlinked, err := service.Repository.GetUnlinked()
+	if err != nil {
+		return nil, errors.Wrap(err, "find unlinked ingredients")
+	}
+
+	return unlinked, nil
+}
+
+// LinkUnlinked เพิ่มชื่อในคิวเป็นคำพ้องของวัตถุดิบ แล้วผูกวัตถุดิบในสูตรที่ใช้ชื่อนี้ทั้งหมด
+func (service Service) LinkUnlinked(request dto.UnlinkedIngredientLinkRequest, id int, claims model.Claims) (model.Ingredient, error) {
+	if !claims.IsAdmin() {
+		return model.Ingredient{}, global.ErrForbidden
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Ingredient{}, errors.Wrap(err, "request invalid")
+	}
+
+	unlinked, err := service.Repository.GetUnlinkedByID(id)
+	if err != nil {
+		return model.Ingredient{}, errors.Wrap(err, "find unlinked ingredient")
+	}
+
+	ingredient, err := service.Repository.GetByID(int(request.IngredientID))
+	if err != nil {
+		return model.Ingredient{}, errors.Wrap(err, "find ingredient")
+	}
+
+	if !ingredient.HasTerm(unlinked.Name) {
+		ingredient.Synonyms = append(ingredient.Synonyms, unlinked.Name)
+	}
+
+	return service.save(ingredient)
+}
+
+func (service Service) DismissUnlinked(id int, claims model.Claims) error {
+	if !claims.IsAdmin() {
+		return global.ErrForbidden
+	}
+
+	unlinked, err := service.Repository.GetUnlinkedByID(id)
+	if err != nil {
+		return errors.Wrap(err, "find unlinked ingredient")
+	}
+
+	return service.Repository.DeleteUnlinked([]string{unlinked.Name})
+}
+
+// save บันทึกวัตถุดิบที่มีอยู่แล้ว และผูกวัตถุดิบในสูตรใหม่ตามชื่อชุดใหม่
+func (service Service) save(ingredient model.Ingredient) (model.Ingredient, error) {
+	if err := service.ensureUniqueTerms(ingredient); err != nil {
+		return model.Ingredient{}, err
+	}
+
+	if err := service.Repository.Update(&ingredient); err != nil {
+		return model.Ingredient{}, errors.Wrap(err, "update ingredient")
+	}
+
+	if err := service.relink(ingredient); err != nil {
+		return model.Ingredient{}, err
+	}
+
+	return ingredient, nil
+}
+
+// relink ผูกวัตถุดิบในสูตรและของในตู้ที่ยังไม่ได้ผูกและชื่อตรงกับวัตถุดิบนี้ ปลดอันที่ชื่อไม่ตรงแล้ว
+// คำนวณค่าของสูตรที่เกี่ยวข้องใหม่ และลบชื่อที่ตรงออกจากคิวรอตรวจ
+func (service Service) relink(ingredient model.Ingredient) error {
+	candidates, err := service.Repository.GetLinkCandidates(ingredient.ID)
+	if err != nil {
+		return errors.Wrap(err, "find recipe ingredients")
+	}
+
+	link := make([]uint, 0)
+	unlink := make([]uint, 0)
+	// สูตรที่ผูกกับวัตถุดิบนี้อยู่แล้วต้องคำนวณใหม่ด้วย เพราะข้อมูลสารก่อภูมิแพ้/รูปแบบการกินอาจเปลี่ยน
+	recipeIDs := make([]uint, 0)
+	for _, candidate := range candidates {
+		matches := ingredient.HasTerm(candidate.Name)
+		switch {
+		case matches && candidate.IngredientID == nil:
+			link = append(link, candidate.ID)
+		case !matches && candidate.IngredientID != nil:
+			unlink = append(unlink, candidate.ID)
+		case !matches:
+			continue
+		}
+		recipeIDs = append(recipeIDs, candidate.FoodRecipeID)
+	}
+
+	if err := service.Repository.SetIngredientID(link, &ingredient.ID); err != nil {
+		return errors.Wrap(err, "link recipe ingredients")
+	}
+
+	if err := service.Repository.SetIngredientID(unlink, nil); err != nil {
+		return errors.Wrap(err, "unlink recipe ingredients")
+	}
+
+	if err := service.refreshRecipes(recipeIDs); err != nil {
+		return err
+	}
+
+	if err := service.relinkPantry(ingredient); err != nil {
+		return err
+	}
+
+	if err := service.Repository.DeleteUnlinked(ingredient.Terms()); err != nil {
+		return errors.Wrap(err, "delete unlinked ingredients")
+	}
+
+	return nil
+}
+
+func (service Service) relinkPantry(ingredient model.Ingredient) error {
+	candidates, err := service.Repository.GetPantryLinkCandidates(ingredient.ID)
+	if err != nil {
+		return errors.Wrap(err, "find pantry items")
+	}
+
+	link := make([]uint, 0)
+	unlink := make([]uint, 0)
+	for _, candidate := range candidates {
+		matches := ingredient.HasTerm(candidate.Name)
+		switch {
+		case matches && candidate.IngredientID == nil:
+			link = append(link, candidate.ID)
+		case !matches && candidate.IngredientID != nil:
+			unlink = append(unlink, candidate.ID)
+		}
+	}
+
+	if err := service.Repository.SetPantryIngredientID(link, &ingredient.ID); err != nil {
+		return errors.Wrap(err, "link pantry items")
+	}
+
+	if err := service.Repository.SetPantryIngredientID(unlink, nil); err != nil {
+		return errors.Wrap(err, "unlink pantry items")
+	}
+
+	return nil
+}
+
+// BackfillNutrition คำนวณค่าโภชนาการ (และรูปแบบการกิน) ให้สูตรที่ยังไม่เคยคำนวณ คืนจำนวนสูตรที่คำนวณแล้ว
+// เรียกจาก cmd/backfill-nutrition สูตรที่คำนวณแล้วจะไม่ถูกเลือกอีก จึงรันซ้ำได้
+func (service Service) BackfillNutrition() (int, error) {
+	recipeIDs, err := service.Repository.GetUnestimatedRecipeIDs()
+	if err != nil {
+		return 0, errors.Wrap(err, "find recipes")
+	}
+
+	for start := 0; start < len(recipeIDs); start += backfillBatchSize {
+		end := min(start+backfillBatchSize, len(recipeIDs))
+		if err := service.refreshRecipes(recipeIDs[start:end]); err != nil {
+			return start, err
+		}
+	}
+
+	return len(recipeIDs), nil
+}
+
+// refreshRecipes คำนวณค่าโภชนาการและสารก่อภูมิแพ้/รูปแบบการกินของสูตรใหม่ หลังการผูกวัตถุดิบเปลี่ยน
+// สูตรที่ตั้ง DietaryOverride ไว้จะแก้แค่ค่าโภชนาการ
+func (service Service) refreshRecipes(recipeIDs []uint) error {
+	recipes, err := service.Repository.GetRecipes(recipeIDs)
+	if err != nil {
+		return errors.Wrap(err, "find recipes")
+	}
+
+	for _, recipe := range recipes {
+		nutrition, err := service.EstimateNutrition(recipe.Ingredients)
+		if err != nil {
+			return err
+		}
+		recipe.Nutrition = nutrition
+
+		if !recipe.DietaryOverride {
+			dietary, err := service.DeriveDietary(recipe.Ingredients)
+			if err != nil {
+				return err
+			}
+			recipe.Dietary = dietary
+		}
+
+		if err := service.Repository.UpdateRecipeDerived(recipe); err != nil {
+			return errors.Wrap(err, "update recipe")
+		}
+	}
+
+	return nil
+}
+
+// ensureUniqueTerms ชื่อแต่ละชื่อต้องเป็นของวัตถุดิบได้อันเดียว ไม่อย่างนั้นจะผูกไม่ได้ว่าหมายถึงอันไหน
+func (service Service) ensureUniqueTerms(ingredient model.Ingredient) error {
+	catalogue, err := service.Repository.Get(model.IngredientQuery{})
+	if err != nil {
+		return errors.Wrap(err, "find ingredients")
+	}
+
+	index := catalogue.Index()
+	for _, term := range ingredient.Terms() {
+		if id, exists := index[term]; exists && id != ingredient.ID {
+			return errors.Wrapf(global.ErrConflict, "ingredient name %q already exists", term)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,501 @@
+package ingredient_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"wongnok/internal/global"
+	"wongnok/internal/ingredient"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewService(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		service := ingredient.NewService(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(service))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+func linked(id uint) *uint {
+	return &id
+}
+
+type ServiceUpdateTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service ingredient.IService
+	repo    *MockIRepository
+
+	// Mock data
+	admin   model.Claims
+	egg     model.Ingredient
+	recipes model.FoodRecipes
+}
+
+// This will run before each test
+func (suite *ServiceUpdateTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &ingredient.Service{
+		Repository: suite.repo,
+	}
+
+	suite.admin = model.Claims{ID: "UID", RealmAccess: &model.RealmAccess{Roles: []string{model.AdminRole}}}
+	suite.egg = model.Ingredient{
+		Model:    gorm.Model{ID: 1},
+		Name:     "egg",
+		Category: "dairy-eggs",
+	}
+	suite.recipes = model.FoodRecipes{
+		{
+			Model:       gorm.Model{ID: 10},
+			Ingredients: model.RecipeIngredients{{Name: "Eggs", IngredientID: linked(1)}},
+		},
+		{
+			Model:           gorm.Model{ID: 20},
+			DietaryOverride: true,
+			Dietary:         model.Dietary{Allergens: model.StringList{}, Diets: model.StringList{model.DietVegan}},
+			Ingredients:     model.RecipeIngredients{{Name: "egg", IngredientID: linked(1)}},
+		},
+	}
+
+	suite.repo.On("GetByID", 1).Return(func(int) (model.Ingredient, error) {
+		return suite.egg, nil
+	})
+	suite.repo.On("Get", model.IngredientQuery{}).Return(func(model.IngredientQuery) (model.Ingredients, error) {
+		return model.Ingredients{suite.egg}, nil
+	})
+	suite.repo.On("Update", mock.Anything).Return(nil)
+	suite.repo.On("GetLinkCandidates", uint(1)).Return(model.RecipeIngredients{
+		{Model: gorm.Model{ID: 100}, FoodRecipeID: 10, Name: "Eggs", IngredientID: linked(1)},
+		{Model: gorm.Model{ID: 200}, FoodRecipeID: 20, Name: "egg"},
+		{Model: gorm.Model{ID: 300}, FoodRecipeID: 30, Name: "salt"},
+	}, nil)
+	suite.repo.On("SetIngredientID", mock.Anything, mock.Anything).Return(nil)
+	suite.repo.On("GetRecipes", mock.Anything).Return(func([]uint) (model.FoodRecipes, error) {
+		return suite.recipes, nil
+	})
+	suite.repo.On("GetNutrition", mock.Anything).Return(model.IngredientNutritions{}, nil)
+	suite.repo.On("GetByIDs", []uint{1}).Return(func([]uint) (model.Ingredients, error) {
+		return model.Ingredients{suite.egg}, nil
+	})
+	suite.repo.On("UpdateRecipeDerived", mock.Anything).Return(nil)
+	suite.repo.On("GetPantryLinkCandidates", uint(1)).Return(model.PantryItems{}, nil)
+	suite.repo.On("SetPantryIngredientID", mock.Anything, mock.Anything).Return(nil)
+	suite.repo.On("DeleteUnlinked", mock.Anything).Return(nil)
+}
+
+func (suite *ServiceUpdateTestSuite) TestRefreshLinkedRecipes() {
+	_, err := suite.service.Update(dto.IngredientRequest{
+		Name:          "egg",
+		Category:      "dairy-eggs",
+		Allergens:     []string{"egg"},
+		ExcludedDiets: []string{"vegan"},
+	}, 1, suite.admin)
+	suite.NoError(err)
+
+	// สูตร 10 ผูกอยู่แล้ว สูตร 20 เพิ่งผูก ส่วน salt ไม่เกี่ยว
+	suite.repo.AssertCalled(suite.T(), "SetIngredientID", []uint{200}, linked(1))
+	suite.repo.AssertCalled(suite.T(), "GetRecipes", []uint{10, 20})
+	suite.repo.AssertNumberOfCalls(suite.T(), "UpdateRecipeDerived", 2)
+}
+
+func (suite *ServiceUpdateTestSuite) TestDeriveDietaryFromUpdatedIngredient() {
+	suite.egg.Allergens = model.StringList{model.AllergenEgg}
+	suite.egg.ExcludedDiets = model.StringList{model.DietVegan}
+
+	_, err := suite.service.Update(dto.IngredientRequest{
+		Name:          "egg",
+		Category:      "dairy-eggs",
+		Allergens:     []string{"egg"},
+		ExcludedDiets: []string{"vegan"},
+	}, 1, suite.admin)
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "UpdateRecipeDerived", mock.MatchedBy(func(recipe model.FoodRecipe) bool {
+		return recipe.ID == 10 &&
+			reflect.DeepEqual(recipe.Dietary.Allergens, model.StringList{model.AllergenEgg}) &&
+			reflect.DeepEqual(recipe.Dietary.Diets, model.StringList{model.DietVegetarian, model.DietHalal, model.DietNoPork})
+	}))
+}
+
+func (suite *ServiceUpdateTestSuite) TestKeepDietaryOverride() {
+	_, err := suite.service.Update(dto.IngredientRequest{
+		Name:          "egg",
+		Category:      "dairy-eggs",
+		Allergens:     []string{"egg"},
+		ExcludedDiets: []string{"vegan"},
+	}, 1, suite.admin)
+	suite.NoError(err)
+
+	// สูตรที่ผู้เขียนกำหนดเองได้ค่าโภชนาการใหม่ แต่รูปแบบการกินคงเดิม
+	suite.repo.AssertCalled(suite.T(), "UpdateRecipeDerived", mock.MatchedBy(func(recipe model.FoodRecipe) bool {
+		return recipe.ID == 20 &&
+			recipe.Nutrition.TotalIngredients == 1 &&
+			reflect.DeepEqual(recipe.Dietary.Diets, model.StringList{model.DietVegan})
+	}))
+}
+
+func TestServiceUpdate(t *testing.T) {
+	suite.Run(t, new(ServiceUpdateTestSuite))
+}
+
+type ServiceCreateTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service ingredient.IService
+	repo    *MockIRepository
+
+	// Mock data
+	admin     model.Claims
+	catalogue model.Ingredients
+}
+
+// This will run before each test
+func (suite *ServiceCreateTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &ingredient.Service{
+		Repository: suite.repo,
+	}
+
+	suite.admin = model.Claims{ID: "UID", RealmAccess: &model.RealmAccess{Roles: []string{model.AdminRole}}}
+	suite.catalogue = model.Ingredients{}
+
+	suite.repo.On("Get", model.IngredientQuery{}).Return(func(model.IngredientQuery) (model.Ingredients, error) {
+		return suite.catalogue, nil
+	})
+	suite.repo.On("Create", mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(0).(*model.Ingredient).ID = 2
+	}).Return(nil)
+	suite.repo.On("GetLinkCandidates", uint(2)).Return(model.RecipeIngredients{}, nil)
+	suite.repo.On("SetIngredientID", mock.Anything, mock.Anything).Return(nil)
+	suite.repo.On("GetRecipes", mock.Anything).Return(model.FoodRecipes{}, nil)
+	suite.repo.On("GetPantryLinkCandidates", uint(2)).Return(model.PantryItems{}, nil)
+	suite.repo.On("SetPantryIngredientID", mock.Anything, mock.Anything).Return(nil)
+	suite.repo.On("DeleteUnlinked", mock.Anything).Return(nil)
+}
+
+func (suite *ServiceCreateTestSuite) TestReturnIngredientWithoutExcludedDiets() {
+	ingredient, err := suite.service.Create(dto.IngredientRequest{
+		Name:          "rice",
+		Category:      "pantry",
+		ExcludedDiets: []string{},
+	}, suite.admin)
+	suite.NoError(err)
+
+	suite.Equal(uint(2), ingredient.ID)
+	suite.Empty(ingredient.ExcludedDiets)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenExcludedDietsMissing() {
+	// ไม่ส่ง excludedDiets มาเลย ต่างจากส่ง [] ที่หมายถึงใช้ได้ทุกแบบ
+	ingredient, err := suite.service.Create(dto.IngredientRequest{
+		Name:     "bacon",
+		Category: "meat",
+	}, suite.admin)
+	suite.Error(err)
+	suite.True(strings.HasPrefix(err.Error(), "request invalid"))
+
+	suite.Empty(ingredient)
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenNameExists() {
+	suite.catalogue = model.Ingredients{{Model: gorm.Model{ID: 1}, Name: "egg", Synonyms: model.StringList{"ไข่"}}}
+
+	// ชื่อพ้องชนกับวัตถุดิบที่มีอยู่
+	_, err := suite.service.Create(dto.IngredientRequest{
+		Name:          "hen egg",
+		Category:      "dairy-eggs",
+		Synonyms:      []string{"ไข่"},
+		ExcludedDiets: []string{"vegan"},
+	}, suite.admin)
+	suite.ErrorIs(err, global.ErrConflict)
+
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenNotAdmin() {
+	_, err := suite.service.Create(dto.IngredientRequest{
+		Name:          "rice",
+		Category:      "pantry",
+		ExcludedDiets: []string{},
+	}, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func TestServiceCreate(t *testing.T) {
+	suite.Run(t, new(ServiceCreateTestSuite))
+}
+
+type ServiceBackfillNutritionTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service ingredient.IService
+	repo    *MockIRepository
+
+	// Mock data
+	recipeIDs       []uint
+	errGetRecipeIDs error
+	errUpdate       error
+}
+
+// This will run before each test
+func (suite *ServiceBackfillNutritionTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &ingredient.Service{
+		Repository: suite.repo,
+	}
+
+	suite.recipeIDs = []uint{10, 20}
+	suite.errGetRecipeIDs = nil
+	suite.errUpdate = nil
+
+	suite.repo.On("GetUnestimatedRecipeIDs").Return(func() ([]uint, error) {
+		return suite.recipeIDs, suite.errGetRecipeIDs
+	})
+	suite.repo.On("GetRecipes", mock.Anything).Return(func(recipeIDs []uint) (model.FoodRecipes, error) {
+		recipes := make(model.FoodRecipes, 0, len(recipeIDs))
+		for _, id := range recipeIDs {
+			recipes = append(recipes, model.FoodRecipe{
+				Model:       gorm.Model{ID: id},
+				Ingredients: model.RecipeIngredients{{Name: "egg", Unit: "ฟอง"}},
+			})
+		}
+		return recipes, nil
+	})
+	suite.repo.On("GetNutrition", mock.Anything).Return(model.IngredientNutritions{}, nil)
+	suite.repo.On("GetByIDs", mock.Anything).Return(model.Ingredients{}, nil)
+	suite.repo.On("UpdateRecipeDerived", mock.Anything).Return(func(model.FoodRecipe) error {
+		return suite.errUpdate
+	})
+}
+
+func (suite *ServiceBackfillNutritionTestSuite) TestEstimateUnestimatedRecipes() {
+	count, err := suite.service.BackfillNutrition()
+	suite.NoError(err)
+
+	suite.Equal(2, count)
+	suite.repo.AssertCalled(suite.T(), "GetRecipes", []uint{10, 20})
+	suite.repo.AssertCalled(suite.T(), "UpdateRecipeDerived", mock.MatchedBy(func(recipe model.FoodRecipe) bool {
+		return recipe.ID == 20 && recipe.Nutrition.TotalIngredients == 1
+	}))
+}
+
+func (suite *ServiceBackfillNutritionTestSuite) TestLoadRecipesInBatches() {
+	suite.recipeIDs = make([]uint, 250)
+	for index := range suite.recipeIDs {
+		suite.recipeIDs[index] = uint(index + 1)
+	}
+
+	count, err := suite.service.BackfillNutrition()
+	suite.NoError(err)
+
+	suite.Equal(250, count)
+	suite.repo.AssertNumberOfCalls(suite.T(), "GetRecipes", 3)
+	suite.repo.AssertNumberOfCalls(suite.T(), "UpdateRecipeDerived", 250)
+}
+
+func (suite *ServiceBackfillNutritionTestSuite) TestSkipWhenNothingToBackfill() {
+	suite.recipeIDs = []uint{}
+
+	count, err := suite.service.BackfillNutrition()
+	suite.NoError(err)
+
+	suite.Zero(count)
+	suite.repo.AssertNotCalled(suite.T(), "GetRecipes", mock.Anything)
+}
+
+func (suite *ServiceBackfillNutritionTestSuite) TestErrorWhenGetRecipeIDs() {
+	suite.errGetRecipeIDs = assert.AnError
+
+	_, err := suite.service.BackfillNutrition()
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func (suite *ServiceBackfillNutritionTestSuite) TestErrorWhenUpdateRecipe() {
+	suite.errUpdate = assert.AnError
+
+	count, err := suite.service.BackfillNutrition()
+	suite.ErrorIs(err, assert.AnError)
+
+	suite.Zero(count)
+}
+
+func TestServiceBackfillNutrition(t *testing.T) {
+	suite.Run(t, new(ServiceBackfillNutritionTestSuite))
+}
+
+type ServiceAdminOnlyTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service ingredient.IService
+	repo    *MockIRepository
+
+	// Mock data
+	claims model.Claims
+}
+
+// This will run before each test
+func (suite *ServiceAdminOnlyTestSuite) SetupTest() {
+	// ไม่ตั้ง expectation ไว้เลย ถ้าเรียก repository จะ panic
+	suite.repo = new(MockIRepository)
+	suite.service = &ingredient.Service{
+		Repository: suite.repo,
+	}
+
+	suite.claims = model.Claims{ID: "UID", RealmAccess: &model.RealmAccess{Roles: []string{"user"}}}
+}
+
+func (suite *ServiceAdminOnlyTestSuite) TestErrorWhenUpdate() {
+	_, err := suite.service.Update(dto.IngredientRequest{Name: "egg", Category: "dairy-eggs", ExcludedDiets: []string{}}, 1, suite.claims)
+	suite.ErrorIs(err, global.ErrForbidden)
+}
+
+func (suite *ServiceAdminOnlyTestSuite) TestErrorWhenDelete() {
+	err := suite.service.Delete(1, suite.claims)
+	suite.ErrorIs(err, global.ErrForbidden)
+}
+
+func (suite *ServiceAdminOnlyTestSuite) TestErrorWhenGetUnlinked() {
+	unlinked, err := suite.service.GetUnlinked(suite.claims)
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.Empty(unlinked)
+}
+
+func (suite *ServiceAdminOnlyTestSuite) TestErrorWhenLinkUnlinked() {
+	_, err := suite.service.LinkUnlinked(dto.UnlinkedIngredientLinkRequest{IngredientID: 1}, 1, suite.claims)
+	suite.ErrorIs(err, global.ErrForbidden)
+}
+
+func (suite *ServiceAdminOnlyTestSuite) TestErrorWhenDismissUnlinked() {
+	err := suite.service.DismissUnlinked(1, suite.claims)
+	suite.ErrorIs(err, global.ErrForbidden)
+}
+
+func TestServiceAdminOnly(t *testing.T) {
+	suite.Run(t, new(ServiceAdminOnlyTestSuite))
+}
+
+type ServiceUnlinkedTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service ingredient.IService
+	repo    *MockIRepository
+
+	// Mock data
+	admin          model.Claims
+	egg            model.Ingredient
+	unlinked       model.UnlinkedIngredient
+	errGetUnlinked error
+	errGetByID     error
+}
+
+// This will run before each test
+func (suite *ServiceUnlinkedTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &ingredient.Service{
+		Repository: suite.repo,
+	}
+
+	suite.admin = model.Claims{ID: "UID", RealmAccess: &model.RealmAccess{Roles: []string{model.AdminRole}}}
+	suite.egg = model.Ingredient{Model: gorm.Model{ID: 1}, Name: "egg", Category: "dairy-eggs"}
+	suite.unlinked = model.UnlinkedIngredient{ID: 5, Name: "ไข่ไก่", Occurrences: 3}
+	suite.errGetUnlinked = nil
+	suite.errGetByID = nil
+
+	suite.repo.On("GetUnlinkedByID", 5).Return(func(int) (model.UnlinkedIngredient, error) {
+		return suite.unlinked, suite.errGetUnlinked
+	})
+	suite.repo.On("GetByID", 1).Return(func(int) (model.Ingredient, error) {
+		return suite.egg, suite.errGetByID
+	})
+	suite.repo.On("Get", model.IngredientQuery{}).Return(func(model.IngredientQuery) (model.Ingredients, error) {
+		return model.Ingredients{suite.egg}, nil
+	})
+	suite.repo.On("Update", mock.Anything).Return(nil)
+	suite.repo.On("GetLinkCandidates", uint(1)).Return(model.RecipeIngredients{}, nil)
+	suite.repo.On("SetIngredientID", mock.Anything, mock.Anything).Return(nil)
+	suite.repo.On("GetRecipes", mock.Anything).Return(model.FoodRecipes{}, nil)
+	suite.repo.On("GetPantryLinkCandidates", uint(1)).Return(model.PantryItems{}, nil)
+	suite.repo.On("SetPantryIngredientID", mock.Anything, mock.Anything).Return(nil)
+	suite.repo.On("DeleteUnlinked", mock.Anything).Return(nil)
+}
+
+func (suite *ServiceUnlinkedTestSuite) TestLinkAsSynonym() {
+	ingredient, err := suite.service.LinkUnlinked(dto.UnlinkedIngredientLinkRequest{IngredientID: 1}, 5, suite.admin)
+	suite.NoError(err)
+
+	suite.Equal(model.StringList{"ไข่ไก่"}, ingredient.Synonyms)
+	suite.repo.AssertCalled(suite.T(), "Update", mock.MatchedBy(func(ingredient *model.Ingredient) bool {
+		return ingredient.ID == 1 && ingredient.HasTerm("ไข่ไก่")
+	}))
+}
+
+func (suite *ServiceUnlinkedTestSuite) TestSkipSynonymWhenAlreadyTerm() {
+	suite.unlinked.Name = "Egg"
+
+	ingredient, err := suite.service.LinkUnlinked(dto.UnlinkedIngredientLinkRequest{IngredientID: 1}, 5, suite.admin)
+	suite.NoError(err)
+
+	suite.Empty(ingredient.Synonyms)
+}
+
+func (suite *ServiceUnlinkedTestSuite) TestErrorWhenLinkUnlinkedNotFound() {
+	suite.errGetUnlinked = gorm.ErrRecordNotFound
+
+	_, err := suite.service.LinkUnlinked(dto.UnlinkedIngredientLinkRequest{IngredientID: 1}, 5, suite.admin)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServiceUnlinkedTestSuite) TestErrorWhenLinkIngredientNotFound() {
+	suite.errGetByID = gorm.ErrRecordNotFound
+
+	_, err := suite.service.LinkUnlinked(dto.UnlinkedIngredientLinkRequest{IngredientID: 1}, 5, suite.admin)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServiceUnlinkedTestSuite) TestDismissByName() {
+	err := suite.service.DismissUnlinked(5, suite.admin)
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "DeleteUnlinked", []string{"ไข่ไก่"})
+}
+
+func (suite *ServiceUnlinkedTestSuite) TestErrorWhenDismissNotFound() {
+	suite.errGetUnlinked = gorm.ErrRecordNotFound
+
+	err := suite.service.DismissUnlinked(5, suite.admin)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "DeleteUnlinked", mock.Anything)
+}
+
+func TestServiceUnlinked(t *testing.T) {
+	suite.Run(t, new(ServiceUnlinkedTestSuite))
+}
@@ -0,0 +1,270 @@
+package mealplan
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+	"wongnok/internal/global"
+	"wongnok/internal/helper"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IHandler interface {
+	GetWeek(ctx *gin.Context)
+	Create(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	CopyWeek(ctx *gin.Context)
+	ClearDay(ctx *gin.Context)
+}
+
+type Handler struct {
+	Service IService
+}
+
+func NewHandler(db *gorm.DB) *Handler {
+	return &Handler{
+		Service: NewService(db),
+	}
+}
+
+// GetWeek godoc
+// @Summary Get my meal plan for a week
+// @Description Meal plan from Monday to Sunday of the week containing the given date, grouped by day and meal slot
+// @Tags meal-plans
+// @Accept json
+// @Produce json
+// @Param week query string false "Any date in the week (YYYY-MM-DD)" (default this week)
+// @Success 200 {object} dto.MealPlanWeekResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/meal-plans [get]
+func (handler Handler) GetWeek(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var query model.MealPlanWeekQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	entries, start, err := handler.Service.GetWeek(query, claims)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, entries.ToWeekResponse(start))
+}
+
+// Create godoc
+// @Summary Add a recipe to my meal plan
+// @Description Assign a recipe to a date and meal slot (breakfast, lunch, dinner, snack). Servings defaults to the recipe's servings
+// @Tags meal-plans
+// @Accept json
+// @Produce json
+// @Param entry body dto.MealPlanEntryRequest true "Meal plan entry"
+// @Success 201 {object} dto.MealPlanEntryResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/meal-plans [post]
+func (handler Handler) Create(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var request dto.MealPlanEntryRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	entry, err := handler.Service.Create(request, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, entry.ToResponse())
+}
+
+// Update godoc
+// @Summary Update a meal plan entry
+// @Description Move an entry to another date or slot, or change its recipe or servings
+// @Tags meal-plans
+// @Accept json
+// @Produce json
+// @Param id path int true "Meal plan entry ID"
+// @Param entry body dto.MealPlanEntryRequest true "Meal plan entry"
+// @Success 200 {object} dto.MealPlanEntryResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/meal-plans/{id} [put]
+func (handler Handler) Update(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid meal plan entry id"})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var request dto.MealPlanEntryRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	entry, err := handler.Service.Update(request, id, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, entry.ToResponse())
+}
+
+// Delete godoc
+// @Summary Remove a meal plan entry
+// @Description Remove an entry from your meal plan
+// @Tags meal-plans
+// @Accept json
+// @Produce json
+// @Param id path int true "Meal plan entry ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/meal-plans/{id} [delete]
+func (handler Handler) Delete(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid meal plan entry id"})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	if err := handler.Service.Delete(id, claims); err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Meal plan entry deleted successfully"})
+}
+
+// CopyWeek godoc
+// @Summary Copy a week of my meal plan
+// @Description Copy every entry of one week onto the same weekdays of another week. Entries already in the target week are kept
+// @Tags meal-plans
+// @Accept json
+// @Produce json
+// @Param copy body dto.MealPlanCopyRequest true "Source and target weeks (any date in each week)"
+// @Success 200 {object} dto.MealPlanWeekResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/meal-plans/copy [post]
+func (handler Handler) CopyWeek(ctx *gin.Context) {
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	var request dto.MealPlanCopyRequest
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	entries, start, err := handler.Service.CopyWeek(request, claims)
+	if err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, entries.ToWeekResponse(start))
+}
+
+// ClearDay godoc
+// @Summary Clear a day of my meal plan
+// @Description Remove every entry on the given date
+// @Tags meal-plans
+// @Accept json
+// @Produce json
+// @Param date path string true "Date (YYYY-MM-DD)"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/meal-plans/days/{date} [delete]
+func (handler Handler) ClearDay(ctx *gin.Context) {
+	date, err := time.Parse(model.MealPlanDateLayout, ctx.Param("date"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid date"})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	if err := handler.Service.ClearDay(date, claims); err != nil {
+		ctx.JSON(statusCode(err), gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Meal plan day cleared successfully"})
+}
+
+func statusCode(err error) int {
+	switch {
+	case errors.As(err, &validator.ValidationErrors{}):
+		return http.StatusBadRequest
+	case errors.Is(err, global.ErrSameWeek):
+		return http.StatusBadRequest
+	case errors.Is(err, global.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
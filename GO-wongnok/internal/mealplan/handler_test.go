@@ -0,0 +1,285 @@
+package mealplan_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"wongnok/internal/global"
+	"wongnok/internal/mealplan"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewHandler(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		handler := mealplan.NewHandler(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(handler))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler mealplan.IHandler
+	service *MockIService
+
+	// Mock data
+	monday time.Time
+	entry  model.MealPlanEntry
+
+	// Helper
+	server func(method string, url string, payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+// This will run once before all tests in the suite
+func (suite *HandlerTestSuite) SetupSuite() {
+	// Gin testing mode
+	gin.SetMode(gin.TestMode)
+}
+
+// This will run before each test
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = mealplan.Handler{
+		Service: suite.service,
+	}
+
+	suite.monday = time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	suite.entry = model.MealPlanEntry{
+		Model:        gorm.Model{ID: 5},
+		UserID:       "UID",
+		Date:         suite.monday,
+		Slot:         model.MealSlotLunch,
+		FoodRecipeID: 1,
+		Servings:     2,
+	}
+
+	suite.server = func(method string, url string, payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder {
+		// Create router
+		router := gin.Default()
+
+		// Set context
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+
+		router.GET("/api/v1/meal-plans", suite.handler.GetWeek)
+		router.POST("/api/v1/meal-plans", suite.handler.Create)
+		router.POST("/api/v1/meal-plans/copy", suite.handler.CopyWeek)
+		router.PUT("/api/v1/meal-plans/:id", suite.handler.Update)
+		router.DELETE("/api/v1/meal-plans/:id", suite.handler.Delete)
+		router.DELETE("/api/v1/meal-plans/days/:date", suite.handler.ClearDay)
+
+		recorder := httptest.NewRecorder()
+
+		// Create request
+		request, err := http.NewRequest(method, url, payload)
+		suite.NoError(err)
+
+		// Start testing server
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+}
+
+// Extend
+type HandlerGetWeekTestSuite struct {
+	HandlerTestSuite
+}
+
+func (suite *HandlerGetWeekTestSuite) TestResponseWeek() {
+	entries := model.MealPlanEntries{suite.entry}
+	suite.service.On("GetWeek", mock.Anything, model.Claims{ID: "UID"}).Return(entries, suite.monday, nil)
+
+	response := suite.server(http.MethodGet, "/api/v1/meal-plans?week=2026-10-15", nil, &model.Claims{ID: "UID"})
+
+	expectedJson, _ := json.Marshal(entries.ToWeekResponse(suite.monday))
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "GetWeek", mock.MatchedBy(func(query model.MealPlanWeekQuery) bool {
+		return query.Week.Format(model.MealPlanDateLayout) == "2026-10-15"
+	}), model.Claims{ID: "UID"})
+}
+
+func (suite *HandlerGetWeekTestSuite) TestErrorWhenWeekInvalid() {
+	response := suite.server(http.MethodGet, "/api/v1/meal-plans?week=15-10-2026", nil, &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "GetWeek", mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerGetWeekTestSuite) TestErrorWhenNoClaims() {
+	response := suite.server(http.MethodGet, "/api/v1/meal-plans", nil, nil)
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+}
+
+func TestHandlerGetWeek(t *testing.T) {
+	suite.Run(t, new(HandlerGetWeekTestSuite))
+}
+
+// Extend
+type HandlerCreateTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	errServiceCreate error
+}
+
+// This will run before each test
+func (suite *HandlerCreateTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.errServiceCreate = nil
+
+	suite.service.On("Create", mock.Anything, mock.Anything).Return(func(dto.MealPlanEntryRequest, model.Claims) (model.MealPlanEntry, error) {
+		return suite.entry, suite.errServiceCreate
+	})
+}
+
+func (suite *HandlerCreateTestSuite) TestResponseEntryWithStatusCode201() {
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(http.MethodPost, "/api/v1/meal-plans", strings.NewReader(`{"date": "2026-10-12", "slot": "lunch", "foodRecipeID": 1, "servings": 2}`), &claims)
+
+	expectedJson, _ := json.Marshal(suite.entry.ToResponse())
+
+	suite.Equal(http.StatusCreated, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Create", dto.MealPlanEntryRequest{Date: "2026-10-12", Slot: "lunch", FoodRecipeID: 1, Servings: 2}, claims)
+}
+
+func (suite *HandlerCreateTestSuite) TestErrorWhenRecipeNotFound() {
+	suite.errServiceCreate = errors.Wrap(gorm.ErrRecordNotFound, "find recipe")
+
+	response := suite.server(http.MethodPost, "/api/v1/meal-plans", strings.NewReader(`{"date": "2026-10-12", "slot": "lunch", "foodRecipeID": 99}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func TestHandlerCreate(t *testing.T) {
+	suite.Run(t, new(HandlerCreateTestSuite))
+}
+
+// Extend
+type HandlerUpdateTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	errServiceUpdate error
+}
+
+// This will run before each test
+func (suite *HandlerUpdateTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.errServiceUpdate = nil
+
+	suite.service.On("Update", mock.Anything, mock.Anything, mock.Anything).Return(func(dto.MealPlanEntryRequest, int, model.Claims) (model.MealPlanEntry, error) {
+		return suite.entry, suite.errServiceUpdate
+	})
+}
+
+func (suite *HandlerUpdateTestSuite) TestResponseEntryWithStatusCode200() {
+	response := suite.server(http.MethodPut, "/api/v1/meal-plans/5", strings.NewReader(`{"date": "2026-10-12", "slot": "lunch", "foodRecipeID": 1}`), &model.Claims{ID: "UID"})
+
+	expectedJson, _ := json.Marshal(suite.entry.ToResponse())
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+}
+
+func (suite *HandlerUpdateTestSuite) TestErrorWhenNotOwner() {
+	suite.errServiceUpdate = global.ErrForbidden
+
+	response := suite.server(http.MethodPut, "/api/v1/meal-plans/5", strings.NewReader(`{"date": "2026-10-12", "slot": "lunch", "foodRecipeID": 1}`), &model.Claims{ID: "OTHER"})
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func (suite *HandlerUpdateTestSuite) TestErrorWhenRecipeNotFound() {
+	suite.errServiceUpdate = errors.Wrap(gorm.ErrRecordNotFound, "find recipe")
+
+	response := suite.server(http.MethodPut, "/api/v1/meal-plans/5", strings.NewReader(`{"date": "2026-10-12", "slot": "lunch", "foodRecipeID": 99}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func TestHandlerUpdate(t *testing.T) {
+	suite.Run(t, new(HandlerUpdateTestSuite))
+}
+
+// Extend
+type HandlerDeleteTestSuite struct {
+	HandlerTestSuite
+}
+
+func (suite *HandlerDeleteTestSuite) TestErrorWhenNotOwner() {
+	suite.service.On("Delete", 5, model.Claims{ID: "OTHER"}).Return(global.ErrForbidden)
+
+	response := suite.server(http.MethodDelete, "/api/v1/meal-plans/5", nil, &model.Claims{ID: "OTHER"})
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func (suite *HandlerDeleteTestSuite) TestClearDay() {
+	suite.service.On("ClearDay", suite.monday, model.Claims{ID: "UID"}).Return(nil)
+
+	response := suite.server(http.MethodDelete, "/api/v1/meal-plans/days/2026-10-12", nil, &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(`{"message":"Meal plan day cleared successfully"}`, response.Body.String())
+}
+
+func (suite *HandlerDeleteTestSuite) TestErrorWhenClearDayInvalid() {
+	response := suite.server(http.MethodDelete, "/api/v1/meal-plans/days/tomorrow", nil, &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "ClearDay", mock.Anything, mock.Anything)
+}
+
+func TestHandlerDelete(t *testing.T) {
+	suite.Run(t, new(HandlerDeleteTestSuite))
+}
+
+// Extend
+type HandlerCopyWeekTestSuite struct {
+	HandlerTestSuite
+}
+
+func (suite *HandlerCopyWeekTestSuite) TestErrorWhenSameWeek() {
+	suite.service.On("CopyWeek", mock.Anything, mock.Anything).Return(nil, time.Time{}, global.ErrSameWeek)
+
+	response := suite.server(http.MethodPost, "/api/v1/meal-plans/copy", strings.NewReader(`{"fromWeek": "2026-10-12", "toWeek": "2026-10-18"}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertCalled(suite.T(), "CopyWeek", dto.MealPlanCopyRequest{FromWeek: "2026-10-12", ToWeek: "2026-10-18"}, model.Claims{ID: "UID"})
+}
+
+func TestHandlerCopyWeek(t *testing.T) {
+	suite.Run(t, new(HandlerCopyWeekTestSuite))
+}
@@ -0,0 +1,90 @@
+package mealplan
+
+import (
+	"time"
+	"wongnok/internal/model"
+
+	"gorm.io/gorm"
+)
+
+type IRepository interface {
+	GetByRange(userID string, start time.Time, end time.Time) (model.MealPlanEntries, error)
+	GetByID(id int) (model.MealPlanEntry, error)
+	Create(entry *model.MealPlanEntry) error
+	Update(entry *model.MealPlanEntry) error
+	Delete(id uint) error
+	CopyWeek(userID string, from time.Time, to time.Time) error
+	DeleteDay(userID string, date time.Time) error
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+// GetByRange รายการในแผนของผู้ใช้ตั้งแต่ start ถึงก่อน end พร้อมข้อมูลย่อของสูตร
+func (repo Repository) GetByRange(userID string, start time.Time, end time.Time) (model.MealPlanEntries, error) {
+	var entries = make(model.MealPlanEntries, 0)
+
+	if err := repo.DB.Preload("FoodRecipe").
+		Where("user_id = ? AND date >= ? AND date < ?", userID, start, end).
+		Order("date asc, id asc").
+		Find(&entries).Error; err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (repo Repository) GetByID(id int) (model.MealPlanEntry, error) {
+	var entry model.MealPlanEntry
+
+	if err := repo.DB.Preload("FoodRecipe").First(&entry, id).Error; err != nil {
+		return model.MealPlanEntry{}, err
+	}
+
+	return entry, nil
+}
+
+func (repo Repository) Create(entry *model.MealPlanEntry) error {
+	if err := repo.DB.Omit("FoodRecipe").Create(entry).Error; err != nil {
+		return err
+	}
+
+	return repo.DB.Preload("FoodRecipe").First(entry, entry.ID).Error
+}
+
+func (repo Repository) Update(entry *model.MealPlanEntry) error {
+	if err := repo.DB.Model(entry).Select("date", "slot", "food_recipe_id", "servings").Updates(entry).Error; err != nil {
+		return err
+	}
+
+	return repo.DB.Preload("FoodRecipe").First(entry, entry.ID).Error
+}
+
+func (repo Repository) Delete(id uint) error {
+	return repo.DB.Delete(&model.MealPlanEntry{}, id).Error
+}
+
+// CopyWeek คัดลอกทุกรายการในสัปดาห์ from ไปไว้ในวันเดียวกันของสัปดาห์ to
+// รายการเดิมในสัปดาห์ to ยังอยู่ครบ (เพิ่มต่อท้าย ไม่เขียนทับ)
+func (repo Repository) CopyWeek(userID string, from time.Time, to time.Time) error {
+	days := int(to.Sub(from).Hours() / 24)
+
+	return repo.DB.Exec(`
+		INSERT INTO meal_plan_entries (user_id, date, slot, food_recipe_id, servings, created_at, updated_at)
+		SELECT user_id, date + ?::int, slot, food_recipe_id, servings, NOW(), NOW()
+		FROM meal_plan_entries
+		WHERE user_id = ? AND date >= ? AND date < ? AND deleted_at IS NULL
+		ORDER BY date, id`, days, userID, from, from.AddDate(0, 0, 7)).Error
+}
+
+// DeleteDay ล้างทุกมื้อของวันที่ระบุ
+func (repo Repository) DeleteDay(userID string, date time.Time) error {
+	return repo.DB.Where("user_id = ? AND date = ?", userID, date).Delete(&model.MealPlanEntry{}).Error
+}
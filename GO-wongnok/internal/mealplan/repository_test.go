@@ -0,0 +1,171 @@
+package mealplan_test
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+	"wongnok/internal/mealplan"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	driver "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestNewRepository(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		repo := mealplan.NewRepository(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(repo))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+type RepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	container  *postgres.PostgresContainer
+	db         *gorm.DB
+	repository mealplan.IRepository
+}
+
+// This will run once before all tests in the suite
+func (suite *RepositoryTestSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	container, err := postgres.Run(
+		suite.ctx,
+		"postgres:17-alpine",
+		postgres.WithInitScripts(filepath.Join("../..", "tests", "init-db.sql")),
+		postgres.WithDatabase("wongnok-test"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").WithOccurrence(2).WithStartupTimeout(
+				(5 * time.Second),
+			),
+		),
+	)
+	suite.NoError(err)
+	suite.container = container
+}
+
+// This will run once after all tests in the suite
+func (suite *RepositoryTestSuite) TearDownSuite() {
+	err := suite.container.Terminate(suite.ctx)
+	suite.NoError(err)
+}
+
+// This will run before each test
+func (suite *RepositoryTestSuite) SetupTest() {
+	conn, err := suite.container.ConnectionString(suite.ctx, "sslmode=disable")
+	suite.NoError(err)
+
+	db, err := gorm.Open(driver.Open(conn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	suite.NoError(err)
+
+	suite.repository = &mealplan.Repository{
+		DB: db,
+	}
+
+	suite.db = db
+}
+
+// This will run after each test
+func (suite *RepositoryTestSuite) TearDownTest() {
+	sqldb, _ := suite.db.DB()
+	sqldb.Close()
+}
+
+const demoUserID = "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"
+
+// Extend
+type RepositoryWeekTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryWeekTestSuite) TestCopyAndClearWeek() {
+	monday := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	nextMonday := monday.AddDate(0, 0, 7)
+
+	lunch := model.MealPlanEntry{UserID: demoUserID, Date: monday, Slot: model.MealSlotLunch, FoodRecipeID: 1, Servings: 2}
+	suite.NoError(suite.repository.Create(&lunch))
+	suite.Equal("Omlet", lunch.FoodRecipe.Name)
+
+	dinner := model.MealPlanEntry{UserID: demoUserID, Date: monday.AddDate(0, 0, 6), Slot: model.MealSlotDinner, FoodRecipeID: 1}
+	suite.NoError(suite.repository.Create(&dinner))
+
+	// รายการของสัปดาห์ถัดไปอยู่นอกช่วง
+	existing := model.MealPlanEntry{UserID: demoUserID, Date: nextMonday, Slot: model.MealSlotBreakfast, FoodRecipeID: 1}
+	suite.NoError(suite.repository.Create(&existing))
+
+	entries, err := suite.repository.GetByRange(demoUserID, monday, nextMonday)
+	suite.NoError(err)
+	suite.Len(entries, 2)
+
+	// คัดลอกไปวันเดียวกันของสัปดาห์ถัดไป โดยไม่ทับรายการเดิม
+	suite.NoError(suite.repository.CopyWeek(demoUserID, monday, nextMonday))
+
+	copied, err := suite.repository.GetByRange(demoUserID, nextMonday, nextMonday.AddDate(0, 0, 7))
+	suite.NoError(err)
+	suite.Len(copied, 3)
+	suite.Equal(nextMonday.Format(model.MealPlanDateLayout), copied[1].Date.Format(model.MealPlanDateLayout))
+	suite.Equal(2, copied[1].Servings)
+	suite.Equal("2026-10-25", copied[2].Date.Format(model.MealPlanDateLayout))
+
+	suite.NoError(suite.repository.DeleteDay(demoUserID, nextMonday))
+
+	remaining, err := suite.repository.GetByRange(demoUserID, nextMonday, nextMonday.AddDate(0, 0, 7))
+	suite.NoError(err)
+	suite.Len(remaining, 1)
+}
+
+func TestRepositoryWeek(t *testing.T) {
+	suite.Run(t, new(RepositoryWeekTestSuite))
+}
+
+// Extend
+type RepositoryUpdateTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryUpdateTestSuite) TestMoveEntry() {
+	entry := model.MealPlanEntry{UserID: demoUserID, Date: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), Slot: model.MealSlotLunch, FoodRecipeID: 1}
+	suite.NoError(suite.repository.Create(&entry))
+
+	entry.Date = time.Date(2026, 10, 13, 0, 0, 0, 0, time.UTC)
+	entry.Slot = model.MealSlotDinner
+	entry.Servings = 4
+	suite.NoError(suite.repository.Update(&entry))
+
+	result, err := suite.repository.GetByID(int(entry.ID))
+	suite.NoError(err)
+
+	suite.Equal("2026-10-13", result.Date.Format(model.MealPlanDateLayout))
+	suite.Equal(model.MealSlotDinner, result.Slot)
+	suite.Equal(4, result.Servings)
+
+	suite.NoError(suite.repository.Delete(entry.ID))
+
+	_, err = suite.repository.GetByID(int(entry.ID))
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func TestRepositoryUpdate(t *testing.T) {
+	suite.Run(t, new(RepositoryUpdateTestSuite))
+}
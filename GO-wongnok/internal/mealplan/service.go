@@ -0,0 +1,142 @@
+package mealplan
+
+import (
+	"time"
+	"wongnok/internal/foodrecipe"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IFoodRecipeService foodrecipe.IService
+
+type IService interface {
+	GetWeek(query model.MealPlanWeekQuery, claims model.Claims) (model.MealPlanEntries, time.Time, error)
+	Create(request dto.MealPlanEntryRequest, claims model.Claims) (model.MealPlanEntry, error)
+	Update(request dto.MealPlanEntryRequest, id int, claims model.Claims) (model.MealPlanEntry, error)
+	Delete(id int, claims model.Claims) error
+	CopyWeek(request dto.MealPlanCopyRequest, claims model.Claims) (model.MealPlanEntries, time.Time, error)
+	ClearDay(date time.Time, claims model.Claims) error
+}
+
+type Service struct {
+	Repository        IRepository
+	FoodRecipeService IFoodRecipeService
+}
+
+func NewService(db *gorm.DB) IService {
+	return &Service{
+		Repository:        NewRepository(db),
+		FoodRecipeService: foodrecipe.NewService(db),
+	}
+}
+
+// GetWeek แผนของผู้ใช้ทั้งสัปดาห์ (จันทร์ถึงอาทิตย์) คืนวันจันทร์ของสัปดาห์นั้นมาด้วย
+func (service Service) GetWeek(query model.MealPlanWeekQuery, claims model.Claims) (model.MealPlanEntries, time.Time, error) {
+	start := query.Start()
+
+	entries, err := service.Repository.GetByRange(claims.ID, start, start.AddDate(0, 0, 7))
+	if err != nil {
+		return nil, time.Time{}, errors.Wrap(err, "find meal plan")
+	}
+
+	return entries, start, nil
+}
+
+func (service Service) Create(request dto.MealPlanEntryRequest, claims model.Claims) (model.MealPlanEntry, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.MealPlanEntry{}, errors.Wrap(err, "request invalid")
+	}
+
+	if err := service.FoodRecipeService.Exists(int(request.FoodRecipeID)); err != nil {
+		return model.MealPlanEntry{}, errors.Wrap(err, "find recipe")
+	}
+
+	entry := model.MealPlanEntry{UserID: claims.ID}.FromRequest(request)
+
+	if err := service.Repository.Create(&entry); err != nil {
+		return model.MealPlanEntry{}, errors.Wrap(err, "create meal plan entry")
+	}
+
+	return entry, nil
+}
+
+// Update ย้ายวัน/มื้อ เปลี่ยนสูตร หรือจำนวนที่เสิร์ฟของรายการในแผนของตัวเอง
+func (service Service) Update(request dto.MealPlanEntryRequest, id int, claims model.Claims) (model.MealPlanEntry, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.MealPlanEntry{}, errors.Wrap(err, "request invalid")
+	}
+
+	entry, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.MealPlanEntry{}, errors.Wrap(err, "find meal plan entry")
+	}
+
+	if entry.UserID != claims.ID {
+		return model.MealPlanEntry{}, global.ErrForbidden
+	}
+
+	if request.FoodRecipeID != entry.FoodRecipeID {
+		if err := service.FoodRecipeService.Exists(int(request.FoodRecipeID)); err != nil {
+			return model.MealPlanEntry{}, errors.Wrap(err, "find recipe")
+		}
+	}
+
+	entry = entry.FromRequest(request)
+
+	if err := service.Repository.Update(&entry); err != nil {
+		return model.MealPlanEntry{}, errors.Wrap(err, "update meal plan entry")
+	}
+
+	return entry, nil
+}
+
+func (service Service) Delete(id int, claims model.Claims) error {
+	entry, err := service.Repository.GetByID(id)
+	if err != nil {
+		return errors.Wrap(err, "find meal plan entry")
+	}
+
+	if entry.UserID != claims.ID {
+		return global.ErrForbidden
+	}
+
+	return service.Repository.Delete(entry.ID)
+}
+
+// CopyWeek คัดลอกแผนจากสัปดาห์หนึ่งไปอีกสัปดาห์ แล้วคืนแผนของสัปดาห์ปลายทาง
+func (service Service) CopyWeek(request dto.MealPlanCopyRequest, claims model.Claims) (model.MealPlanEntries, time.Time, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return nil, time.Time{}, errors.Wrap(err, "request invalid")
+	}
+
+	fromWeek, _ := time.Parse(model.MealPlanDateLayout, request.FromWeek)
+	toWeek, _ := time.Parse(model.MealPlanDateLayout, request.ToWeek)
+	from, to := model.WeekStart(fromWeek), model.WeekStart(toWeek)
+
+	// สองวันที่อยู่ในสัปดาห์เดียวกัน จะได้รายการซ้ำในวันเดิม
+	if from.Equal(to) {
+		return nil, time.Time{}, global.ErrSameWeek
+	}
+
+	if err := service.Repository.CopyWeek(claims.ID, from, to); err != nil {
+		return nil, time.Time{}, errors.Wrap(err, "copy meal plan")
+	}
+
+	return service.GetWeek(model.MealPlanWeekQuery{Week: to}, claims)
+}
+
+func (service Service) ClearDay(date time.Time, claims model.Claims) error {
+	if err := service.Repository.DeleteDay(claims.ID, date); err != nil {
+		return errors.Wrap(err, "clear meal plan day")
+	}
+
+	return nil
+}
@@ -0,0 +1,241 @@
+package mealplan_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+	"wongnok/internal/global"
+	"wongnok/internal/mealplan"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewService(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		service := mealplan.NewService(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(service))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+type ServiceTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service           mealplan.IService
+	repo              *MockIRepository
+	foodRecipeService *MockIFoodRecipeService
+
+	// Mock data
+	monday      time.Time
+	entry       model.MealPlanEntry
+	errGetByID  error
+	errExists   error
+	errCopyWeek error
+}
+
+// This will run before each test
+func (suite *ServiceTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.foodRecipeService = new(MockIFoodRecipeService)
+	suite.service = &mealplan.Service{
+		Repository:        suite.repo,
+		FoodRecipeService: suite.foodRecipeService,
+	}
+
+	suite.monday = time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	suite.entry = model.MealPlanEntry{
+		Model:        gorm.Model{ID: 5},
+		UserID:       "UID",
+		Date:         suite.monday,
+		Slot:         model.MealSlotLunch,
+		FoodRecipeID: 1,
+	}
+	suite.errGetByID = nil
+	suite.errExists = nil
+	suite.errCopyWeek = nil
+
+	suite.foodRecipeService.On("Exists", mock.Anything).Return(func(int) error {
+		return suite.errExists
+	})
+	suite.repo.On("GetByID", 5).Return(func(int) (model.MealPlanEntry, error) {
+		return suite.entry, suite.errGetByID
+	})
+	suite.repo.On("GetByRange", mock.Anything, mock.Anything, mock.Anything).Return(func(string, time.Time, time.Time) (model.MealPlanEntries, error) {
+		return model.MealPlanEntries{suite.entry}, nil
+	})
+	suite.repo.On("Create", mock.Anything).Return(nil)
+	suite.repo.On("Update", mock.Anything).Return(nil)
+	suite.repo.On("Delete", mock.Anything).Return(nil)
+	suite.repo.On("CopyWeek", mock.Anything, mock.Anything, mock.Anything).Return(func(string, time.Time, time.Time) error {
+		return suite.errCopyWeek
+	})
+	suite.repo.On("DeleteDay", mock.Anything, mock.Anything).Return(nil)
+}
+
+// Extend
+type ServiceGetWeekTestSuite struct {
+	ServiceTestSuite
+}
+
+func (suite *ServiceGetWeekTestSuite) TestGetFromMondayToNextMonday() {
+	entries, start, err := suite.service.GetWeek(model.MealPlanWeekQuery{Week: time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)}, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.Len(entries, 1)
+	suite.Equal(suite.monday, start)
+	suite.repo.AssertCalled(suite.T(), "GetByRange", "UID", suite.monday, suite.monday.AddDate(0, 0, 7))
+}
+
+func TestServiceGetWeek(t *testing.T) {
+	suite.Run(t, new(ServiceGetWeekTestSuite))
+}
+
+// Extend
+type ServiceCreateTestSuite struct {
+	ServiceTestSuite
+}
+
+func (suite *ServiceCreateTestSuite) TestCreateEntry() {
+	entry, err := suite.service.Create(dto.MealPlanEntryRequest{Date: "2026-10-12", Slot: "dinner", FoodRecipeID: 1, Servings: 4}, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.Equal(model.MealPlanEntry{UserID: "UID", Date: suite.monday, Slot: "dinner", FoodRecipeID: 1, Servings: 4}, entry)
+	suite.foodRecipeService.AssertCalled(suite.T(), "Exists", 1)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenRecipeNotFound() {
+	suite.errExists = gorm.ErrRecordNotFound
+
+	_, err := suite.service.Create(dto.MealPlanEntryRequest{Date: "2026-10-12", Slot: "dinner", FoodRecipeID: 99}, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenSlotInvalid() {
+	_, err := suite.service.Create(dto.MealPlanEntryRequest{Date: "2026-10-12", Slot: "brunch", FoodRecipeID: 1}, model.Claims{ID: "UID"})
+	suite.ErrorAs(err, &validator.ValidationErrors{})
+
+	suite.foodRecipeService.AssertNotCalled(suite.T(), "Exists", mock.Anything)
+}
+
+func TestServiceCreate(t *testing.T) {
+	suite.Run(t, new(ServiceCreateTestSuite))
+}
+
+// Extend
+type ServiceUpdateTestSuite struct {
+	ServiceTestSuite
+}
+
+func (suite *ServiceUpdateTestSuite) TestSkipRecipeCheckWhenUnchanged() {
+	entry, err := suite.service.Update(dto.MealPlanEntryRequest{Date: "2026-10-13", Slot: "dinner", FoodRecipeID: 1}, 5, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.Equal(suite.monday.AddDate(0, 0, 1), entry.Date)
+	suite.foodRecipeService.AssertNotCalled(suite.T(), "Exists", mock.Anything)
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorWhenNewRecipeNotFound() {
+	suite.errExists = gorm.ErrRecordNotFound
+
+	_, err := suite.service.Update(dto.MealPlanEntryRequest{Date: "2026-10-12", Slot: "lunch", FoodRecipeID: 99}, 5, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.foodRecipeService.AssertCalled(suite.T(), "Exists", 99)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorWhenNotOwner() {
+	_, err := suite.service.Update(dto.MealPlanEntryRequest{Date: "2026-10-12", Slot: "lunch", FoodRecipeID: 1}, 5, model.Claims{ID: "OTHER"})
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServiceUpdateTestSuite) TestErrorWhenNotFound() {
+	suite.errGetByID = gorm.ErrRecordNotFound
+
+	_, err := suite.service.Update(dto.MealPlanEntryRequest{Date: "2026-10-12", Slot: "lunch", FoodRecipeID: 1}, 5, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func TestServiceUpdate(t *testing.T) {
+	suite.Run(t, new(ServiceUpdateTestSuite))
+}
+
+// Extend
+type ServiceDeleteTestSuite struct {
+	ServiceTestSuite
+}
+
+func (suite *ServiceDeleteTestSuite) TestDeleteOwnEntry() {
+	err := suite.service.Delete(5, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "Delete", uint(5))
+}
+
+func (suite *ServiceDeleteTestSuite) TestErrorWhenNotOwner() {
+	err := suite.service.Delete(5, model.Claims{ID: "OTHER"})
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
+
+func (suite *ServiceDeleteTestSuite) TestClearDayOfClaims() {
+	err := suite.service.ClearDay(suite.monday, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "DeleteDay", "UID", suite.monday)
+}
+
+func TestServiceDelete(t *testing.T) {
+	suite.Run(t, new(ServiceDeleteTestSuite))
+}
+
+// Extend
+type ServiceCopyWeekTestSuite struct {
+	ServiceTestSuite
+}
+
+func (suite *ServiceCopyWeekTestSuite) TestCopyBetweenMondays() {
+	_, start, err := suite.service.CopyWeek(dto.MealPlanCopyRequest{FromWeek: "2026-10-15", ToWeek: "2026-10-25"}, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	nextMonday := suite.monday.AddDate(0, 0, 7)
+	suite.Equal(nextMonday, start)
+	suite.repo.AssertCalled(suite.T(), "CopyWeek", "UID", suite.monday, nextMonday)
+}
+
+func (suite *ServiceCopyWeekTestSuite) TestErrorWhenSameWeek() {
+	_, _, err := suite.service.CopyWeek(dto.MealPlanCopyRequest{FromWeek: "2026-10-12", ToWeek: "2026-10-18"}, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, global.ErrSameWeek)
+
+	suite.repo.AssertNotCalled(suite.T(), "CopyWeek", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceCopyWeekTestSuite) TestErrorWhenRepositoryCopy() {
+	suite.errCopyWeek = assert.AnError
+
+	_, _, err := suite.service.CopyWeek(dto.MealPlanCopyRequest{FromWeek: "2026-10-12", ToWeek: "2026-10-19"}, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func TestServiceCopyWeek(t *testing.T) {
+	suite.Run(t, new(ServiceCopyWeekTestSuite))
+}
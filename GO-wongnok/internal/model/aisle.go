@@ -0,0 +1,83 @@
+package model
+
+import "strings"
+
+// หมวดในซูเปอร์มาร์เก็ต ใช้จัดกลุ่มรายการซื้อของ เรียงตามลำดับใน AisleCategories
+const (
+	AisleProduce   = "produce"
+	AisleMeat      = "meat"
+	AisleSeafood   = "seafood"
+	AisleDairy     = "dairy-eggs"
+	AisleBakery    = "bakery"
+	AislePantry    = "pantry"
+	AisleSpices    = "spices-condiments"
+	AisleFrozen    = "frozen"
+	AisleBeverages = "beverages"
+	AisleOther     = "other"
+)
+
+var AisleCategories = []string{
+	AisleProduce, AisleMeat, AisleSeafood, AisleDairy, AisleBakery,
+	AislePantry, AisleSpices, AisleFrozen, AisleBeverages, AisleOther,
+}
+
+// aisleKeywords คำที่ใช้เดาหมวดจากชื่อวัตถุดิบ
+// ถ้าตรงหลายคำจะใช้คำที่ยาวที่สุด เช่น "น้ำปลา" (เครื่องปรุง) ชนะ "ปลา" (อาหารทะเล)
+// ถ้ายาวเท่ากันใช้หมวดที่อยู่ก่อนในรายการนี้
+var aisleKeywords = []struct {
+	Category string
+	Keywords []string
+}{
+	{AisleFrozen, []string{"frozen", "ice cream", "แช่แข็ง", "ไอศกรีม"}},
+	{AisleSpices, []string{
+		"fish sauce", "soy sauce", "oyster sauce", "sauce", "salt", "pepper", "sugar", "vinegar", "curry paste", "spice", "cinnamon", "paprika", "cumin",
+		"น้ำปลา", "ซีอิ๊ว", "ซอส", "น้ำมันหอย", "เกลือ", "น้ำตาล", "พริกไทย", "น้ำส้มสายชู", "พริกแกง", "กะปิ", "ผงปรุงรส",
+	}},
+	{AislePantry, []string{
+		"coconut milk", "peanut butter", "rice", "noodle", "pasta", "spaghetti", "flour", "oil", "canned", "bean", "stock", "bouillon", "oat",
+		"กะทิ", "ข้าว", "เส้น", "ก๋วยเตี๋ยว", "แป้ง", "น้ำมัน", "กระป๋อง", "ถั่ว", "ซุปก้อน",
+	}},
+	{AisleDairy, []string{"egg", "milk", "butter", "cheese", "cream", "yogurt", "ไข่", "นม", "เนย", "ชีส", "ครีม", "โยเกิร์ต"}},
+	{AisleSeafood, []string{
+		"shrimp", "prawn", "fish", "squid", "crab", "clam", "mussel", "salmon", "tuna",
+		"กุ้ง", "ปลา", "หมึก", "ปู", "หอยแมลงภู่", "หอยลาย", "แซลมอน", "ทูน่า",
+	}},
+	{AisleMeat, []string{
+		"chicken", "pork", "beef", "lamb", "duck", "bacon", "sausage", "ham", "mince",
+		"ไก่", "หมู", "เนื้อ", "เป็ด", "เบคอน", "ไส้กรอก", "แฮม",
+	}},
+	{AisleProduce, []string{
+		"tomato", "onion", "bell pepper", "corn", "garlic", "chili", "chilli", "lime", "lemon", "ginger", "basil", "carrot", "cabbage", "potato", "mushroom",
+		"lettuce", "cucumber", "cilantro", "coriander", "lemongrass", "apple", "banana", "mango", "vegetable",
+		"มะเขือ", "หอม", "กระเทียม", "พริก", "มะนาว", "ขิง", "ข่า", "ตะไคร้", "มะกรูด", "โหระพา", "กะเพรา", "แครอท", "กะหล่ำ",
+		"มันฝรั่ง", "ข้าวโพด", "เห็ด", "ผัก", "แตงกวา", "กล้วย", "มะม่วง",
+	}},
+	{AisleBakery, []string{"bread", "bun", "tortilla", "pastry", "baguette", "ขนมปัง"}},
+	{AisleBeverages, []string{"water", "juice", "coffee", "tea", "beer", "wine", "soda", "น้ำเปล่า", "น้ำผลไม้", "กาแฟ", "ชาเขียว", "ใบชา", "ไวน์", "เบียร์"}},
+}
+
+// AisleCategory เดาหมวดของวัตถุดิบจากชื่อ ไม่ตรงกับคำใดเลยจะเป็น AisleOther
+func AisleCategory(name string) string {
+	name = strings.ToLower(name)
+	category, longest := AisleOther, 0
+
+	for _, group := range aisleKeywords {
+		for _, keyword := range group.Keywords {
+			if len(keyword) > longest && strings.Contains(name, keyword) {
+				category, longest = group.Category, len(keyword)
+			}
+		}
+	}
+
+	return category
+}
+
+// aisleOrder ลำดับของหมวด ใช้เรียงรายการซื้อของ
+func aisleOrder(category string) int {
+	for index, value := range AisleCategories {
+		if value == category {
+			return index
+		}
+	}
+	return len(AisleCategories)
+}
@@ -26,6 +26,8 @@ type Claims struct {
 	ID        string `json:"sub" validate:"required"`
 	FirstName string `json:"given_name" validate:"required"`
 	LastName  string `json:"family_name" validate:"required"`
+	// RealmAccess role ของผู้ใช้จาก Keycloak ใช้ตรวจสิทธิ์ admin
+	RealmAccess *RealmAccess `json:"realm_access,omitempty"`
 }
 
 func (claims Claims) ChangeFormatClaims() *User {
@@ -37,3 +39,23 @@ func (claims Claims) ChangeFormatClaims() *User {
 		ImageUrl:  func(s string) *string { return &s }("https://avatar.iran.liara.run/public/boy"),
 	}
 }
+
+const AdminRole = "admin"
+
+type RealmAccess struct {
+	Roles []string `json:"roles"`
+}
+
+// IsAdmin ตรวจ realm role จาก token ของ Keycloak
+func (claims Claims) IsAdmin() bool {
+	if claims.RealmAccess == nil {
+		return false
+	}
+
+	for _, role := range claims.RealmAccess.Roles {
+		if role == AdminRole {
+			return true
+		}
+	}
+	return false
+}
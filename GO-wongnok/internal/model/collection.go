@@ -0,0 +1,75 @@
+package model
+
+import (
+	"strings"
+	"time"
+	"wongnok/internal/model/dto"
+
+	"gorm.io/gorm"
+)
+
+// DefaultCollectionName ชื่อของ collection เริ่มต้นที่ปุ่ม favorite เพิ่ม/ลบสูตรเข้าไป
+const DefaultCollectionName = "Favorites"
+
+// Collection แฟ้มเก็บสูตรอาหารที่ผู้ใช้ตั้งชื่อเองได้ เช่น "Weeknight", "Party"
+// ผู้ใช้แต่ละคนมี collection เริ่มต้น (IsDefault) หนึ่งอันซึ่งตรงกับรายการ favorite
+type Collection struct {
+	gorm.Model
+	UserID      string
+	Name        string
+	Position    int
+	IsPublic    bool
+	IsDefault   bool
+	RecipeCount int64 `gorm:"->;-:migration"` // จำนวนสูตรใน collection (นับตอนดึงรายการ)
+}
+
+func (collection Collection) FromRequest(request dto.CollectionRequest) Collection {
+	return Collection{
+		Model:     collection.Model,
+		UserID:    collection.UserID,
+		Name:      strings.TrimSpace(request.Name),
+		Position:  collection.Position,
+		IsPublic:  request.IsPublic,
+		IsDefault: collection.IsDefault,
+	}
+}
+
+// IsVisibleTo ผู้ใช้คนนี้ดู collection นี้ได้หรือไม่ (เจ้าของ หรือ collection เป็นสาธารณะ)
+func (collection Collection) IsVisibleTo(userID string) bool {
+	return collection.IsPublic || (userID != "" && collection.UserID == userID)
+}
+
+func (collection Collection) ToResponse() dto.CollectionResponse {
+	return dto.CollectionResponse{
+		ID:          collection.ID,
+		UserID:      collection.UserID,
+		Name:        collection.Name,
+		Position:    collection.Position,
+		IsPublic:    collection.IsPublic,
+		IsDefault:   collection.IsDefault,
+		RecipeCount: collection.RecipeCount,
+		CreatedAt:   collection.CreatedAt,
+		UpdatedAt:   collection.UpdatedAt,
+	}
+}
+
+type Collections []Collection
+
+func (collections Collections) ToResponse() dto.CollectionsResponse {
+	var results = make([]dto.CollectionResponse, 0)
+
+	for _, collection := range collections {
+		results = append(results, collection.ToResponse())
+	}
+
+	return dto.CollectionsResponse{
+		Results: results,
+	}
+}
+
+// CollectionItem สูตรอาหารหนึ่งรายการใน collection
+type CollectionItem struct {
+	CollectionID uint `gorm:"primaryKey"`
+	FoodRecipeID uint `gorm:"primaryKey"`
+	CreatedAt    time.Time
+}
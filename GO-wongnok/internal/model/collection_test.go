@@ -0,0 +1,64 @@
+package model_test
+
+import (
+	"testing"
+
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestCollectionFromRequest(t *testing.T) {
+	t.Run("ShouldRenameAndKeepOwnerPositionAndDefault", func(t *testing.T) {
+		collection := model.Collection{
+			Model:     gorm.Model{ID: 3},
+			UserID:    "user-1",
+			Name:      model.DefaultCollectionName,
+			Position:  2,
+			IsDefault: true,
+		}
+
+		updated := collection.FromRequest(dto.CollectionRequest{Name: "  Weeknight  ", IsPublic: true})
+
+		assert.Equal(t, uint(3), updated.ID)
+		assert.Equal(t, "Weeknight", updated.Name)
+		assert.Equal(t, "user-1", updated.UserID)
+		assert.Equal(t, 2, updated.Position)
+		assert.True(t, updated.IsPublic)
+		assert.True(t, updated.IsDefault)
+	})
+}
+
+func TestCollectionIsVisibleTo(t *testing.T) {
+	private := model.Collection{UserID: "user-1"}
+	public := model.Collection{UserID: "user-1", IsPublic: true}
+
+	t.Run("ShouldShowPrivateCollectionOnlyToOwner", func(t *testing.T) {
+		assert.True(t, private.IsVisibleTo("user-1"))
+		assert.False(t, private.IsVisibleTo("user-2"))
+		assert.False(t, private.IsVisibleTo(""))
+	})
+
+	t.Run("ShouldShowPublicCollectionToEveryone", func(t *testing.T) {
+		assert.True(t, public.IsVisibleTo("user-2"))
+		assert.True(t, public.IsVisibleTo(""))
+	})
+}
+
+func TestCollectionsToResponse(t *testing.T) {
+	t.Run("ShouldMapEveryCollection", func(t *testing.T) {
+		collections := model.Collections{
+			{Model: gorm.Model{ID: 1}, Name: model.DefaultCollectionName, IsDefault: true, RecipeCount: 4},
+			{Model: gorm.Model{ID: 2}, Name: "Party", Position: 2},
+		}
+
+		response := collections.ToResponse()
+
+		assert.Len(t, response.Results, 2)
+		assert.True(t, response.Results[0].IsDefault)
+		assert.Equal(t, int64(4), response.Results[0].RecipeCount)
+		assert.Equal(t, "Party", response.Results[1].Name)
+	})
+}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"strings"
+	"wongnok/internal/model/dto"
+
+	"gorm.io/gorm"
+)
+
+// DeletedCommentBody ข้อความที่แสดงแทนความคิดเห็นที่ถูกลบแต่ยังมีคนตอบกลับอยู่
+const DeletedCommentBody = "[deleted]"
+
+// Comment ความคิดเห็นใต้สูตรอาหาร ตอบกลับได้ชั้นเดียว (reply ของ reply ไม่ได้)
+type Comment struct {
+	gorm.Model
+	FoodRecipeID uint
+	UserID       string
+	User         User
+	ParentID     *uint
+	Body         string
+	Replies      Comments `gorm:"foreignKey:ParentID"`
+}
+
+func (comment Comment) FromRequest(request dto.CommentRequest) Comment {
+	return Comment{
+		Model:        comment.Model,
+		FoodRecipeID: comment.FoodRecipeID,
+		UserID:       comment.UserID,
+		ParentID:     request.ParentID,
+		Body:         strings.TrimSpace(request.Body),
+	}
+}
+
+// IsReply ความคิดเห็นนี้เป็นการตอบกลับหรือไม่
+func (comment Comment) IsReply() bool {
+	return comment.ParentID != nil
+}
+
+func (comment Comment) ToResponse() dto.CommentResponse {
+	response := dto.CommentResponse{
+		ID:           comment.ID,
+		FoodRecipeID: comment.FoodRecipeID,
+		ParentID:     comment.ParentID,
+		Body:         comment.Body,
+		Replies:      comment.Replies.ToResponse().Results,
+		CreatedAt:    comment.CreatedAt,
+		UpdatedAt:    comment.UpdatedAt,
+	}
+
+	// ความคิดเห็นที่ถูกลบไม่แสดงข้อความและผู้เขียน
+	if comment.DeletedAt.Valid {
+		response.Body = DeletedCommentBody
+		response.Deleted = true
+		return response
+	}
+
+	if comment.User.ID != "" {
+		user := comment.User.ToResponse()
+		response.User = &user
+	}
+
+	return response
+}
+
+type Comments []Comment
+
+func (comments Comments) ToResponse() dto.CommentsResponse {
+	var results = make([]dto.CommentResponse, 0)
+
+	for _, comment := range comments {
+		results = append(results, comment.ToResponse())
+	}
+
+	return dto.CommentsResponse{
+		Results: results,
+	}
+}
+
+const DefaultCommentLimit = 20
+
+// CommentQuery การแบ่งหน้าความคิดเห็นระดับบนสุด (reply แสดงครบทุกรายการ)
+type CommentQuery struct {
+	Page  int `form:"page" binding:"omitempty,min=1"`
+	Limit int `form:"limit" binding:"omitempty,min=1,max=50"`
+}
@@ -0,0 +1,68 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestCommentFromRequest(t *testing.T) {
+	t.Run("ShouldTrimBodyAndKeepRecipeAndAuthor", func(t *testing.T) {
+		parentID := uint(3)
+		comment := model.Comment{FoodRecipeID: 1, UserID: "user-1"}.FromRequest(dto.CommentRequest{
+			Body:     "  ใส่น้ำปลาได้ไหมครับ  ",
+			ParentID: &parentID,
+		})
+
+		assert.Equal(t, "ใส่น้ำปลาได้ไหมครับ", comment.Body)
+		assert.Equal(t, uint(1), comment.FoodRecipeID)
+		assert.Equal(t, "user-1", comment.UserID)
+		assert.True(t, comment.IsReply())
+	})
+}
+
+func TestCommentToResponse(t *testing.T) {
+	t.Run("ShouldIncludeAuthorAndReplies", func(t *testing.T) {
+		comment := model.Comment{
+			Model:        gorm.Model{ID: 1},
+			FoodRecipeID: 2,
+			UserID:       "user-1",
+			User:         model.User{ID: "user-1", FirstName: "Somchai"},
+			Body:         "Question",
+			Replies: model.Comments{
+				{Model: gorm.Model{ID: 2}, Body: "Answer", User: model.User{ID: "user-2"}},
+			},
+		}
+
+		response := comment.ToResponse()
+
+		assert.Equal(t, "Question", response.Body)
+		assert.Equal(t, "Somchai", response.User.FirstName)
+		assert.Len(t, response.Replies, 1)
+		assert.Equal(t, "Answer", response.Replies[0].Body)
+		assert.False(t, response.Deleted)
+	})
+
+	t.Run("ShouldHideBodyAndAuthorOfDeletedComment", func(t *testing.T) {
+		comment := model.Comment{
+			Model: gorm.Model{ID: 1, DeletedAt: gorm.DeletedAt{Time: time.Now(), Valid: true}},
+			User:  model.User{ID: "user-1"},
+			Body:  "Secret",
+			Replies: model.Comments{
+				{Model: gorm.Model{ID: 2}, Body: "Still visible"},
+			},
+		}
+
+		response := comment.ToResponse()
+
+		assert.Equal(t, model.DeletedCommentBody, response.Body)
+		assert.True(t, response.Deleted)
+		assert.Nil(t, response.User)
+		assert.Len(t, response.Replies, 1)
+	})
+}
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"strings"
+	"time"
+	"wongnok/internal/model/dto"
+
+	"gorm.io/gorm"
+)
+
+const CookedOnLayout = "2006-01-02"
+
+// CookLog บันทึกว่าผู้ใช้ได้ลองทำสูตรนี้แล้ว
+// Notes เป็นบันทึกส่วนตัว แสดงเฉพาะกับเจ้าของบันทึก
+type CookLog struct {
+	gorm.Model
+	FoodRecipeID  uint
+	FoodRecipe    FoodRecipe
+	UserID        string
+	User          User
+	CookedOn      time.Time  `gorm:"type:date"`
+	PhotoURLs     StringList `gorm:"column:photo_urls;type:jsonb"`
+	Notes         string
+	Modifications string
+}
+
+// FromRequest request ผ่าน validator มาแล้ว วันที่จึง parse ได้เสมอ ถ้าไม่ส่งวันที่มาจะใช้วันนี้
+func (cookLog CookLog) FromRequest(request dto.CookLogRequest) CookLog {
+	cookedOn, err := time.Parse(CookedOnLayout, request.CookedOn)
+	if err != nil {
+		now := time.Now()
+		cookedOn = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	}
+
+	return CookLog{
+		Model:         cookLog.Model,
+		FoodRecipeID:  cookLog.FoodRecipeID,
+		UserID:        cookLog.UserID,
+		CookedOn:      cookedOn,
+		PhotoURLs:     StringList(request.PhotoURLs),
+		Notes:         strings.TrimSpace(request.Notes),
+		Modifications: strings.TrimSpace(request.Modifications),
+	}
+}
+
+// ToResponse แสดง Notes เฉพาะเมื่อผู้ดูเป็นเจ้าของบันทึก
+func (cookLog CookLog) ToResponse(viewerID string) dto.CookLogResponse {
+	response := dto.CookLogResponse{
+		ID:            cookLog.ID,
+		FoodRecipeID:  cookLog.FoodRecipeID,
+		UserID:        cookLog.UserID,
+		CookedOn:      cookLog.CookedOn.Format(CookedOnLayout),
+		PhotoURLs:     cookLog.PhotoURLs,
+		Modifications: cookLog.Modifications,
+		CreatedAt:     cookLog.CreatedAt,
+		UpdatedAt:     cookLog.UpdatedAt,
+	}
+
+	if viewerID != "" && viewerID == cookLog.UserID {
+		response.Notes = cookLog.Notes
+	}
+
+	if cookLog.FoodRecipe.ID != 0 {
+		response.FoodRecipe = &dto.CookLogRecipeResponse{
+			ID:       cookLog.FoodRecipe.ID,
+			Name:     cookLog.FoodRecipe.Name,
+			ImageURL: cookLog.FoodRecipe.ImageURL,
+		}
+	}
+
+	if cookLog.User.ID != "" {
+		user := cookLog.User.ToResponse()
+		response.User = &user
+	}
+
+	return response
+}
+
+type CookLogs []CookLog
+
+func (cookLogs CookLogs) ToResponse(viewerID string) dto.CookLogsResponse {
+	var results = make([]dto.CookLogResponse, 0)
+
+	for _, cookLog := range cookLogs {
+		results = append(results, cookLog.ToResponse(viewerID))
+	}
+
+	return dto.CookLogsResponse{
+		Results: results,
+	}
+}
+
+const DefaultCookLogLimit = 10
+
+// CookLogQuery การแบ่งหน้าบันทึกการทำอาหาร (ล่าสุดก่อน)
+type CookLogQuery struct {
+	Page  int `form:"page" binding:"omitempty,min=1"`
+	Limit int `form:"limit" binding:"omitempty,min=1,max=50"`
+}
+
+// RecipeCookCount จำนวนครั้งที่มีคนบันทึกว่าทำสูตรนี้ (ใช้ใน SELECT ของ food_recipes)
+const RecipeCookCount = "(SELECT COUNT(*) FROM cook_logs WHERE cook_logs.food_recipe_id = food_recipes.id AND cook_logs.deleted_at IS NULL)"
@@ -0,0 +1,59 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestCookLogFromRequest(t *testing.T) {
+	t.Run("ShouldParseCookedOn", func(t *testing.T) {
+		cookLog := model.CookLog{FoodRecipeID: 1, UserID: "user-1"}.FromRequest(dto.CookLogRequest{
+			CookedOn:      "2026-10-01",
+			PhotoURLs:     []string{"https://example.com/a.jpg"},
+			Notes:         "  ลดน้ำตาลลงครึ่งหนึ่ง  ",
+			Modifications: "ใช้ไก่แทนหมู",
+		})
+
+		assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), cookLog.CookedOn)
+		assert.Equal(t, "ลดน้ำตาลลงครึ่งหนึ่ง", cookLog.Notes)
+		assert.Equal(t, "ใช้ไก่แทนหมู", cookLog.Modifications)
+		assert.Equal(t, uint(1), cookLog.FoodRecipeID)
+		assert.Equal(t, "user-1", cookLog.UserID)
+	})
+
+	t.Run("ShouldDefaultToToday", func(t *testing.T) {
+		cookLog := model.CookLog{}.FromRequest(dto.CookLogRequest{})
+
+		assert.Equal(t, time.Now().Format(model.CookedOnLayout), cookLog.CookedOn.Format(model.CookedOnLayout))
+	})
+}
+
+func TestCookLogToResponse(t *testing.T) {
+	cookLog := model.CookLog{
+		Model:        gorm.Model{ID: 1},
+		FoodRecipeID: 2,
+		FoodRecipe:   model.FoodRecipe{Model: gorm.Model{ID: 2}, Name: "Omlet"},
+		UserID:       "user-1",
+		CookedOn:     time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		Notes:        "private",
+	}
+
+	t.Run("ShouldShowNotesToOwner", func(t *testing.T) {
+		response := cookLog.ToResponse("user-1")
+
+		assert.Equal(t, "private", response.Notes)
+		assert.Equal(t, "2026-10-01", response.CookedOn)
+		assert.Equal(t, "Omlet", response.FoodRecipe.Name)
+	})
+
+	t.Run("ShouldHideNotesFromOthers", func(t *testing.T) {
+		assert.Empty(t, cookLog.ToResponse("user-2").Notes)
+		assert.Empty(t, cookLog.ToResponse("").Notes)
+	})
+}
@@ -0,0 +1,237 @@
+package model
+
+import (
+	"sort"
+	"strings"
+	"wongnok/internal/global"
+
+	"github.com/pkg/errors"
+)
+
+// สารก่อภูมิแพ้ที่ตรวจจากวัตถุดิบ เรียงตามลำดับใน Allergens
+const (
+	AllergenPeanut    = "peanut"
+	AllergenTreeNut   = "tree-nut"
+	AllergenShellfish = "shellfish"
+	AllergenFish      = "fish"
+	AllergenGluten    = "gluten"
+	AllergenDairy     = "dairy"
+	AllergenEgg       = "egg"
+	AllergenSoy       = "soy"
+	AllergenSesame    = "sesame"
+)
+
+var Allergens = []string{
+	AllergenPeanut, AllergenTreeNut, AllergenShellfish, AllergenFish, AllergenGluten,
+	AllergenDairy, AllergenEgg, AllergenSoy, AllergenSesame,
+}
+
+// รูปแบบการกินที่สูตรเข้ากันได้ halal-friendly หมายถึงไม่มีหมูและแอลกอฮอล์ ไม่ได้รับรองฮาลาล
+const (
+	DietVegetarian = "vegetarian"
+	DietVegan      = "vegan"
+	DietHalal      = "halal-friendly"
+	DietNoPork     = "no-pork"
+)
+
+var Diets = []string{DietVegetarian, DietVegan, DietHalal, DietNoPork}
+
+// allergenKeywords คำที่ใช้เดาสารก่อภูมิแพ้จากชื่อวัตถุดิบที่ยังไม่ได้ผูกกับพจนานุกรม
+// กลุ่มแรก (nil) คือคำที่ดูเหมือนแต่ไม่ใช่สารก่อภูมิแพ้ มีไว้กันคำที่สั้นกว่า เช่น "coconut milk" กัน "milk"
+var allergenKeywords = []struct {
+	Allergens []string
+	Keywords  []string
+}{
+	{nil, []string{"coconut milk", "coconut cream", "eggplant", "nutmeg", "กะทิ", "มะเขือ"}},
+	{[]string{AllergenPeanut}, []string{"peanut", "peanut butter", "ถั่วลิสง", "เนยถั่ว"}},
+	{[]string{AllergenTreeNut}, []string{"cashew", "almond", "walnut", "pistachio", "hazelnut", "pecan", "มะม่วงหิมพานต์", "อัลมอนด์", "วอลนัท"}},
+	{[]string{AllergenShellfish}, []string{
+		"shrimp", "prawn", "crab", "oyster", "squid", "mussel", "clam", "scallop", "lobster",
+		"กุ้ง", "ปู", "หอย", "หมึก", "กะปิ",
+	}},
+	{[]string{AllergenFish}, []string{"fish", "anchovy", "salmon", "tuna", "ปลา", "แซลมอน", "ทูน่า"}},
+	{[]string{AllergenGluten}, []string{
+		"wheat", "flour", "bread", "pasta", "spaghetti", "breadcrumb", "beer",
+		"แป้งสาลี", "ขนมปัง", "บะหมี่", "เบียร์",
+	}},
+	{[]string{AllergenGluten, AllergenEgg}, []string{"egg noodle"}},
+	{[]string{AllergenSoy, AllergenGluten}, []string{"soy sauce", "ซีอิ๊ว", "ซอสปรุงรส"}},
+	{[]string{AllergenDairy}, []string{"milk", "butter", "cheese", "cream", "yogurt", "นม", "เนย", "ชีส", "ครีม", "โยเกิร์ต"}},
+	{[]string{AllergenEgg}, []string{"egg", "mayonnaise", "ไข่", "มายองเนส"}},
+	{[]string{AllergenSoy}, []string{"tofu", "soy", "soybean", "miso", "edamame", "เต้าหู้", "ถั่วเหลือง", "เต้าเจี้ยว", "นมถั่วเหลือง"}},
+	{[]string{AllergenSesame}, []string{"sesame", "tahini", "งา"}},
+}
+
+type allergenKeyword struct {
+	Keyword   string
+	Allergens []string
+}
+
+// allergenKeywordsByLength คำทั้งหมดใน allergenKeywords เรียงจากยาวไปสั้น
+var allergenKeywordsByLength = sortAllergenKeywords()
+
+func sortAllergenKeywords() []allergenKeyword {
+	keywords := make([]allergenKeyword, 0)
+	for _, group := range allergenKeywords {
+		for _, keyword := range group.Keywords {
+			keywords = append(keywords, allergenKeyword{Keyword: keyword, Allergens: group.Allergens})
+		}
+	}
+
+	sort.SliceStable(keywords, func(i, j int) bool {
+		return len(keywords[i].Keyword) > len(keywords[j].Keyword)
+	})
+
+	return keywords
+}
+
+// GuessAllergens เดาสารก่อภูมิแพ้จากชื่อวัตถุดิบ รวมทุกกลุ่มที่พบ เช่น "บะหมี่ไข่" ได้ทั้ง gluten และ egg
+// ไล่จากคำที่ยาวก่อนแล้วปิดช่วงที่เจอไว้ คำที่สั้นกว่าจึงนับซ้ำในช่วงนั้นไม่ได้
+// เช่น "eggplant" ไม่มี egg และ "นมถั่วเหลือง" เป็น soy อย่างเดียว (ตรงกับ migration add_allergens_and_diets)
+func GuessAllergens(name string) []string {
+	name = strings.ToLower(name)
+	var allergens []string
+
+	for _, match := range allergenKeywordsByLength {
+		if !strings.Contains(name, match.Keyword) {
+			continue
+		}
+		allergens = append(allergens, match.Allergens...)
+		name = strings.ReplaceAll(name, match.Keyword, "|")
+	}
+
+	if len(allergens) == 0 {
+		return nil
+	}
+
+	return NormalizeAllergens(allergens)
+}
+
+// Dietary สารก่อภูมิแพ้และรูปแบบการกินของสูตร เก็บเป็นคอลัมน์ allergens/diets ใน food_recipes
+type Dietary struct {
+	Allergens StringList
+	Diets     StringList
+}
+
+// DeriveDietary รวมข้อมูลจากวัตถุดิบในพจนานุกรม (catalogue คือวัตถุดิบที่ผูกไว้ตาม id)
+// วัตถุดิบที่ยังไม่ได้ผูกจะเดาสารก่อภูมิแพ้จากชื่อ และทำให้ไม่ระบุรูปแบบการกินใด ๆ
+// เพราะไม่รู้ว่ามีเนื้อสัตว์หรือไม่ ผู้เขียนสูตรกำหนดเองได้ผ่าน DietaryOverride
+func DeriveDietary(ingredients RecipeIngredients, catalogue map[uint]Ingredient) Dietary {
+	allergens := make([]string, 0)
+	excluded := make(StringList, 0)
+	known := len(ingredients) > 0
+
+	for _, ingredient := range ingredients {
+		linked, ok := Ingredient{}, false
+		if ingredient.IngredientID != nil {
+			linked, ok = catalogue[*ingredient.IngredientID]
+		}
+
+		if !ok {
+			allergens = append(allergens, GuessAllergens(ingredient.Name)...)
+			known = false
+			continue
+		}
+
+		allergens = append(allergens, linked.Allergens...)
+		excluded = append(excluded, linked.ExcludedDiets...)
+	}
+
+	diets := make(StringList, 0)
+	if known {
+		excluded = NormalizeExcludedDiets(excluded)
+		for _, diet := range Diets {
+			if !containsString(excluded, diet) {
+				diets = append(diets, diet)
+			}
+		}
+	}
+
+	return Dietary{
+		Allergens: NormalizeAllergens(allergens),
+		Diets:     diets,
+	}
+}
+
+// Columns ค่าสำหรับ Updates แบบ map เพราะ Updates แบบ struct จะข้ามค่าศูนย์
+func (dietary Dietary) Columns() map[string]interface{} {
+	return map[string]interface{}{
+		"allergens": dietary.Allergens,
+		"diets":     dietary.Diets,
+	}
+}
+
+// NormalizeAllergens ตัดค่าที่ไม่รู้จักและค่าซ้ำ แล้วเรียงตามลำดับใน Allergens
+func NormalizeAllergens(values []string) StringList {
+	return normalizeValues(values, Allergens)
+}
+
+// NormalizeDiets ใช้กับรายการรูปแบบที่เข้ากันได้ วีแกนถือเป็นมังสวิรัติ และมังสวิรัติ/ฮาลาลถือว่าไม่มีหมู
+func NormalizeDiets(values []string) StringList {
+	if containsString(values, DietVegan) {
+		values = append(values, DietVegetarian)
+	}
+	if containsString(values, DietVegetarian) || containsString(values, DietHalal) {
+		values = append(values, DietNoPork)
+	}
+	return normalizeValues(values, Diets)
+}
+
+// NormalizeExcludedDiets ใช้กับรายการรูปแบบที่ไม่เข้ากัน (Ingredient.ExcludedDiets)
+// ไม่เหมาะกับมังสวิรัติจะไม่เหมาะกับวีแกนด้วย และวัตถุดิบที่มีหมูไม่เหมาะกับทุกแบบ
+func NormalizeExcludedDiets(values []string) StringList {
+	if containsString(values, DietNoPork) {
+		values = append(values, Diets...)
+	}
+	if containsString(values, DietVegetarian) {
+		values = append(values, DietVegan)
+	}
+	return normalizeValues(values, Diets)
+}
+
+func normalizeValues(values []string, known []string) StringList {
+	normalized := make(StringList, 0, len(values))
+	for _, value := range known {
+		if containsString(values, value) {
+			normalized = append(normalized, value)
+		}
+	}
+	return normalized
+}
+
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if strings.EqualFold(strings.TrimSpace(value), target) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitDietary แยกค่าจาก query ที่คั่นด้วย comma เช่น exclude_allergens=peanut,shellfish
+// ค่าที่ไม่รู้จักคืน ErrInvalidDietary เพราะถ้าข้ามไปเฉย ๆ ผู้แพ้อาหารจะได้ผลลัพธ์ที่ไม่ได้กรอง
+func splitDietary(query string, known []string) (StringList, error) {
+	values := make([]string, 0)
+	for _, value := range strings.Split(query, ",") {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value == "" {
+			continue
+		}
+		if !containsString(known, value) {
+			return nil, errors.Wrap(global.ErrInvalidDietary, value)
+		}
+		values = append(values, value)
+	}
+
+	return normalizeValues(values, known), nil
+}
+
+// ExcludedAllergens สารก่อภูมิแพ้ที่ต้องไม่มีในสูตร
+func (query FoodRecipeQuery) ExcludedAllergens() (StringList, error) {
+	return splitDietary(query.ExcludeAllergens, Allergens)
+}
+
+// DietList รูปแบบการกินที่สูตรต้องเข้ากันได้ทุกแบบ
+func (query FoodRecipeQuery) DietList() (StringList, error) {
+	return splitDietary(query.Diet, Diets)
+}
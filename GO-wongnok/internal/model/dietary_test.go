@@ -0,0 +1,114 @@
+package model_test
+
+import (
+	"testing"
+
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestGuessAllergens(t *testing.T) {
+	cases := map[string][]string{
+		"Peanut butter":             {model.AllergenPeanut},
+		"coconut milk":              nil,
+		"ซีอิ๊วขาว":                 {model.AllergenGluten, model.AllergenSoy},
+		"น้ำปลา":                    {model.AllergenFish},
+		"นมถั่วเหลือง":              {model.AllergenSoy},
+		"Eggplant":                  nil,
+		"egg noodles":               {model.AllergenGluten, model.AllergenEgg},
+		"บะหมี่ไข่":                 {model.AllergenGluten, model.AllergenEgg},
+		"shrimp and egg fried rice": {model.AllergenShellfish, model.AllergenEgg},
+		"milk bread":                {model.AllergenGluten, model.AllergenDairy},
+		"cheese bread":              {model.AllergenGluten, model.AllergenDairy},
+		"eggplant with egg":         {model.AllergenEgg},
+	}
+
+	for name, expected := range cases {
+		assert.Equal(t, expected, model.GuessAllergens(name), name)
+	}
+}
+
+func TestDeriveDietary(t *testing.T) {
+	catalogue := map[uint]model.Ingredient{
+		1: {Model: gorm.Model{ID: 1}, Name: "egg", Allergens: model.StringList{model.AllergenEgg}, ExcludedDiets: model.StringList{model.DietVegan}},
+		2: {Model: gorm.Model{ID: 2}, Name: "garlic"},
+		3: {Model: gorm.Model{ID: 3}, Name: "pork", ExcludedDiets: model.StringList{model.DietNoPork}},
+	}
+
+	t.Run("all linked", func(t *testing.T) {
+		dietary := model.DeriveDietary(model.RecipeIngredients{
+			{Name: "Eggs", IngredientID: linked(1)},
+			{Name: "garlic", IngredientID: linked(2)},
+		}, catalogue)
+
+		assert.Equal(t, model.StringList{model.AllergenEgg}, dietary.Allergens)
+		assert.Equal(t, model.StringList{model.DietVegetarian, model.DietHalal, model.DietNoPork}, dietary.Diets)
+	})
+
+	t.Run("pork rules out every diet", func(t *testing.T) {
+		dietary := model.DeriveDietary(model.RecipeIngredients{
+			{Name: "pork", IngredientID: linked(3)},
+		}, catalogue)
+
+		assert.Empty(t, dietary.Diets)
+	})
+
+	t.Run("unlinked ingredient", func(t *testing.T) {
+		dietary := model.DeriveDietary(model.RecipeIngredients{
+			{Name: "garlic", IngredientID: linked(2)},
+			{Name: "กุ้งแห้ง"},
+			{Name: "peanuts"},
+		}, catalogue)
+
+		assert.Equal(t, model.StringList{model.AllergenPeanut, model.AllergenShellfish}, dietary.Allergens)
+		assert.Empty(t, dietary.Diets)
+	})
+}
+
+func TestNormalizeDiets(t *testing.T) {
+	assert.Equal(t, model.StringList{model.DietVegetarian, model.DietVegan, model.DietNoPork}, model.NormalizeDiets([]string{"vegan"}))
+	assert.Equal(t, model.StringList{model.DietHalal, model.DietNoPork}, model.NormalizeDiets([]string{"halal-friendly", "unknown"}))
+	assert.Equal(t, model.StringList{model.DietVegetarian, model.DietVegan}, model.NormalizeExcludedDiets([]string{"vegetarian"}))
+}
+
+func TestFoodRecipeFromRequestDietaryOverride(t *testing.T) {
+	existing := model.FoodRecipe{
+		Dietary: model.Dietary{Allergens: model.StringList{model.AllergenEgg}, Diets: model.StringList{model.DietVegetarian}},
+	}
+	override := true
+
+	recipe := existing.FromRequest(dto.FoodRecipeRequest{
+		Allergens:       []string{"egg", "dairy"},
+		Diets:           []string{"vegan"},
+		DietaryOverride: &override,
+	}, model.Claims{})
+
+	assert.True(t, recipe.DietaryOverride)
+	assert.Equal(t, model.StringList{model.AllergenDairy, model.AllergenEgg}, recipe.Dietary.Allergens)
+	assert.Equal(t, model.StringList{model.DietVegetarian, model.DietVegan, model.DietNoPork}, recipe.Dietary.Diets)
+
+	// ไม่ override จะไม่ใช้ค่าที่ส่งมา (service คำนวณใหม่จากวัตถุดิบ)
+	recipe = existing.FromRequest(dto.FoodRecipeRequest{Allergens: []string{"peanut"}}, model.Claims{})
+
+	assert.False(t, recipe.DietaryOverride)
+	assert.Equal(t, existing.Dietary, recipe.Dietary)
+}
+
+func TestFoodRecipeQueryDietaryFilters(t *testing.T) {
+	query := model.FoodRecipeQuery{ExcludeAllergens: "Shellfish, peanut,,peanut", Diet: "vegan"}
+
+	allergens, err := query.ExcludedAllergens()
+	assert.NoError(t, err)
+	assert.Equal(t, model.StringList{model.AllergenPeanut, model.AllergenShellfish}, allergens)
+
+	diets, err := query.DietList()
+	assert.NoError(t, err)
+	assert.Equal(t, model.StringList{model.DietVegan}, diets)
+
+	_, err = model.FoodRecipeQuery{Diet: "keto"}.DietList()
+	assert.ErrorIs(t, err, global.ErrInvalidDietary)
+}
@@ -0,0 +1,32 @@
+package dto
+
+import "time"
+
+type CollectionRequest struct {
+	Name     string `validate:"required,max=100"`
+	IsPublic bool
+}
+
+// CollectionOrderRequest id ของ collection เรียงตามลำดับที่ต้องการ
+type CollectionOrderRequest struct {
+	IDs []uint `validate:"required,min=1,dive,gt=0"`
+}
+
+type CollectionItemRequest struct {
+	FoodRecipeID uint `validate:"required,gt=0"`
+}
+
+type CollectionResponse struct {
+	ID          uint                 `json:"id"`
+	UserID      string               `json:"userID"`
+	Name        string               `json:"name"`
+	Position    int                  `json:"position"`
+	IsPublic    bool                 `json:"isPublic"`
+	IsDefault   bool                 `json:"isDefault"`
+	RecipeCount int64                `json:"recipeCount"`
+	Recipes     []FoodRecipeResponse `json:"recipes,omitempty"`
+	CreatedAt   time.Time            `json:"createdAt"`
+	UpdatedAt   time.Time            `json:"updatedAt"`
+}
+
+type CollectionsResponse BaseListResponse[[]CollectionResponse]
@@ -0,0 +1,22 @@
+package dto
+
+import "time"
+
+type CommentRequest struct {
+	Body     string `validate:"required,max=2000"`
+	ParentID *uint  `validate:"omitempty,gt=0"` // ตอบกลับความคิดเห็นนี้ (ใช้เฉพาะตอนสร้าง)
+}
+
+type CommentResponse struct {
+	ID           uint              `json:"id"`
+	FoodRecipeID uint              `json:"foodRecipeID"`
+	ParentID     *uint             `json:"parentID,omitempty"`
+	Body         string            `json:"body"`
+	Deleted      bool              `json:"deleted,omitempty"`
+	User         *UserResponse     `json:"user,omitempty"`
+	Replies      []CommentResponse `json:"replies,omitempty"`
+	CreatedAt    time.Time         `json:"createdAt"`
+	UpdatedAt    time.Time         `json:"updatedAt"`
+}
+
+type CommentsResponse BaseListResponse[[]CommentResponse]
@@ -0,0 +1,32 @@
+package dto
+
+import "time"
+
+type CookLogRequest struct {
+	CookedOn      string   `validate:"omitempty,datetime=2006-01-02"` // วันที่ทำ (ไม่ส่งมา = วันนี้)
+	PhotoURLs     []string `validate:"omitempty,max=10,dive,url"`
+	Notes         string   `validate:"omitempty,max=5000"` // บันทึกส่วนตัว เห็นเฉพาะเจ้าของ
+	Modifications string   `validate:"omitempty,max=2000"` // สิ่งที่ปรับจากสูตร
+}
+
+type CookLogRecipeResponse struct {
+	ID       uint    `json:"id"`
+	Name     string  `json:"name"`
+	ImageURL *string `json:"imageUrl,omitempty"`
+}
+
+type CookLogResponse struct {
+	ID            uint                   `json:"id"`
+	FoodRecipeID  uint                   `json:"foodRecipeID"`
+	FoodRecipe    *CookLogRecipeResponse `json:"foodRecipe,omitempty"`
+	UserID        string                 `json:"userID"`
+	User          *UserResponse          `json:"user,omitempty"`
+	CookedOn      string                 `json:"cookedOn"`
+	PhotoURLs     []string               `json:"photoUrls,omitempty"`
+	Notes         string                 `json:"notes,omitempty"`
+	Modifications string                 `json:"modifications,omitempty"`
+	CreatedAt     time.Time              `json:"createdAt"`
+	UpdatedAt     time.Time              `json:"updatedAt"`
+}
+
+type CookLogsResponse BaseListResponse[[]CookLogResponse]
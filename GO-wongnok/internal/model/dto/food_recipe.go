@@ -3,30 +3,74 @@ package dto
 import "time"
 
 type FoodRecipeRequest struct {
-	Name              string  `validate:"required"`
-	Description       string  `validate:"required"`
-	Ingredient        string  `validate:"required"`
-	Instruction       string  `validate:"required"`
-	ImageURL          *string `validate:"omitempty,url"`
-	CookingDurationID uint    `validate:"required,oneof=1 2 3 4"`
-	DifficultyID      uint    `validate:"required,oneof=1 2 3"`
+	Name              string                    `validate:"required"`
+	Description       string                    `validate:"required"`
+	Ingredient        string                    `validate:"required_without=Ingredients"`
+	Ingredients       []RecipeIngredientRequest `validate:"omitempty,min=1,dive"`
+	Instruction       string                    `validate:"required_without=Steps"`
+	Steps             []RecipeStepRequest       `validate:"omitempty,min=1,dive"`
+	Servings          int                       `validate:"omitempty,min=1,max=100"`
+	TagIDs            []uint                    `validate:"omitempty,dive,gt=0"`
+	Allergens         []string                  `validate:"omitempty,dive,oneof=peanut tree-nut shellfish fish gluten dairy egg soy sesame"`
+	Diets             []string                  `validate:"omitempty,dive,oneof=vegetarian vegan halal-friendly no-pork"`
+	DietaryOverride   *bool                     // true = ใช้ Allergens/Diets ที่ส่งมา, false = คำนวณจากวัตถุดิบ
+	ImageURL          *string                   `validate:"omitempty,url"`
+	CookingDurationID uint                      `validate:"required,oneof=1 2 3 4"`
+	DifficultyID      uint                      `validate:"required,oneof=1 2 3"`
 }
 
 type FoodRecipeResponse struct {
-	ID              uint                    `json:"id"`
-	Name            string                  `json:"name"`
-	Description     string                  `json:"description"`
-	Ingredient      string                  `json:"ingredient"`
-	Instruction     string                  `json:"instruction"`
-	ImageURL        *string                 `json:"imageUrl,omitempty"`
-	CookingDuration CookingDurationResponse `json:"cookingDuration"`
-	Difficulty      DifficultyResponse      `json:"difficulty"`
-	Favorite        FavoriteResponse        `json:"favorite"`
-	Rating          RatingResponse          `json:"rating"`
-	CreatedAt       time.Time               `json:"createdAt"`
-	UpdatedAt       time.Time               `json:"updatedAt"`
-	AverageRating   float64                 `json:"averageRating"`
-	User            UserResponse            `json:"user"`
+	ID               uint                       `json:"id"`
+	Name             string                     `json:"name"`
+	Description      string                     `json:"description"`
+	Ingredient       string                     `json:"ingredient"`
+	Ingredients      []RecipeIngredientResponse `json:"ingredients"`
+	Instruction      string                     `json:"instruction"`
+	Steps            []RecipeStepResponse       `json:"steps"`
+	Servings         int                        `json:"servings"`
+	OriginalServings int                        `json:"originalServings,omitempty"`
+	ImageURL         *string                    `json:"imageUrl,omitempty"`
+	CookingDuration  CookingDurationResponse    `json:"cookingDuration"`
+	Difficulty       DifficultyResponse         `json:"difficulty"`
+	Favorite         FavoriteResponse           `json:"favorite"`
+	Rating           RatingResponse             `json:"rating"`
+	Tags             []TagResponse              `json:"tags"`
+	CreatedAt        time.Time                  `json:"createdAt"`
+	UpdatedAt        time.Time                  `json:"updatedAt"`
+	AverageRating    float64                    `json:"averageRating"`
+	BayesianRating   float64                    `json:"bayesianRating,omitempty"`
+	RatingCount      int                        `json:"ratingCount"`
+	RatingHistogram  *RatingHistogramResponse   `json:"ratingHistogram,omitempty"`
+	CookCount        int64                      `json:"cookCount"`
+	Nutrition        *NutritionResponse         `json:"nutrition,omitempty"`
+	Allergens        []string                   `json:"allergens"`
+	Diets            []string                   `json:"diets"`
+	DietaryOverride  bool                       `json:"dietaryOverride"`
+	User             UserResponse               `json:"user"`
 }
 
-type FoodRecipesResponse BaseListResponse[[]FoodRecipeResponse]
+// FoodRecipeSummaryResponse ข้อมูลย่อของสูตร ใช้แสดงคู่กับแผนมื้ออาหาร
+type FoodRecipeSummaryResponse struct {
+	ID       uint    `json:"id"`
+	Name     string  `json:"name"`
+	ImageURL *string `json:"imageUrl,omitempty"`
+	Servings int     `json:"servings"`
+}
+
+type FoodRecipesResponse struct {
+	Total      int64                     `json:"total,omitempty"`
+	Results    []FoodRecipeResponse      `json:"results"`
+	Facets     *FoodRecipeFacetsResponse `json:"facets,omitempty"`
+	NextCursor string                    `json:"nextCursor,omitempty"`
+}
+
+type FacetCountResponse struct {
+	ID    uint   `json:"id"`
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+type FoodRecipeFacetsResponse struct {
+	Difficulties     []FacetCountResponse `json:"difficulties"`
+	CookingDurations []FacetCountResponse `json:"cookingDurations"`
+}
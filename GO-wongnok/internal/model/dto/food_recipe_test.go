@@ -40,6 +40,36 @@ func TestFoodRecipeRequest_Validation(t *testing.T) {
 			expectErr: true,
 			errFields: []string{"Name", "Description", "Ingredient", "Instruction"},
 		},
+		{
+			name: "Valid request with structured ingredients only",
+			request: FoodRecipeRequest{
+				Name:        "Pad Thai",
+				Description: "Traditional Thai noodle dish",
+				Ingredients: []RecipeIngredientRequest{
+					{Name: "Rice noodles", Quantity: float64Ptr(200), Unit: "g"},
+					{Name: "Fish sauce", Group: "sauce"},
+				},
+				Instruction:       "Stir fry everything",
+				CookingDurationID: 2,
+				DifficultyID:      1,
+			},
+			expectErr: false,
+		},
+		{
+			name: "Invalid structured ingredient",
+			request: FoodRecipeRequest{
+				Name:        "Pad Thai",
+				Description: "Traditional Thai noodle dish",
+				Ingredients: []RecipeIngredientRequest{
+					{Name: "", Quantity: float64Ptr(-1)},
+				},
+				Instruction:       "Stir fry everything",
+				CookingDurationID: 2,
+				DifficultyID:      1,
+			},
+			expectErr: true,
+			errFields: []string{"Ingredients[0].Name", "Ingredients[0].Quantity"},
+		},
 		{
 			name: "Invalid URL",
 			request: FoodRecipeRequest{
@@ -240,3 +270,23 @@ func TestFoodRecipeRequest_JSONMarshaling(t *testing.T) {
 func stringPtr(s string) *string {
 	return &s
 }
+
+// Helper function to create float64 pointer
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
+func TestFoodRecipesResponse_OmitEmptyFacets(t *testing.T) {
+	jsonData, err := json.Marshal(FoodRecipesResponse{Total: 1, Results: []FoodRecipeResponse{}})
+	assert.NoError(t, err)
+	assert.NotContains(t, string(jsonData), "facets")
+
+	jsonData, err = json.Marshal(FoodRecipesResponse{
+		Results: []FoodRecipeResponse{},
+		Facets: &FoodRecipeFacetsResponse{
+			Difficulties: []FacetCountResponse{{ID: 1, Name: "Easy", Count: 2}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, string(jsonData), `"difficulties":[{"id":1,"name":"Easy","count":2}]`)
+}
@@ -0,0 +1,38 @@
+package dto
+
+import "time"
+
+type IngredientRequest struct {
+	Name          string   `validate:"required,max=100"`
+	NameTH        string   `validate:"omitempty,max=100"`
+	Category      string   `validate:"required,oneof=produce meat seafood dairy-eggs bakery pantry spices-condiments frozen beverages other"`
+	Synonyms      []string `validate:"omitempty,max=50,dive,required,max=100"`
+	Allergens     []string `validate:"omitempty,dive,oneof=peanut tree-nut shellfish fish gluten dairy egg soy sesame"`
+	ExcludedDiets []string `validate:"required,dive,oneof=vegetarian vegan halal-friendly no-pork"` // รูปแบบการกินที่วัตถุดิบนี้ใช้ไม่ได้ ต้องส่งเสมอ ([] คือใช้ได้ทุกแบบ) กันวัตถุดิบอย่างเบคอนถูกนับว่าเข้ากันได้ทุกแบบ
+}
+
+type IngredientResponse struct {
+	ID            uint     `json:"id"`
+	Name          string   `json:"name"`
+	NameTH        string   `json:"nameTH,omitempty"`
+	Category      string   `json:"category"`
+	Synonyms      []string `json:"synonyms"`
+	Allergens     []string `json:"allergens"`
+	ExcludedDiets []string `json:"excludedDiets"`
+}
+
+type IngredientsResponse BaseListResponse[[]IngredientResponse]
+
+// UnlinkedIngredientLinkRequest ผูกชื่อที่ยังไม่รู้จักเข้ากับวัตถุดิบในพจนานุกรม (ชื่อนั้นจะกลายเป็นคำพ้อง)
+type UnlinkedIngredientLinkRequest struct {
+	IngredientID uint `validate:"required,gt=0"`
+}
+
+type UnlinkedIngredientResponse struct {
+	ID          uint      `json:"id"`
+	Name        string    `json:"name"`
+	Occurrences int       `json:"occurrences"`
+	LastSeenAt  time.Time `json:"lastSeenAt"`
+}
+
+type UnlinkedIngredientsResponse BaseListResponse[[]UnlinkedIngredientResponse]
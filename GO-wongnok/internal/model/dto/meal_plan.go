@@ -0,0 +1,38 @@
+package dto
+
+import "time"
+
+type MealPlanEntryRequest struct {
+	Date         string `validate:"required,datetime=2006-01-02"`
+	Slot         string `validate:"required,oneof=breakfast lunch dinner snack"`
+	FoodRecipeID uint   `validate:"required,gt=0"`
+	Servings     int    `validate:"omitempty,min=1,max=100"` // ไม่ส่งมา = ใช้จำนวนที่เสิร์ฟของสูตร
+}
+
+// MealPlanCopyRequest คัดลอกแผนทั้งสัปดาห์ (วันใดก็ได้ในสัปดาห์) ไปยังอีกสัปดาห์
+type MealPlanCopyRequest struct {
+	FromWeek string `validate:"required,datetime=2006-01-02"`
+	ToWeek   string `validate:"required,datetime=2006-01-02"`
+}
+
+type MealPlanEntryResponse struct {
+	ID           uint                       `json:"id"`
+	Date         string                     `json:"date"`
+	Slot         string                     `json:"slot"`
+	FoodRecipeID uint                       `json:"foodRecipeID"`
+	FoodRecipe   *FoodRecipeSummaryResponse `json:"foodRecipe,omitempty"`
+	Servings     int                        `json:"servings"`
+	CreatedAt    time.Time                  `json:"createdAt"`
+	UpdatedAt    time.Time                  `json:"updatedAt"`
+}
+
+type MealPlanDayResponse struct {
+	Date    string                  `json:"date"`
+	Entries []MealPlanEntryResponse `json:"entries"`
+}
+
+type MealPlanWeekResponse struct {
+	StartDate string                `json:"startDate"`
+	EndDate   string                `json:"endDate"`
+	Days      []MealPlanDayResponse `json:"days"`
+}
@@ -0,0 +1,18 @@
+package dto
+
+// NutritionFactsResponse พลังงาน (kcal) โปรตีน ไขมัน คาร์โบไฮเดรต (g) และโซเดียม (mg)
+type NutritionFactsResponse struct {
+	Calories float64 `json:"calories"`
+	Protein  float64 `json:"protein"`
+	Fat      float64 `json:"fat"`
+	Carbs    float64 `json:"carbs"`
+	Sodium   float64 `json:"sodium"`
+}
+
+// NutritionResponse ค่าโดยประมาณ คำนวณจากวัตถุดิบ estimatedIngredients รายการจาก totalIngredients รายการ
+type NutritionResponse struct {
+	Total                NutritionFactsResponse `json:"total"`
+	PerServing           NutritionFactsResponse `json:"perServing"`
+	EstimatedIngredients int                    `json:"estimatedIngredients"`
+	TotalIngredients     int                    `json:"totalIngredients"`
+}
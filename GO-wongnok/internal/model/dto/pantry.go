@@ -0,0 +1,26 @@
+package dto
+
+import "time"
+
+// PantryRequest ชื่อวัตถุดิบที่มีอยู่ที่บ้าน เพิ่มทีละหลายรายการได้
+type PantryRequest struct {
+	Names []string `validate:"required,min=1,max=100,dive,required,max=100"`
+}
+
+type PantryItemResponse struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type PantryItemsResponse BaseListResponse[[]PantryItemResponse]
+
+type PantryMatchResponse struct {
+	FoodRecipe      FoodRecipeResponse `json:"foodRecipe"`
+	IngredientCount int                `json:"ingredientCount"`
+	OwnedCount      int                `json:"ownedCount"`
+	Match           float64            `json:"match"` // สัดส่วนวัตถุดิบที่มีแล้ว 0-1
+	Missing         []string           `json:"missing"`
+}
+
+type PantryMatchesResponse BaseListResponse[[]PantryMatchResponse]
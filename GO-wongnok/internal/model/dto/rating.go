@@ -1,14 +1,56 @@
 package dto
 
+import (
+	"math"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// HalfStepTag ชื่อ validation ของคะแนนแบบครึ่งดาว ต้อง register ด้วย RegisterRatingValidations ก่อนใช้
+// คะแนนอยู่ระหว่าง 1 ถึง 5 (min/max ใน tag ของ RatingRequest) และเพิ่มทีละครึ่งดาว
+const HalfStepTag = "half_step"
+
 type RatingRequest struct {
-	Score float64 `validate:"required"`
+	Score     float64  `validate:"required,min=1,max=5,half_step"`
+	Title     string   `validate:"omitempty,max=200"`
+	Body      string   `validate:"omitempty,max=5000"`
+	PhotoURLs []string `validate:"omitempty,max=10,dive,url"`
+}
+
+// IsHalfStep ตรวจว่าค่าเป็นทวีคูณของ 0.5 เช่น 3 หรือ 3.5 (4.7 ไม่ผ่าน)
+func IsHalfStep(fl validator.FieldLevel) bool {
+	return math.Mod(fl.Field().Float()*2, 1) == 0
+}
+
+func RegisterRatingValidations(validate *validator.Validate) error {
+	return validate.RegisterValidation(HalfStepTag, IsHalfStep)
 }
 
 type RatingResponse struct {
-	ID           uint    `json:"id"`
-	Score        float64 `json:"score"`
-	FoodRecipeID uint    `json:"foodRecipeID"`
-	UserID       string  `json:"userID"`
+	ID           uint          `json:"id"`
+	Score        float64       `json:"score"`
+	FoodRecipeID uint          `json:"foodRecipeID"`
+	UserID       string        `json:"userID"`
+	Title        string        `json:"title,omitempty"`
+	Body         string        `json:"body,omitempty"`
+	PhotoURLs    []string      `json:"photoUrls,omitempty"`
+	User         *UserResponse `json:"user,omitempty"`
+	HelpfulCount int64         `json:"helpfulCount"`
+	CreatedAt    time.Time     `json:"createdAt"`
+	UpdatedAt    time.Time     `json:"updatedAt"`
 }
 
 type RatingsResponse BaseListResponse[[]RatingResponse]
+
+type ReviewVoteRequest struct {
+	Helpful *bool `validate:"required"`
+}
+
+type RatingHistogramResponse struct {
+	Star1 int `json:"1"`
+	Star2 int `json:"2"`
+	Star3 int `json:"3"`
+	Star4 int `json:"4"`
+	Star5 int `json:"5"`
+}
@@ -2,6 +2,7 @@ package dto
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/go-playground/validator/v10"
@@ -10,6 +11,7 @@ import (
 
 func TestRatingRequest_Validation(t *testing.T) {
 	validate := validator.New()
+	assert.NoError(t, RegisterRatingValidations(validate))
 
 	testCases := []struct {
 		name      string
@@ -31,19 +33,78 @@ func TestRatingRequest_Validation(t *testing.T) {
 			expectErr: false,
 		},
 		{
-			name: "Valid rating - decimal score",
+			name: "Valid rating - half star",
 			request: RatingRequest{
-				Score: 4.7,
+				Score: 4.5,
 			},
 			expectErr: false,
 		},
 		{
-			name: "Valid rating - minimum positive score",
+			name: "Valid rating - minimum score",
 			request: RatingRequest{
-				Score: 0.1,
+				Score: 1,
 			},
 			expectErr: false,
 		},
+		{
+			name: "Invalid rating - not a half step",
+			request: RatingRequest{
+				Score: 4.7,
+			},
+			expectErr: true,
+		},
+		{
+			name: "Invalid rating - below minimum",
+			request: RatingRequest{
+				Score: 0.5,
+			},
+			expectErr: true,
+		},
+		{
+			name: "Invalid rating - negative score",
+			request: RatingRequest{
+				Score: -3,
+			},
+			expectErr: true,
+		},
+		{
+			name: "Invalid rating - above maximum",
+			request: RatingRequest{
+				Score: 1000,
+			},
+			expectErr: true,
+		},
+		{
+			name:      "Invalid rating - missing score",
+			request:   RatingRequest{},
+			expectErr: true,
+		},
+		{
+			name: "Valid rating - with review and photos",
+			request: RatingRequest{
+				Score:     4,
+				Title:     "Great",
+				Body:      "Easy to follow",
+				PhotoURLs: []string{"https://example.com/a.jpg"},
+			},
+			expectErr: false,
+		},
+		{
+			name: "Invalid rating - photo is not a URL",
+			request: RatingRequest{
+				Score:     4,
+				PhotoURLs: []string{"not a url"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Invalid rating - title too long",
+			request: RatingRequest{
+				Score: 4,
+				Title: strings.Repeat("a", 201),
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,20 @@
+package dto
+
+type RecipeIngredientRequest struct {
+	Name     string   `validate:"required"`
+	Quantity *float64 `validate:"omitempty,gt=0"`
+	Unit     string
+	Note     string
+	Group    string
+}
+
+type RecipeIngredientResponse struct {
+	ID           uint     `json:"id"`
+	Position     int      `json:"position"`
+	Name         string   `json:"name"`
+	Quantity     *float64 `json:"quantity,omitempty"`
+	Unit         string   `json:"unit,omitempty"`
+	Note         string   `json:"note,omitempty"`
+	Group        string   `json:"group,omitempty"`
+	IngredientID *uint    `json:"ingredientID,omitempty"` // วัตถุดิบในพจนานุกรม (ไม่มีถ้ายังไม่รู้จักชื่อนี้)
+}
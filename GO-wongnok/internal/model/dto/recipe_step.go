@@ -0,0 +1,17 @@
+package dto
+
+type RecipeStepRequest struct {
+	// ID ของขั้นตอนเดิม (ถ้ามี) ใช้ตอนแก้ไขเพื่อคงขั้นตอนเดิมไว้ ส่วนลำดับจะยึดตามลำดับใน array
+	ID              uint
+	Text            string  `validate:"required"`
+	DurationSeconds *int    `validate:"omitempty,gt=0"`
+	ImageURL        *string `validate:"omitempty,url"`
+}
+
+type RecipeStepResponse struct {
+	ID              uint    `json:"id"`
+	Position        int     `json:"position"`
+	Text            string  `json:"text"`
+	DurationSeconds *int    `json:"durationSeconds,omitempty"`
+	ImageURL        *string `json:"imageUrl,omitempty"`
+}
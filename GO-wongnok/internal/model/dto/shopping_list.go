@@ -0,0 +1,42 @@
+package dto
+
+import "time"
+
+// ShoppingListRequest สร้างรายการซื้อของจากสูตรที่เลือก หรือจากแผนมื้ออาหารในช่วงวันที่ (รวมวันสุดท้าย)
+type ShoppingListRequest struct {
+	Name      string `validate:"omitempty,max=100"`
+	RecipeIDs []uint `validate:"required_without=From,omitempty,min=1,max=50,dive,gt=0"`
+	From      string `validate:"required_without=RecipeIDs,omitempty,datetime=2006-01-02"`
+	To        string `validate:"required_with=From,omitempty,datetime=2006-01-02"`
+}
+
+// ShoppingListItemRequest ติ๊ก/ยกเลิกติ๊กของที่ซื้อแล้ว
+type ShoppingListItemRequest struct {
+	Checked *bool `validate:"required"`
+}
+
+type ShoppingListItemResponse struct {
+	ID       uint     `json:"id"`
+	Name     string   `json:"name"`
+	Quantity *float64 `json:"quantity,omitempty"`
+	Unit     string   `json:"unit,omitempty"`
+	Category string   `json:"category"`
+	Checked  bool     `json:"checked"`
+}
+
+type ShoppingListCategoryResponse struct {
+	Category string                     `json:"category"`
+	Items    []ShoppingListItemResponse `json:"items"`
+}
+
+type ShoppingListResponse struct {
+	ID           uint                           `json:"id"`
+	Name         string                         `json:"name"`
+	ItemCount    int                            `json:"itemCount"`
+	CheckedCount int                            `json:"checkedCount"`
+	Categories   []ShoppingListCategoryResponse `json:"categories"`
+	CreatedAt    time.Time                      `json:"createdAt"`
+	UpdatedAt    time.Time                      `json:"updatedAt"`
+}
+
+type ShoppingListsResponse BaseListResponse[[]ShoppingListResponse]
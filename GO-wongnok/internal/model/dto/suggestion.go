@@ -0,0 +1,10 @@
+package dto
+
+type SuggestionResponse struct {
+	Type string `json:"type"`
+	ID   uint   `json:"id,omitempty"`
+	Text string `json:"text"`
+	Slug string `json:"slug,omitempty"`
+}
+
+type SuggestionsResponse BaseListResponse[[]SuggestionResponse]
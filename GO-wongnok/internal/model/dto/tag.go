@@ -0,0 +1,16 @@
+package dto
+
+type TagRequest struct {
+	Name     string `validate:"required,max=100"`
+	Slug     string `validate:"omitempty,max=100"`
+	Category string `validate:"required,oneof=cuisine meal_type diet occasion"`
+}
+
+type TagResponse struct {
+	ID       uint   `json:"id"`
+	Name     string `json:"name"`
+	Slug     string `json:"slug"`
+	Category string `json:"category"`
+}
+
+type TagsResponse BaseListResponse[[]TagResponse]
@@ -0,0 +1,38 @@
+package model
+
+import "wongnok/internal/model/dto"
+
+// FacetCount จำนวนสูตรอาหารของตัวเลือกหนึ่งใน filter เช่น ระดับความยาก "ง่าย" มี 12 สูตร
+type FacetCount struct {
+	ID    uint
+	Name  string
+	Count int64
+}
+
+type FacetCounts []FacetCount
+
+func (facets FacetCounts) ToResponse() []dto.FacetCountResponse {
+	var results = make([]dto.FacetCountResponse, 0)
+
+	for _, facet := range facets {
+		results = append(results, dto.FacetCountResponse{
+			ID:    facet.ID,
+			Name:  facet.Name,
+			Count: facet.Count,
+		})
+	}
+
+	return results
+}
+
+type FoodRecipeFacets struct {
+	Difficulties     FacetCounts
+	CookingDurations FacetCounts
+}
+
+func (facets FoodRecipeFacets) ToResponse() *dto.FoodRecipeFacetsResponse {
+	return &dto.FoodRecipeFacetsResponse{
+		Difficulties:     facets.Difficulties.ToResponse(),
+		CookingDurations: facets.CookingDurations.ToResponse(),
+	}
+}
@@ -0,0 +1,28 @@
+package model_test
+
+import (
+	"testing"
+
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFoodRecipeFacetsToResponse(t *testing.T) {
+	t.Run("ShouldTransformFacetCounts", func(t *testing.T) {
+		facets := model.FoodRecipeFacets{
+			Difficulties: model.FacetCounts{
+				{ID: 1, Name: "Easy", Count: 3},
+				{ID: 2, Name: "Medium", Count: 0},
+			},
+		}
+
+		result := facets.ToResponse()
+
+		assert.Len(t, result.Difficulties, 2)
+		assert.Equal(t, int64(3), result.Difficulties[0].Count)
+		assert.Equal(t, "Medium", result.Difficulties[1].Name)
+		assert.NotNil(t, result.CookingDurations)
+		assert.Len(t, result.CookingDurations, 0)
+	})
+}
@@ -1,7 +1,10 @@
 package model
 
 import (
+	"strings"
+	"time"
 	"wongnok/internal/model/dto"
+	"wongnok/internal/units"
 
 	"gorm.io/gorm"
 )
@@ -11,7 +14,11 @@ type FoodRecipe struct {
 	Name              string
 	Description       string
 	Ingredient        string
+	Ingredients       RecipeIngredients
 	Instruction       string
+	Steps             RecipeSteps
+	Servings          int
+	OriginalServings  int `gorm:"-"`
 	ImageURL          *string
 	CookingDurationID uint
 	CookingDuration   CookingDuration
@@ -19,19 +26,80 @@ type FoodRecipe struct {
 	Difficulty        Difficulty
 	Rating            Rating
 	Ratings           Ratings
+	RatingCount       int
+	RatingSum         float64
+	RatingHistogram   RatingHistogram `gorm:"embedded;embeddedPrefix:rating_"`
+	CookCount         int64           `gorm:"->;-:migration"` // จำนวนบันทึกการทำ (มีเฉพาะตอนดึงรายละเอียด)
+	Nutrition         Nutrition       `gorm:"embedded;embeddedPrefix:nutrition_"`
+	Dietary           Dietary         `gorm:"embedded"`
+	DietaryOverride   bool            // ผู้เขียนกำหนดสารก่อภูมิแพ้/รูปแบบการกินเอง ไม่คำนวณจากวัตถุดิบ
 	Favorite          Favorite
+	Tags              Tags    `gorm:"many2many:food_recipe_tags;"`
 	AverageRating     float64 `gorm:"-"`
+	BayesianRating    float64 `gorm:"-"`              // คะแนนสำหรับจัดอันดับ (ดู BayesianPrior)
+	SortKey           *string `gorm:"->;-:migration"` // ค่า sort_key สำหรับสร้าง cursor (มีเฉพาะตอนดึงรายการ)
 	UserID            string
 	User              User
 }
 
 func (recipe FoodRecipe) FromRequest(request dto.FoodRecipeRequest, claims Claims) FoodRecipe {
+	// รองรับทั้ง client เดิมที่ส่งแค่ข้อความ และ client ใหม่ที่ส่งวัตถุดิบเป็นรายการ
+	ingredient := request.Ingredient
+	ingredients := RecipeIngredients{}.FromRequest(request.Ingredients)
+	if len(ingredients) == 0 {
+		ingredients = ParseIngredients(request.Ingredient)
+	} else if ingredient == "" {
+		ingredient = ingredients.String()
+	}
+
+	instruction := request.Instruction
+	steps := RecipeSteps{}.FromRequest(request.Steps)
+	if len(steps) == 0 {
+		steps = ParseSteps(request.Instruction)
+	} else if instruction == "" {
+		instruction = steps.String()
+	}
+
+	// client เดิมไม่ได้ส่ง servings มา ให้คงค่าเดิมไว้
+	servings := request.Servings
+	if servings == 0 {
+		servings = recipe.Servings
+	}
+	if servings == 0 {
+		servings = DefaultServings
+	}
+
+	// ไม่ได้ส่ง tag มา ให้คง tag เดิมไว้
+	tags := recipe.Tags
+	if request.TagIDs != nil {
+		tags = TagsFromIDs(request.TagIDs)
+	}
+
+	// ไม่ได้ส่ง dietaryOverride มา ให้คงสถานะเดิมไว้ ถ้าไม่ override จะคำนวณใหม่ตอนบันทึก
+	override := recipe.DietaryOverride
+	if request.DietaryOverride != nil {
+		override = *request.DietaryOverride
+	}
+	dietary := recipe.Dietary
+	if override && request.Allergens != nil {
+		dietary.Allergens = NormalizeAllergens(request.Allergens)
+	}
+	if override && request.Diets != nil {
+		dietary.Diets = NormalizeDiets(request.Diets)
+	}
+
 	return FoodRecipe{
 		Model:             recipe.Model,
 		Name:              request.Name,
 		Description:       request.Description,
-		Ingredient:        request.Ingredient,
-		Instruction:       request.Instruction,
+		Ingredient:        ingredient,
+		Ingredients:       ingredients,
+		Instruction:       instruction,
+		Steps:             steps,
+		Servings:          servings,
+		Tags:              tags,
+		Dietary:           dietary,
+		DietaryOverride:   override,
 		ImageURL:          request.ImageURL,
 		CookingDurationID: request.CookingDurationID,
 		DifficultyID:      request.DifficultyID,
@@ -41,12 +109,16 @@ func (recipe FoodRecipe) FromRequest(request dto.FoodRecipeRequest, claims Claim
 
 func (recipe FoodRecipe) ToResponse() dto.FoodRecipeResponse {
 	return dto.FoodRecipeResponse{
-		ID:          recipe.ID,
-		Name:        recipe.Name,
-		Description: recipe.Description,
-		Ingredient:  recipe.Ingredient,
-		Instruction: recipe.Instruction,
-		ImageURL:    recipe.ImageURL,
+		ID:               recipe.ID,
+		Name:             recipe.Name,
+		Description:      recipe.Description,
+		Ingredient:       recipe.Ingredient,
+		Ingredients:      recipe.Ingredients.ToResponse(),
+		Instruction:      recipe.Instruction,
+		Steps:            recipe.Steps.ToResponse(),
+		Servings:         recipe.Servings,
+		OriginalServings: recipe.OriginalServings,
+		ImageURL:         recipe.ImageURL,
 		CookingDuration: dto.CookingDurationResponse{
 			ID:   recipe.CookingDuration.ID,
 			Name: recipe.CookingDuration.Name,
@@ -66,11 +138,70 @@ func (recipe FoodRecipe) ToResponse() dto.FoodRecipeResponse {
 			FoodRecipeID: recipe.Rating.FoodRecipeID,
 			UserID:       recipe.Rating.UserID,
 		},
-		AverageRating: recipe.AverageRating,
-		User:          recipe.User.ToResponse(),
-		CreatedAt:     recipe.CreatedAt,
-		UpdatedAt:     recipe.UpdatedAt,
+		Tags:            recipe.Tags.ToResponse().Results,
+		AverageRating:   recipe.AverageRating,
+		BayesianRating:  recipe.BayesianRating,
+		RatingCount:     recipe.RatingCount,
+		CookCount:       recipe.CookCount,
+		Nutrition:       recipe.Nutrition.ToResponse(recipe.Servings),
+		Allergens:       NormalizeAllergens(recipe.Dietary.Allergens),
+		Diets:           NormalizeDiets(recipe.Dietary.Diets),
+		DietaryOverride: recipe.DietaryOverride,
+		User:            recipe.User.ToResponse(),
+		CreatedAt:       recipe.CreatedAt,
+		UpdatedAt:       recipe.UpdatedAt,
+	}
+}
+
+func (recipe FoodRecipe) ToSummaryResponse() dto.FoodRecipeSummaryResponse {
+	return dto.FoodRecipeSummaryResponse{
+		ID:       recipe.ID,
+		Name:     recipe.Name,
+		ImageURL: recipe.ImageURL,
+		Servings: recipe.Servings,
+	}
+}
+
+// ScaleServings ปรับปริมาณวัตถุดิบทุกตัวตามจำนวนที่เสิร์ฟที่ต้องการ
+// และสร้างข้อความวัตถุดิบใหม่จากปริมาณที่ปรับแล้ว ค่าโภชนาการรวมปรับตาม ส่วนต่อที่เสิร์ฟคงเดิม
+func (recipe FoodRecipe) ScaleServings(servings int) FoodRecipe {
+	if servings <= 0 || recipe.Servings <= 0 || servings == recipe.Servings {
+		return recipe
+	}
+
+	factor := float64(servings) / float64(recipe.Servings)
+
+	ingredients := make(RecipeIngredients, 0, len(recipe.Ingredients))
+	for _, ingredient := range recipe.Ingredients {
+		ingredients = append(ingredients, ingredient.Scale(factor))
+	}
+
+	recipe.OriginalServings = recipe.Servings
+	recipe.Servings = servings
+	recipe.Ingredients = ingredients
+	recipe.Nutrition.Total = recipe.Nutrition.Total.Scale(factor)
+	if len(ingredients) > 0 {
+		recipe.Ingredient = ingredients.String()
+	}
+
+	return recipe
+}
+
+// ConvertUnits แปลงหน่วยของวัตถุดิบทุกตัวเป็น metric หรือ imperial
+func (recipe FoodRecipe) ConvertUnits(system units.System) FoodRecipe {
+	if len(recipe.Ingredients) == 0 {
+		return recipe
 	}
+
+	ingredients := make(RecipeIngredients, 0, len(recipe.Ingredients))
+	for _, ingredient := range recipe.Ingredients {
+		ingredients = append(ingredients, ingredient.ConvertUnits(system))
+	}
+
+	recipe.Ingredients = ingredients
+	recipe.Ingredient = ingredients.String()
+
+	return recipe
 }
 
 type FoodRecipes []FoodRecipe
@@ -88,8 +219,12 @@ func (recipes FoodRecipes) ToResponse(total int64) dto.FoodRecipesResponse {
 	}
 }
 
+// CalculateAverageRating คำนวณคะแนนเฉลี่ยจากคอลัมน์สรุป rating_count/rating_sum
+// ถ้าไม่มีค่าสรุปแต่มี Ratings ที่โหลดมา จะคำนวณจากรายการแทน
 func (recipe FoodRecipe) CalculateAverageRating() FoodRecipe {
-	if len(recipe.Ratings) > 0 {
+	if recipe.RatingCount > 0 {
+		recipe.AverageRating = recipe.RatingSum / float64(recipe.RatingCount)
+	} else if len(recipe.Ratings) > 0 {
 		var totalRating float64
 		for _, rating := range recipe.Ratings {
 			totalRating += rating.Score
@@ -101,23 +236,75 @@ func (recipe FoodRecipe) CalculateAverageRating() FoodRecipe {
 	return recipe
 }
 
+// CalculateBayesianRating คำนวณคะแนนสำหรับจัดอันดับจากคอลัมน์สรุปคะแนน
+func (recipe FoodRecipe) CalculateBayesianRating(prior BayesianPrior) FoodRecipe {
+	recipe.BayesianRating = prior.Score(recipe.RatingSum, recipe.RatingCount)
+	return recipe
+}
+
+func (recipes FoodRecipes) ConvertUnits(system units.System) FoodRecipes {
+	for i, recipe := range recipes {
+		recipes[i] = recipe.ConvertUnits(system)
+	}
+	return recipes
+}
+
 func (recipes FoodRecipes) CalculateAverageRatings() FoodRecipes {
 	for i, recipe := range recipes {
-		if len(recipe.Ratings) > 0 {
-			var totalRating float64
-			for _, rating := range recipe.Ratings {
-				totalRating += rating.Score
-			}
-			recipes[i].AverageRating = totalRating / float64(len(recipe.Ratings))
-		} else {
-			recipes[i].AverageRating = 0
-		}
+		recipes[i] = recipe.CalculateAverageRating()
 	}
 	return recipes
 }
 
+func (recipes FoodRecipes) CalculateBayesianRatings(prior BayesianPrior) FoodRecipes {
+	for i, recipe := range recipes {
+		recipes[i] = recipe.CalculateBayesianRating(prior)
+	}
+	return recipes
+}
+
+const DefaultServings = 1
+
+// FoodRecipeDetailQuery ตัวเลือกตอนดึงสูตรอาหารรายการเดียว
+type FoodRecipeDetailQuery struct {
+	Servings int    `form:"servings" binding:"omitempty,min=1,max=100"`      // ปรับสัดส่วนวัตถุดิบตามจำนวนที่เสิร์ฟ
+	Units    string `form:"units" binding:"omitempty,oneof=metric imperial"` // แปลงหน่วยวัตถุดิบ
+}
+
 type FoodRecipeQuery struct {
-	Search string `form:"search"`
-	Page   int    `form:"page" binding:"required,min=1"`  // page number for pagination
-	Limit  int    `form:"limit" binding:"required,min=1"` // number of items per page
+	Search            string    `form:"search"`
+	Page              int       `form:"page" binding:"required_without=Cursor,omitempty,min=1"` // page number for pagination (ไม่ใช้เมื่อส่ง cursor)
+	Limit             int       `form:"limit" binding:"required,min=1"`                         // number of items per page
+	Units             string    `form:"units" binding:"omitempty,oneof=metric imperial"`        // แปลงหน่วยวัตถุดิบ
+	Tags              string    `form:"tags"`                                                   // slug ของ tag คั่นด้วย comma ต้องมีครบทุก tag
+	DifficultyID      uint      `form:"difficulty" binding:"omitempty,oneof=1 2 3"`             // ระดับความยาก
+	CookingDurationID uint      `form:"cookingDuration" binding:"omitempty,oneof=1 2 3 4"`      // ระยะเวลาทำ
+	Author            string    `form:"author"`                                                 // user id ของผู้สร้างสูตร
+	MinRating         float64   `form:"minRating" binding:"omitempty,min=0,max=5"`              // คะแนนเฉลี่ยขั้นต่ำ
+	CreatedAfter      time.Time `form:"createdAfter" time_format:"2006-01-02"`                  // สร้างตั้งแต่วันที่นี้ (รวมวันนั้น)
+	CreatedBefore     time.Time `form:"createdBefore" time_format:"2006-01-02"`                 // สร้างก่อนวันที่นี้ (ไม่รวมวันนั้น)
+	HasImage          *bool     `form:"hasImage"`                                               // true = มีรูป, false = ไม่มีรูป
+	ExcludeAllergens  string    `form:"exclude_allergens"`                                      // สารก่อภูมิแพ้คั่นด้วย comma ต้องไม่มีเลยสักตัว
+	Diet              string    `form:"diet"`                                                   // รูปแบบการกินคั่นด้วย comma ต้องเข้ากันได้ทุกแบบ
+	Sort              string    `form:"sort" binding:"omitempty,oneof=newest oldest highest-rated top-rated most-rated most-favorited name"`
+	Cursor            string    `form:"cursor"` // nextCursor จากหน้าก่อน ใช้แทน page (keyset pagination)
+
+	Prior BayesianPrior `form:"-"` // กำหนดโดย service ผ่าน WithPrior ใช้กับ top-rated
+}
+
+// TagSlugs แยก slug จาก query tags=thai,vegan
+func (query FoodRecipeQuery) TagSlugs() []string {
+	slugs := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, value := range strings.Split(query.Tags, ",") {
+		slug := Slugify(value)
+		if slug == "" || seen[slug] {
+			continue
+		}
+		seen[slug] = true
+		slugs = append(slugs, slug)
+	}
+
+	return slugs
 }
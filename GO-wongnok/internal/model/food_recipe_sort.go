@@ -0,0 +1,222 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"wongnok/internal/global"
+
+	"gorm.io/gorm/clause"
+)
+
+// ตัวเลือก sort= ของรายการสูตรอาหาร
+const (
+	SortNewest        = "newest"
+	SortOldest        = "oldest"
+	SortHighestRated  = "highest-rated"
+	SortTopRated      = "top-rated" // คะแนน Bayesian (ดู BayesianPrior)
+	SortMostRated     = "most-rated"
+	SortMostFavorited = "most-favorited"
+	SortName          = "name"
+	// SortRelevance ใช้ภายในเมื่อค้นหาโดยไม่ได้เลือก sort
+	SortRelevance = "relevance"
+)
+
+// เงื่อนไข full-text search และการเรียงตามความเกี่ยวข้อง
+// search_vector และฟังก์ชัน search_query ดูแลโดย migration (ดู add_food_recipes_search_vector)
+const (
+	RecipeSearchCondition = "food_recipes.search_vector @@ search_query(?)"
+	RecipeRankOrder       = "ts_rank_cd(food_recipes.search_vector, search_query(?)) desc, food_recipes.id desc"
+)
+
+// RecipeAverageRating คะแนนเฉลี่ยจากคอลัมน์สรุปใน food_recipes (ดู RatingAggregateColumns)
+const RecipeAverageRating = "(CASE WHEN food_recipes.rating_count > 0 THEN food_recipes.rating_sum / food_recipes.rating_count ELSE 0 END)"
+
+// recipeSortKey ค่าที่ใช้เรียงของแต่ละ sort โดยใช้ id เป็นตัวตัดสินเมื่อค่าเท่ากัน (ทิศทางเดียวกัน)
+type recipeSortKey struct {
+	Expr string // SQL ของค่าที่ใช้เรียง
+	Type string // ชนิดข้อมูลสำหรับ cast ค่าใน cursor กลับ
+	Desc bool
+}
+
+var recipeSortKeys = map[string]recipeSortKey{
+	SortNewest:        {Expr: "food_recipes.created_at", Type: "TIMESTAMP", Desc: true},
+	SortOldest:        {Expr: "food_recipes.created_at", Type: "TIMESTAMP"},
+	SortHighestRated:  {Expr: RecipeAverageRating, Type: "NUMERIC", Desc: true},
+	SortTopRated:      {Type: "NUMERIC", Desc: true}, // Expr ขึ้นกับ prior ดู lookupSortKey
+	SortMostRated:     {Expr: "food_recipes.rating_count", Type: "INT", Desc: true},
+	SortMostFavorited: {Expr: "(SELECT COUNT(*) FROM favorites WHERE favorites.food_recipe_id = food_recipes.id AND favorites.deleted_at IS NULL)", Type: "BIGINT", Desc: true},
+	SortName:          {Expr: "food_recipes.name", Type: "TEXT"},
+	SortRelevance:     {Expr: "ts_rank_cd(food_recipes.search_vector, search_query(?))", Type: "REAL", Desc: true},
+}
+
+// lookupSortKey คืน sort key ของ sort ที่ระบุ โดยเติมนิพจน์ที่ขึ้นกับ prior ให้ครบ
+func lookupSortKey(sort string, prior BayesianPrior) (recipeSortKey, bool) {
+	key, ok := recipeSortKeys[sort]
+	if sort == SortTopRated {
+		key.Expr = prior.SQL()
+	}
+	return key, ok
+}
+
+func (key recipeSortKey) direction() string {
+	if key.Desc {
+		return "desc"
+	}
+	return "asc"
+}
+
+// RecipeOrder คืนเงื่อนไข ORDER BY ของ sort ที่เลือก (ค่าเริ่มต้นเรียงตามชื่อ)
+// ปิดท้ายด้วย id เสมอ เพื่อให้ลำดับคงที่และแต่ละหน้าไม่ซ้อนกัน
+func RecipeOrder(sort string, prior BayesianPrior) string {
+	key, ok := lookupSortKey(sort, prior)
+	if !ok || sort == SortRelevance {
+		key = recipeSortKeys[SortName]
+	}
+
+	return fmt.Sprintf("%s %s, food_recipes.id %s", key.Expr, key.direction(), key.direction())
+}
+
+// SortMode คืน sort ที่ใช้จริง ถ้าค้นหาโดยไม่ได้เลือก sort จะเรียงตามความเกี่ยวข้องกับคำค้น
+func (query FoodRecipeQuery) SortMode() string {
+	if query.Search != "" && query.Sort == "" {
+		return SortRelevance
+	}
+	if _, ok := recipeSortKeys[query.Sort]; !ok {
+		return SortName
+	}
+	return query.Sort
+}
+
+// ค่า sort key และ parameter ของ SQL (เฉพาะ relevance ที่ต้องใช้คำค้น)
+func (query FoodRecipeQuery) sortKey() (recipeSortKey, []interface{}) {
+	mode := query.SortMode()
+	key, _ := lookupSortKey(mode, query.Prior)
+	if mode == SortRelevance {
+		return key, []interface{}{query.Search}
+	}
+	return key, nil
+}
+
+// OrderBy คืน ORDER BY ของรายการสูตรอาหารตาม SortMode
+func (query FoodRecipeQuery) OrderBy() clause.OrderBy {
+	if query.SortMode() == SortRelevance {
+		return clause.OrderBy{
+			Expression: clause.Expr{SQL: RecipeRankOrder, Vars: []interface{}{query.Search}, WithoutParentheses: true},
+		}
+	}
+
+	return clause.OrderBy{
+		Expression: clause.Expr{SQL: RecipeOrder(query.SortMode(), query.Prior), WithoutParentheses: true},
+	}
+}
+
+// SelectWithSortKey เลือกคอลัมน์ของสูตรพร้อมค่า sort key (เป็นข้อความ) ไว้สร้าง cursor ของหน้าถัดไป
+func (query FoodRecipeQuery) SelectWithSortKey() clause.Expr {
+	key, vars := query.sortKey()
+
+	return clause.Expr{SQL: fmt.Sprintf("food_recipes.*, CAST(%s AS TEXT) AS sort_key", key.Expr), Vars: vars}
+}
+
+// AfterCursor เงื่อนไข keyset ของแถวที่อยู่หลัง cursor ตามลำดับของ sort ที่เลือก
+func (query FoodRecipeQuery) AfterCursor() (clause.Expr, error) {
+	cursor, err := DecodeRecipeCursor(query.Cursor)
+	if err != nil {
+		return clause.Expr{}, err
+	}
+
+	// cursor ต้องมาจากลำดับเดียวกัน ไม่อย่างนั้นค่า key จะเทียบกันไม่ได้
+	if cursor.Sort != query.SortMode() {
+		return clause.Expr{}, global.ErrInvalidCursor
+	}
+
+	key, vars := query.sortKey()
+	operator := ">"
+	if key.Desc {
+		operator = "<"
+	}
+
+	return clause.Expr{
+		SQL:  fmt.Sprintf("(%s, food_recipes.id) %s (CAST(? AS %s), ?)", key.Expr, operator, key.Type),
+		Vars: append(vars, cursor.Key, cursor.ID),
+	}, nil
+}
+
+// WithPrior กำหนด prior ของ top-rated ถ้าเป็นหน้าถัดไปจะใช้ค่าเฉลี่ยเดียวกับตอนสร้าง cursor
+// ไม่อย่างนั้นค่าเฉลี่ยที่เปลี่ยนระหว่างหน้าจะทำให้ key ใน cursor เทียบกับนิพจน์ปัจจุบันไม่ได้
+func (query FoodRecipeQuery) WithPrior(prior BayesianPrior) FoodRecipeQuery {
+	if query.Cursor != "" && query.SortMode() == SortTopRated {
+		if cursor, err := DecodeRecipeCursor(query.Cursor); err == nil && cursor.Mean > 0 {
+			prior.Mean = cursor.Mean
+		}
+	}
+
+	query.Prior = prior
+	return query
+}
+
+// RecipeCursor ตำแหน่งของแถวสุดท้ายในหน้าที่แล้ว ส่งให้ client เป็นข้อความ base64 ที่ไม่ต้องตีความ
+type RecipeCursor struct {
+	Sort string  `json:"s"`
+	Key  string  `json:"k"`
+	ID   uint    `json:"i"`
+	Mean float64 `json:"m,omitempty"` // ค่าเฉลี่ยของ prior ที่ใช้ใน top-rated (ดู WithPrior)
+}
+
+func (cursor RecipeCursor) Encode() string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// ช่วงของ RecipeCursor.Mean ค่าเฉลี่ยมาจาก client จึงต้องอยู่ในช่วงคะแนนที่เป็นไปได้ (1-5)
+// ไม่อย่างนั้นจะส่ง prior แปลก ๆ เข้ามาบิดลำดับของ top-rated ได้ (0 คือไม่ได้ระบุ)
+const (
+	minCursorMean = 1
+	maxCursorMean = 5
+)
+
+func DecodeRecipeCursor(value string) (RecipeCursor, error) {
+	var cursor RecipeCursor
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return RecipeCursor{}, global.ErrInvalidCursor
+	}
+
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == 0 {
+		return RecipeCursor{}, global.ErrInvalidCursor
+	}
+
+	if cursor.Mean != 0 && (cursor.Mean < minCursorMean || cursor.Mean > maxCursorMean) {
+		return RecipeCursor{}, global.ErrInvalidCursor
+	}
+
+	return cursor, nil
+}
+
+// Page ตัดแถวที่ดึงเกินมา 1 แถว (ใช้บอกว่ายังมีหน้าถัดไป) และสร้าง cursor ของหน้าถัดไป
+// คืน cursor ว่างเมื่อเป็นหน้าสุดท้าย
+func (recipes FoodRecipes) Page(query FoodRecipeQuery) (FoodRecipes, string) {
+	if len(recipes) <= query.Limit {
+		return recipes, ""
+	}
+
+	recipes = recipes[:query.Limit]
+	last := recipes[len(recipes)-1]
+
+	cursor := RecipeCursor{Sort: query.SortMode(), ID: last.ID}
+	if last.SortKey != nil {
+		cursor.Key = *last.SortKey
+	}
+	if cursor.Sort == SortTopRated {
+		cursor.Mean = query.Prior.Mean
+	}
+
+	return recipes, cursor.Encode()
+}
+
+// UserRecipesQuery ตัวเลือกตอนดึงสูตรอาหารของผู้ใช้
+type UserRecipesQuery struct {
+	Sort  string        `form:"sort" binding:"omitempty,oneof=newest oldest highest-rated top-rated most-rated most-favorited name"`
+	Prior BayesianPrior `form:"-"` // กำหนดโดย service ใช้กับ top-rated
+}
@@ -0,0 +1,177 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func TestRecipeOrder(t *testing.T) {
+	t.Run("ShouldDefaultToName", func(t *testing.T) {
+		assert.Equal(t, "food_recipes.name asc, food_recipes.id asc", model.RecipeOrder("", model.BayesianPrior{}))
+	})
+
+	t.Run("ShouldAlwaysBreakTiesOnID", func(t *testing.T) {
+		sorts := []string{
+			model.SortNewest,
+			model.SortOldest,
+			model.SortHighestRated,
+			model.SortMostRated,
+			model.SortMostFavorited,
+			model.SortName,
+		}
+
+		for _, sort := range sorts {
+			order := model.RecipeOrder(sort, model.BayesianPrior{})
+			assert.True(t, strings.HasSuffix(order, "food_recipes.id desc") || strings.HasSuffix(order, "food_recipes.id asc"), sort)
+		}
+	})
+
+	t.Run("ShouldSortNewestFirst", func(t *testing.T) {
+		assert.Equal(t, "food_recipes.created_at desc, food_recipes.id desc", model.RecipeOrder(model.SortNewest, model.BayesianPrior{}))
+	})
+}
+
+func TestFoodRecipeQueryOrderBy(t *testing.T) {
+	t.Run("ShouldRankBySearchWhenNoSortGiven", func(t *testing.T) {
+		orderBy := model.FoodRecipeQuery{Search: "ต้มยำ"}.OrderBy()
+
+		expr, ok := orderBy.Expression.(clause.Expr)
+		assert.True(t, ok)
+		assert.Equal(t, model.RecipeRankOrder, expr.SQL)
+		assert.Equal(t, []interface{}{"ต้มยำ"}, expr.Vars)
+	})
+
+	t.Run("ShouldUseSelectedSortWhenSearching", func(t *testing.T) {
+		orderBy := model.FoodRecipeQuery{Search: "ต้มยำ", Sort: model.SortNewest}.OrderBy()
+
+		expr, ok := orderBy.Expression.(clause.Expr)
+		assert.True(t, ok)
+		assert.Equal(t, model.RecipeOrder(model.SortNewest, model.BayesianPrior{}), expr.SQL)
+		assert.Empty(t, expr.Vars)
+	})
+}
+
+func TestRecipeCursor(t *testing.T) {
+	t.Run("ShouldRoundTrip", func(t *testing.T) {
+		cursor := model.RecipeCursor{Sort: model.SortNewest, Key: "2026-10-18 09:00:00.123456", ID: 42}
+
+		decoded, err := model.DecodeRecipeCursor(cursor.Encode())
+
+		assert.NoError(t, err)
+		assert.Equal(t, cursor, decoded)
+	})
+
+	t.Run("ShouldRejectGarbage", func(t *testing.T) {
+		_, err := model.DecodeRecipeCursor("not-a-cursor!")
+
+		assert.ErrorIs(t, err, global.ErrInvalidCursor)
+	})
+
+	t.Run("ShouldKeepMeanWithinScoreRange", func(t *testing.T) {
+		cursor := model.RecipeCursor{Sort: model.SortTopRated, Key: "4.2", ID: 7, Mean: 3.8}
+
+		decoded, err := model.DecodeRecipeCursor(cursor.Encode())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3.8, decoded.Mean)
+	})
+
+	t.Run("ShouldRejectMeanOutsideScoreRange", func(t *testing.T) {
+		for _, mean := range []float64{-3, 0.5, 5.5, 1000} {
+			cursor := model.RecipeCursor{Sort: model.SortTopRated, Key: "4.2", ID: 7, Mean: mean}
+
+			_, err := model.DecodeRecipeCursor(cursor.Encode())
+
+			assert.ErrorIs(t, err, global.ErrInvalidCursor, mean)
+		}
+	})
+}
+
+func TestFoodRecipeQueryWithPrior(t *testing.T) {
+	prior := model.BayesianPrior{MinVotes: 5, Mean: 3.5}
+
+	t.Run("ShouldUseMeanFromCursor", func(t *testing.T) {
+		cursor := model.RecipeCursor{Sort: model.SortTopRated, Key: "4.2", ID: 7, Mean: 4.1}
+		query := model.FoodRecipeQuery{Sort: model.SortTopRated, Cursor: cursor.Encode()}.WithPrior(prior)
+
+		assert.Equal(t, 4.1, query.Prior.Mean)
+	})
+
+	t.Run("ShouldIgnoreMeanOutsideScoreRange", func(t *testing.T) {
+		cursor := model.RecipeCursor{Sort: model.SortTopRated, Key: "4.2", ID: 7, Mean: 1000}
+		query := model.FoodRecipeQuery{Sort: model.SortTopRated, Cursor: cursor.Encode()}.WithPrior(prior)
+
+		assert.Equal(t, 3.5, query.Prior.Mean)
+
+		_, err := query.AfterCursor()
+		assert.ErrorIs(t, err, global.ErrInvalidCursor)
+	})
+}
+
+func TestFoodRecipeQueryAfterCursor(t *testing.T) {
+	t.Run("ShouldCompareDescendingSortWithLessThan", func(t *testing.T) {
+		cursor := model.RecipeCursor{Sort: model.SortMostRated, Key: "3", ID: 9}
+		query := model.FoodRecipeQuery{Sort: model.SortMostRated, Cursor: cursor.Encode()}
+
+		expr, err := query.AfterCursor()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "(food_recipes.rating_count, food_recipes.id) < (CAST(? AS INT), ?)", expr.SQL)
+		assert.Equal(t, []interface{}{"3", uint(9)}, expr.Vars)
+	})
+
+	t.Run("ShouldPassSearchForRelevance", func(t *testing.T) {
+		cursor := model.RecipeCursor{Sort: model.SortRelevance, Key: "0.5", ID: 3}
+		query := model.FoodRecipeQuery{Search: "ผัดไทย", Cursor: cursor.Encode()}
+
+		expr, err := query.AfterCursor()
+
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"ผัดไทย", "0.5", uint(3)}, expr.Vars)
+	})
+
+	t.Run("ShouldRejectCursorFromAnotherSort", func(t *testing.T) {
+		cursor := model.RecipeCursor{Sort: model.SortNewest, Key: "x", ID: 1}
+		query := model.FoodRecipeQuery{Sort: model.SortName, Cursor: cursor.Encode()}
+
+		_, err := query.AfterCursor()
+
+		assert.ErrorIs(t, err, global.ErrInvalidCursor)
+	})
+}
+
+func TestFoodRecipesPage(t *testing.T) {
+	key := func(value string) *string { return &value }
+
+	t.Run("ShouldTrimExtraRowAndReturnCursor", func(t *testing.T) {
+		recipes := model.FoodRecipes{
+			{Model: gorm.Model{ID: 1}, SortKey: key("A")},
+			{Model: gorm.Model{ID: 2}, SortKey: key("B")},
+			{Model: gorm.Model{ID: 3}, SortKey: key("C")},
+		}
+		query := model.FoodRecipeQuery{Limit: 2}
+
+		page, next := recipes.Page(query)
+
+		assert.Len(t, page, 2)
+		cursor, err := model.DecodeRecipeCursor(next)
+		assert.NoError(t, err)
+		assert.Equal(t, model.RecipeCursor{Sort: model.SortName, Key: "B", ID: 2}, cursor)
+	})
+
+	t.Run("ShouldReturnEmptyCursorOnLastPage", func(t *testing.T) {
+		recipes := model.FoodRecipes{{Model: gorm.Model{ID: 1}}}
+
+		page, next := recipes.Page(model.FoodRecipeQuery{Limit: 2})
+
+		assert.Len(t, page, 1)
+		assert.Empty(t, next)
+	})
+}
@@ -0,0 +1,217 @@
+package model
+
+import (
+	"strings"
+	"time"
+	"wongnok/internal/model/dto"
+
+	"gorm.io/gorm"
+)
+
+// Ingredient วัตถุดิบในพจนานุกรม ใช้จับคู่ชื่อที่ผู้ใช้พิมพ์ต่างกัน ("ไข่", "Eggs", "egg") ให้เป็นอันเดียวกัน
+type Ingredient struct {
+	gorm.Model
+	Name     string // ชื่อหลัก (ภาษาอังกฤษ)
+	NameTH   string `gorm:"column:name_th"`
+	Category string // หมวดในซูเปอร์มาร์เก็ต (ดู AisleCategories)
+	Synonyms StringList
+	// ใช้สรุปสารก่อภูมิแพ้และรูปแบบการกินของสูตร (ดู DeriveDietary)
+	Allergens     StringList
+	ExcludedDiets StringList
+}
+
+func (ingredient Ingredient) FromRequest(request dto.IngredientRequest) Ingredient {
+	synonyms := make(StringList, 0, len(request.Synonyms))
+	for _, synonym := range request.Synonyms {
+		if synonym = strings.TrimSpace(synonym); synonym != "" {
+			synonyms = append(synonyms, synonym)
+		}
+	}
+
+	return Ingredient{
+		Model:         ingredient.Model,
+		Name:          strings.TrimSpace(request.Name),
+		NameTH:        strings.TrimSpace(request.NameTH),
+		Category:      request.Category,
+		Synonyms:      synonyms,
+		Allergens:     NormalizeAllergens(request.Allergens),
+		ExcludedDiets: NormalizeExcludedDiets(request.ExcludedDiets),
+	}
+}
+
+func (ingredient Ingredient) ToResponse() dto.IngredientResponse {
+	synonyms := []string(ingredient.Synonyms)
+	if synonyms == nil {
+		synonyms = make([]string, 0)
+	}
+
+	return dto.IngredientResponse{
+		ID:            ingredient.ID,
+		Name:          ingredient.Name,
+		NameTH:        ingredient.NameTH,
+		Category:      ingredient.Category,
+		Synonyms:      synonyms,
+		Allergens:     NormalizeAllergens(ingredient.Allergens),
+		ExcludedDiets: NormalizeExcludedDiets(ingredient.ExcludedDiets),
+	}
+}
+
+// Terms ชื่อทั้งหมดของวัตถุดิบ (ชื่อหลัก ชื่อไทย คำพ้อง) ในรูปที่ normalise แล้ว ไม่ซ้ำกัน
+func (ingredient Ingredient) Terms() []string {
+	names := append([]string{ingredient.Name, ingredient.NameTH}, ingredient.Synonyms...)
+	terms := make([]string, 0, len(names))
+	seen := make(map[string]bool, len(names))
+
+	for _, name := range names {
+		term := NormalizeIngredientName(name)
+		if term == "" || seen[term] {
+			continue
+		}
+		seen[term] = true
+		terms = append(terms, term)
+	}
+
+	return terms
+}
+
+// HasTerm ชื่อนี้เป็นชื่อหนึ่งของวัตถุดิบหรือไม่
+func (ingredient Ingredient) HasTerm(name string) bool {
+	term := NormalizeIngredientName(name)
+	for _, value := range ingredient.Terms() {
+		if value == term {
+			return true
+		}
+	}
+	return false
+}
+
+type Ingredients []Ingredient
+
+func (ingredients Ingredients) ToResponse() dto.IngredientsResponse {
+	var results = make([]dto.IngredientResponse, 0)
+
+	for _, ingredient := range ingredients {
+		results = append(results, ingredient.ToResponse())
+	}
+
+	return dto.IngredientsResponse{
+		Results: results,
+	}
+}
+
+// IngredientIndex ชื่อที่ normalise แล้ว -> id ของวัตถุดิบในพจนานุกรม
+type IngredientIndex map[string]uint
+
+func (ingredients Ingredients) Index() IngredientIndex {
+	index := make(IngredientIndex)
+
+	for _, ingredient := range ingredients {
+		for _, term := range ingredient.Terms() {
+			if _, exists := index[term]; !exists {
+				index[term] = ingredient.ID
+			}
+		}
+	}
+
+	return index
+}
+
+// Link ผูกวัตถุดิบของสูตรเข้ากับพจนานุกรมตามชื่อ และคืนชื่อที่ยังไม่รู้จัก (ไม่ซ้ำกัน)
+func (index IngredientIndex) Link(ingredients RecipeIngredients) (RecipeIngredients, []string) {
+	unlinked := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for i, ingredient := range ingredients {
+		term := NormalizeIngredientName(ingredient.Name)
+		if id, ok := index[term]; ok {
+			ingredients[i].IngredientID = &id
+			continue
+		}
+
+		ingredients[i].IngredientID = nil
+		if term != "" && !seen[term] {
+			seen[term] = true
+			unlinked = append(unlinked, ingredient.Name)
+		}
+	}
+
+	return ingredients, unlinked
+}
+
+// LinkPantry ผูกของในตู้เข้ากับพจนานุกรมตามชื่อ ชื่อที่ไม่รู้จักไม่เข้าคิวรอตรวจเพราะเป็นของส่วนตัวของผู้ใช้
+func (index IngredientIndex) LinkPantry(items PantryItems) PantryItems {
+	for i, item := range items {
+		items[i].IngredientID = nil
+		if id, ok := index[NormalizeIngredientName(item.Name)]; ok {
+			items[i].IngredientID = &id
+		}
+	}
+
+	return items
+}
+
+// NormalizeIngredientName รูปมาตรฐานของชื่อวัตถุดิบสำหรับจับคู่
+// ตัวพิมพ์เล็ก ตัดช่องว่างซ้ำ และทำคำสุดท้ายเป็นเอกพจน์แบบง่าย (eggs -> egg, tomatoes -> tomato)
+// ภาษาไทยไม่มีรูปพหูพจน์ จึงเหลือแค่การตัดช่องว่าง
+func NormalizeIngredientName(name string) string {
+	words := strings.Fields(strings.ToLower(name))
+	if len(words) == 0 {
+		return ""
+	}
+
+	words[len(words)-1] = singular(words[len(words)-1])
+
+	return strings.Join(words, " ")
+}
+
+func singular(word string) string {
+	switch {
+	case len(word) > 4 && strings.HasSuffix(word, "ies"):
+		return strings.TrimSuffix(word, "ies") + "y"
+	case len(word) > 4 && strings.HasSuffix(word, "oes"):
+		return strings.TrimSuffix(word, "es")
+	case len(word) > 3 && strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss"):
+		return strings.TrimSuffix(word, "s")
+	default:
+		return word
+	}
+}
+
+// UnlinkedIngredient ชื่อวัตถุดิบที่ยังไม่อยู่ในพจนานุกรม รอ admin ตรวจ
+// Name เก็บรูปที่ normalise แล้ว จึงมีแถวเดียวต่อชื่อ ตรวจเสร็จแล้วลบแถวทิ้ง (ไม่ใช้ soft delete)
+type UnlinkedIngredient struct {
+	ID          uint `gorm:"primarykey"`
+	Name        string
+	Occurrences int
+	CreatedAt   time.Time
+	LastSeenAt  time.Time
+}
+
+func (unlinked UnlinkedIngredient) ToResponse() dto.UnlinkedIngredientResponse {
+	return dto.UnlinkedIngredientResponse{
+		ID:          unlinked.ID,
+		Name:        unlinked.Name,
+		Occurrences: unlinked.Occurrences,
+		LastSeenAt:  unlinked.LastSeenAt,
+	}
+}
+
+type UnlinkedIngredients []UnlinkedIngredient
+
+func (unlinked UnlinkedIngredients) ToResponse() dto.UnlinkedIngredientsResponse {
+	var results = make([]dto.UnlinkedIngredientResponse, 0)
+
+	for _, item := range unlinked {
+		results = append(results, item.ToResponse())
+	}
+
+	return dto.UnlinkedIngredientsResponse{
+		Results: results,
+	}
+}
+
+// IngredientQuery ค้นหาในพจนานุกรมจากชื่อใดก็ได้ของวัตถุดิบ
+type IngredientQuery struct {
+	Search   string `form:"search"`
+	Category string `form:"category" binding:"omitempty,oneof=produce meat seafood dairy-eggs bakery pantry spices-condiments frozen beverages other"`
+}
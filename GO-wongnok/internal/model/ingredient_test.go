@@ -0,0 +1,67 @@
+package model_test
+
+import (
+	"testing"
+
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestNormalizeIngredientName(t *testing.T) {
+	cases := map[string]string{
+		"Eggs":             "egg",
+		"  egg ":           "egg",
+		"Cherry  Tomatoes": "cherry tomato",
+		"berries":          "berry",
+		"Swiss":            "swiss",
+		"ไข่ไก่":           "ไข่ไก่",
+		"":                 "",
+	}
+
+	for name, expected := range cases {
+		assert.Equal(t, expected, model.NormalizeIngredientName(name), name)
+	}
+}
+
+func TestIngredientFromRequest(t *testing.T) {
+	ingredient := model.Ingredient{}.FromRequest(dto.IngredientRequest{
+		Name:     " egg ",
+		NameTH:   "ไข่",
+		Category: model.AisleDairy,
+		Synonyms: []string{" ไข่ไก่ ", " "},
+	})
+
+	assert.Equal(t, "egg", ingredient.Name)
+	assert.Equal(t, model.StringList{"ไข่ไก่"}, ingredient.Synonyms)
+	assert.Equal(t, []string{"egg", "ไข่", "ไข่ไก่"}, ingredient.Terms())
+	assert.True(t, ingredient.HasTerm("Eggs"))
+}
+
+func TestIngredientIndexLink(t *testing.T) {
+	catalogue := model.Ingredients{
+		{Model: gorm.Model{ID: 1}, Name: "egg", NameTH: "ไข่", Synonyms: model.StringList{"ไข่ไก่"}},
+		{Model: gorm.Model{ID: 2}, Name: "garlic", NameTH: "กระเทียม"},
+	}
+
+	ingredients, unlinked := catalogue.Index().Link(model.RecipeIngredients{
+		{Name: "Eggs"},
+		{Name: "ไข่"},
+		{Name: "กระเทียม"},
+		{Name: "Galangal"},
+		{Name: "galangal"},
+	})
+
+	assert.Equal(t, uint(1), *ingredients[0].IngredientID)
+	assert.Equal(t, uint(1), *ingredients[1].IngredientID)
+	assert.Equal(t, uint(2), *ingredients[2].IngredientID)
+	assert.Nil(t, ingredients[3].IngredientID)
+	assert.Equal(t, []string{"Galangal"}, unlinked)
+
+	items := catalogue.Index().LinkPantry(model.PantryItems{{Name: "ไข่ไก่"}, {Name: "Galangal", IngredientID: linked(2)}})
+
+	assert.Equal(t, uint(1), *items[0].IngredientID)
+	assert.Nil(t, items[1].IngredientID)
+}
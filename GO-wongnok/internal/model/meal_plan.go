@@ -0,0 +1,123 @@
+package model
+
+import (
+	"time"
+	"wongnok/internal/model/dto"
+
+	"gorm.io/gorm"
+)
+
+// มื้ออาหารในแต่ละวัน เรียงตามลำดับใน MealSlots
+const (
+	MealSlotBreakfast = "breakfast"
+	MealSlotLunch     = "lunch"
+	MealSlotDinner    = "dinner"
+	MealSlotSnack     = "snack"
+)
+
+var MealSlots = []string{MealSlotBreakfast, MealSlotLunch, MealSlotDinner, MealSlotSnack}
+
+const MealPlanDateLayout = "2006-01-02"
+
+// MealPlanEntry สูตรอาหารหนึ่งรายการในแผนมื้ออาหาร (วัน + มื้อ)
+// Servings เป็น 0 เมื่อใช้จำนวนที่เสิร์ฟตามสูตร
+type MealPlanEntry struct {
+	gorm.Model
+	UserID       string
+	Date         time.Time `gorm:"type:date"`
+	Slot         string
+	FoodRecipeID uint
+	FoodRecipe   FoodRecipe
+	Servings     int
+}
+
+// FromRequest request ผ่าน validator มาแล้ว วันที่จึง parse ได้เสมอ
+func (entry MealPlanEntry) FromRequest(request dto.MealPlanEntryRequest) MealPlanEntry {
+	date, _ := time.Parse(MealPlanDateLayout, request.Date)
+
+	return MealPlanEntry{
+		Model:        entry.Model,
+		UserID:       entry.UserID,
+		Date:         date,
+		Slot:         request.Slot,
+		FoodRecipeID: request.FoodRecipeID,
+		Servings:     request.Servings,
+	}
+}
+
+// EffectiveServings จำนวนที่เสิร์ฟที่ใช้จริง (ค่าที่กำหนดไว้ หรือของสูตร)
+func (entry MealPlanEntry) EffectiveServings() int {
+	if entry.Servings > 0 {
+		return entry.Servings
+	}
+	return entry.FoodRecipe.Servings
+}
+
+func (entry MealPlanEntry) ToResponse() dto.MealPlanEntryResponse {
+	response := dto.MealPlanEntryResponse{
+		ID:           entry.ID,
+		Date:         entry.Date.Format(MealPlanDateLayout),
+		Slot:         entry.Slot,
+		FoodRecipeID: entry.FoodRecipeID,
+		Servings:     entry.EffectiveServings(),
+		CreatedAt:    entry.CreatedAt,
+		UpdatedAt:    entry.UpdatedAt,
+	}
+
+	if entry.FoodRecipe.ID != 0 {
+		summary := entry.FoodRecipe.ToSummaryResponse()
+		response.FoodRecipe = &summary
+	}
+
+	return response
+}
+
+type MealPlanEntries []MealPlanEntry
+
+// ToWeekResponse จัดรายการเป็น 7 วันเริ่มจาก start แต่ละวันเรียงตามมื้อ
+func (entries MealPlanEntries) ToWeekResponse(start time.Time) dto.MealPlanWeekResponse {
+	days := make([]dto.MealPlanDayResponse, 7)
+	index := make(map[string]int, 7)
+	for i := range days {
+		date := start.AddDate(0, 0, i).Format(MealPlanDateLayout)
+		days[i] = dto.MealPlanDayResponse{Date: date, Entries: make([]dto.MealPlanEntryResponse, 0)}
+		index[date] = i
+	}
+
+	for _, slot := range MealSlots {
+		for _, entry := range entries {
+			if entry.Slot != slot {
+				continue
+			}
+			if i, ok := index[entry.Date.Format(MealPlanDateLayout)]; ok {
+				days[i].Entries = append(days[i].Entries, entry.ToResponse())
+			}
+		}
+	}
+
+	return dto.MealPlanWeekResponse{
+		StartDate: start.Format(MealPlanDateLayout),
+		EndDate:   start.AddDate(0, 0, 6).Format(MealPlanDateLayout),
+		Days:      days,
+	}
+}
+
+// WeekStart วันจันทร์ของสัปดาห์ที่มีวันที่นี้ (ตัดเวลาออก)
+func WeekStart(date time.Time) time.Time {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	offset := (int(day.Weekday()) + 6) % 7 // จันทร์ = 0
+	return day.AddDate(0, 0, -offset)
+}
+
+// MealPlanWeekQuery สัปดาห์ที่ต้องการดู (วันใดก็ได้ในสัปดาห์ ไม่ส่งมา = สัปดาห์นี้)
+type MealPlanWeekQuery struct {
+	Week time.Time `form:"week" time_format:"2006-01-02"`
+}
+
+// Start วันจันทร์ของสัปดาห์ที่เลือก
+func (query MealPlanWeekQuery) Start() time.Time {
+	if query.Week.IsZero() {
+		return WeekStart(time.Now())
+	}
+	return WeekStart(query.Week)
+}
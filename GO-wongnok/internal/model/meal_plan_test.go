@@ -0,0 +1,75 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestWeekStart(t *testing.T) {
+	monday := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+
+	t.Run("ShouldReturnMondayOfTheWeek", func(t *testing.T) {
+		assert.Equal(t, monday, model.WeekStart(time.Date(2026, 10, 15, 18, 30, 0, 0, time.UTC)))
+		assert.Equal(t, monday, model.WeekStart(monday))
+	})
+
+	t.Run("ShouldTreatSundayAsLastDay", func(t *testing.T) {
+		assert.Equal(t, monday, model.WeekStart(time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)))
+	})
+}
+
+func TestMealPlanEntryFromRequest(t *testing.T) {
+	entry := model.MealPlanEntry{Model: gorm.Model{ID: 3}, UserID: "user-1"}.FromRequest(dto.MealPlanEntryRequest{
+		Date:         "2026-10-14",
+		Slot:         model.MealSlotDinner,
+		FoodRecipeID: 2,
+		Servings:     4,
+	})
+
+	assert.Equal(t, uint(3), entry.ID)
+	assert.Equal(t, "user-1", entry.UserID)
+	assert.Equal(t, time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC), entry.Date)
+	assert.Equal(t, model.MealSlotDinner, entry.Slot)
+	assert.Equal(t, 4, entry.Servings)
+}
+
+func TestMealPlanEntryEffectiveServings(t *testing.T) {
+	recipe := model.FoodRecipe{Model: gorm.Model{ID: 2}, Servings: 2}
+
+	t.Run("ShouldUseOverride", func(t *testing.T) {
+		assert.Equal(t, 6, model.MealPlanEntry{FoodRecipe: recipe, Servings: 6}.EffectiveServings())
+	})
+
+	t.Run("ShouldFallBackToRecipeServings", func(t *testing.T) {
+		assert.Equal(t, 2, model.MealPlanEntry{FoodRecipe: recipe}.EffectiveServings())
+	})
+}
+
+func TestMealPlanEntriesToWeekResponse(t *testing.T) {
+	monday := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	entries := model.MealPlanEntries{
+		{Model: gorm.Model{ID: 1}, Date: monday.AddDate(0, 0, 2), Slot: model.MealSlotDinner},
+		{Model: gorm.Model{ID: 2}, Date: monday.AddDate(0, 0, 2), Slot: model.MealSlotBreakfast},
+		{Model: gorm.Model{ID: 3}, Date: monday, Slot: model.MealSlotLunch},
+	}
+
+	response := entries.ToWeekResponse(monday)
+
+	assert.Equal(t, "2026-10-12", response.StartDate)
+	assert.Equal(t, "2026-10-18", response.EndDate)
+	assert.Len(t, response.Days, 7)
+	assert.Equal(t, uint(3), response.Days[0].Entries[0].ID)
+	assert.Empty(t, response.Days[1].Entries)
+
+	t.Run("ShouldOrderEntriesBySlot", func(t *testing.T) {
+		assert.Equal(t, "2026-10-14", response.Days[2].Date)
+		assert.Equal(t, uint(2), response.Days[2].Entries[0].ID)
+		assert.Equal(t, uint(1), response.Days[2].Entries[1].ID)
+	})
+}
@@ -0,0 +1,155 @@
+package model
+
+import (
+	"math"
+	"wongnok/internal/model/dto"
+	"wongnok/internal/units"
+)
+
+// NutritionFacts ค่าโภชนาการ พลังงานเป็น kcal โปรตีน ไขมัน คาร์โบไฮเดรตเป็นกรัม และโซเดียมเป็นมิลลิกรัม
+type NutritionFacts struct {
+	Calories float64
+	Protein  float64
+	Fat      float64
+	Carbs    float64
+	Sodium   float64
+}
+
+func (facts NutritionFacts) Add(other NutritionFacts) NutritionFacts {
+	return NutritionFacts{
+		Calories: facts.Calories + other.Calories,
+		Protein:  facts.Protein + other.Protein,
+		Fat:      facts.Fat + other.Fat,
+		Carbs:    facts.Carbs + other.Carbs,
+		Sodium:   facts.Sodium + other.Sodium,
+	}
+}
+
+func (facts NutritionFacts) Scale(factor float64) NutritionFacts {
+	return NutritionFacts{
+		Calories: facts.Calories * factor,
+		Protein:  facts.Protein * factor,
+		Fat:      facts.Fat * factor,
+		Carbs:    facts.Carbs * factor,
+		Sodium:   facts.Sodium * factor,
+	}
+}
+
+// ToResponse ปัดพลังงานและโซเดียมเป็นจำนวนเต็ม ส่วนสารอาหารอื่นเหลือทศนิยมหนึ่งตำแหน่ง
+func (facts NutritionFacts) ToResponse() dto.NutritionFactsResponse {
+	return dto.NutritionFactsResponse{
+		Calories: math.Round(facts.Calories),
+		Protein:  math.Round(facts.Protein*10) / 10,
+		Fat:      math.Round(facts.Fat*10) / 10,
+		Carbs:    math.Round(facts.Carbs*10) / 10,
+		Sodium:   math.Round(facts.Sodium),
+	}
+}
+
+// IngredientNutrition ค่าโภชนาการอ้างอิงต่อ 100 กรัมของวัตถุดิบในพจนานุกรม (โหลดผ่าน migration)
+type IngredientNutrition struct {
+	IngredientID  uint           `gorm:"primaryKey;autoIncrement:false"`
+	Per100G       NutritionFacts `gorm:"embedded"`
+	GramsPerPiece *float64       // น้ำหนักต่อชิ้น/ฟอง/กลีบ ถ้าไม่มีจะคำนวณวัตถุดิบที่นับเป็นชิ้นไม่ได้
+	GramsPerML    float64        `gorm:"column:grams_per_ml"` // ความหนาแน่นสำหรับหน่วยตวง (0 ถือเป็น 1 เท่าน้ำ)
+}
+
+// Grams แปลงปริมาณที่ผู้ใช้กรอกเป็นกรัม คืน false ถ้าไม่รู้จักหน่วยหรือไม่มีน้ำหนักต่อชิ้น
+func (reference IngredientNutrition) Grams(quantity float64, unit string) (float64, bool) {
+	measure, ok := units.Lookup(unit)
+	if !ok {
+		return 0, false
+	}
+
+	switch measure.Kind {
+	case units.Mass:
+		return quantity * measure.Factor, true
+	case units.Volume:
+		density := reference.GramsPerML
+		if density <= 0 {
+			density = 1
+		}
+		return quantity * measure.Factor * density, true
+	case units.Count:
+		if reference.GramsPerPiece == nil {
+			return 0, false
+		}
+		return quantity * measure.Factor * *reference.GramsPerPiece, true
+	}
+
+	return 0, false
+}
+
+type IngredientNutritions []IngredientNutrition
+
+func (references IngredientNutritions) ByIngredientID() map[uint]IngredientNutrition {
+	byID := make(map[uint]IngredientNutrition, len(references))
+	for _, reference := range references {
+		byID[reference.IngredientID] = reference
+	}
+	return byID
+}
+
+// Nutrition ค่าโภชนาการโดยประมาณของทั้งสูตร เก็บเป็นคอลัมน์ nutrition_* ใน food_recipes
+// คำนวณใหม่ทุกครั้งที่สร้างหรือแก้สูตร วัตถุดิบที่ไม่ได้ผูกกับพจนานุกรมหรือไม่มีปริมาณจะไม่ถูกนับ
+type Nutrition struct {
+	Total                NutritionFacts `gorm:"embedded"`
+	EstimatedIngredients int            // จำนวนวัตถุดิบที่นำมาคำนวณได้
+	TotalIngredients     int
+}
+
+// EstimateNutrition รวมค่าโภชนาการของวัตถุดิบที่มีค่าอ้างอิง
+func EstimateNutrition(ingredients RecipeIngredients, references map[uint]IngredientNutrition) Nutrition {
+	nutrition := Nutrition{TotalIngredients: len(ingredients)}
+
+	for _, ingredient := range ingredients {
+		if ingredient.IngredientID == nil || ingredient.Quantity == nil {
+			continue
+		}
+
+		reference, ok := references[*ingredient.IngredientID]
+		if !ok {
+			continue
+		}
+
+		grams, ok := reference.Grams(*ingredient.Quantity, ingredient.Unit)
+		if !ok {
+			continue
+		}
+
+		nutrition.Total = nutrition.Total.Add(reference.Per100G.Scale(grams / 100))
+		nutrition.EstimatedIngredients++
+	}
+
+	return nutrition
+}
+
+// ToResponse คืน nil เมื่อไม่มีวัตถุดิบที่คำนวณได้เลย
+func (nutrition Nutrition) ToResponse(servings int) *dto.NutritionResponse {
+	if nutrition.EstimatedIngredients == 0 {
+		return nil
+	}
+	if servings <= 0 {
+		servings = DefaultServings
+	}
+
+	return &dto.NutritionResponse{
+		Total:                nutrition.Total.ToResponse(),
+		PerServing:           nutrition.Total.Scale(1 / float64(servings)).ToResponse(),
+		EstimatedIngredients: nutrition.EstimatedIngredients,
+		TotalIngredients:     nutrition.TotalIngredients,
+	}
+}
+
+// Columns ค่าสำหรับ Updates แบบ map เพราะ Updates แบบ struct จะข้ามค่าศูนย์
+func (nutrition Nutrition) Columns() map[string]interface{} {
+	return map[string]interface{}{
+		"nutrition_calories":              nutrition.Total.Calories,
+		"nutrition_protein":               nutrition.Total.Protein,
+		"nutrition_fat":                   nutrition.Total.Fat,
+		"nutrition_carbs":                 nutrition.Total.Carbs,
+		"nutrition_sodium":                nutrition.Total.Sodium,
+		"nutrition_estimated_ingredients": nutrition.EstimatedIngredients,
+		"nutrition_total_ingredients":     nutrition.TotalIngredients,
+	}
+}
@@ -0,0 +1,66 @@
+package model_test
+
+import (
+	"testing"
+
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func linked(id uint) *uint {
+	return &id
+}
+
+func TestEstimateNutrition(t *testing.T) {
+	references := model.IngredientNutritions{
+		{IngredientID: 1, Per100G: model.NutritionFacts{Calories: 143, Protein: 12.6, Fat: 9.5, Carbs: 0.7, Sodium: 142}, GramsPerPiece: quantity(50)},
+		{IngredientID: 2, Per100G: model.NutritionFacts{Calories: 884, Fat: 100}, GramsPerML: 0.92},
+		{IngredientID: 3, Per100G: model.NutritionFacts{Calories: 165, Protein: 31, Fat: 3.6, Sodium: 74}},
+	}.ByIngredientID()
+
+	nutrition := model.EstimateNutrition(model.RecipeIngredients{
+		{Name: "Eggs", Quantity: quantity(2), IngredientID: linked(1)},
+		{Name: "oil", Quantity: quantity(1), Unit: "ช้อนโต๊ะ", IngredientID: linked(2)},
+		{Name: "chicken", Quantity: quantity(0.2), Unit: "kg", IngredientID: linked(3)},
+		{Name: "chicken", Quantity: quantity(1), IngredientID: linked(3)}, // ไม่มีน้ำหนักต่อชิ้น
+		{Name: "salt", IngredientID: linked(4)},
+		{Name: "pepper", Quantity: quantity(1), Unit: "tsp"},
+	}, references)
+
+	assert.Equal(t, 3, nutrition.EstimatedIngredients)
+	assert.Equal(t, 6, nutrition.TotalIngredients)
+	assert.InDelta(t, 143+884*0.138+330, nutrition.Total.Calories, 0.001)
+	assert.InDelta(t, 12.6+62, nutrition.Total.Protein, 0.001)
+	assert.InDelta(t, 142+148, nutrition.Total.Sodium, 0.001)
+}
+
+func TestNutritionToResponse(t *testing.T) {
+	assert.Nil(t, model.Nutrition{TotalIngredients: 2}.ToResponse(2))
+
+	nutrition := model.Nutrition{
+		Total:                model.NutritionFacts{Calories: 1001, Protein: 40.04, Fat: 20, Carbs: 100.26, Sodium: 2001},
+		EstimatedIngredients: 2,
+		TotalIngredients:     3,
+	}
+
+	response := nutrition.ToResponse(4)
+
+	assert.Equal(t, float64(1001), response.Total.Calories)
+	assert.Equal(t, 100.3, response.Total.Carbs)
+	assert.Equal(t, float64(250), response.PerServing.Calories)
+	assert.Equal(t, 10.0, response.PerServing.Protein)
+	assert.Equal(t, float64(500), response.PerServing.Sodium)
+	assert.Equal(t, 2, response.EstimatedIngredients)
+	assert.Equal(t, 3, response.TotalIngredients)
+}
+
+func TestScaleServingsScalesNutrition(t *testing.T) {
+	recipe := model.FoodRecipe{
+		Servings:  2,
+		Nutrition: model.Nutrition{Total: model.NutritionFacts{Calories: 600}, EstimatedIngredients: 1},
+	}.ScaleServings(4)
+
+	assert.Equal(t, float64(1200), recipe.Nutrition.Total.Calories)
+	assert.Equal(t, float64(300), recipe.ToResponse().Nutrition.PerServing.Calories)
+}
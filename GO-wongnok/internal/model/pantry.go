@@ -0,0 +1,154 @@
+package model
+
+import (
+	"math"
+	"strings"
+	"wongnok/internal/model/dto"
+
+	"gorm.io/gorm"
+)
+
+// PantryItem วัตถุดิบที่ผู้ใช้มีอยู่ที่บ้าน
+// IngredientID ผูกกับพจนานุกรมตามชื่อเหมือนวัตถุดิบในสูตร (nil เมื่อยังไม่รู้จักชื่อนี้)
+type PantryItem struct {
+	gorm.Model
+	UserID       string
+	Name         string
+	IngredientID *uint
+}
+
+func (item PantryItem) ToResponse() dto.PantryItemResponse {
+	return dto.PantryItemResponse{
+		ID:        item.ID,
+		Name:      item.Name,
+		CreatedAt: item.CreatedAt,
+	}
+}
+
+type PantryItems []PantryItem
+
+// FromRequest แปลงชื่อเป็นรายการ ตัดช่องว่างและชื่อซ้ำ (ไม่สนตัวพิมพ์เล็ก/ใหญ่) รวมถึงชื่อที่มีอยู่แล้ว
+func (items PantryItems) FromRequest(request dto.PantryRequest, userID string) PantryItems {
+	seen := make(map[string]bool, len(items)+len(request.Names))
+	for _, item := range items {
+		seen[strings.ToLower(item.Name)] = true
+	}
+
+	results := make(PantryItems, 0, len(request.Names))
+	for _, name := range request.Names {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[strings.ToLower(name)] {
+			continue
+		}
+		seen[strings.ToLower(name)] = true
+		results = append(results, PantryItem{UserID: userID, Name: name})
+	}
+
+	return results
+}
+
+func (items PantryItems) ToResponse() dto.PantryItemsResponse {
+	var results = make([]dto.PantryItemResponse, 0)
+
+	for _, item := range items {
+		results = append(results, item.ToResponse())
+	}
+
+	return dto.PantryItemsResponse{
+		Results: results,
+	}
+}
+
+// Covers มีวัตถุดิบนี้แล้วหรือไม่ ถือว่ามีเมื่อผูกกับวัตถุดิบเดียวกันในพจนานุกรม (เช่น "ไข่ไก่" กับ "eggs")
+// หรือชื่อตรงกันทั้งชื่อ (ไม่สนตัวพิมพ์และช่องว่างหัวท้าย)
+// ไม่เทียบแค่บางส่วนของชื่อ เพราะ "ปลา" จะกลายเป็นมี "น้ำปลา" และ "egg" จะกลายเป็นมี "eggplant"
+// ต้องตรงกับเงื่อนไขใน SQL ของ foodrecipe.Repository.MatchPantry
+func (items PantryItems) Covers(ingredient RecipeIngredient) bool {
+	term := pantryTerm(ingredient.Name)
+
+	for _, item := range items {
+		if item.IngredientID != nil && ingredient.IngredientID != nil && *item.IngredientID == *ingredient.IngredientID {
+			return true
+		}
+		if pantryTerm(item.Name) == term {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pantryTerm รูปที่ใช้เทียบชื่อ ตรงกับ LOWER(BTRIM(name)) ใน SQL
+func pantryTerm(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Missing ชื่อวัตถุดิบของสูตรที่ยังไม่มี
+func (items PantryItems) Missing(ingredients RecipeIngredients) []string {
+	missing := make([]string, 0)
+
+	for _, ingredient := range ingredients {
+		if !items.Covers(ingredient) {
+			missing = append(missing, ingredient.Name)
+		}
+	}
+
+	return missing
+}
+
+// PantryMatch สูตรที่ทำได้จากของในตู้ พร้อมจำนวนวัตถุดิบทั้งหมดและที่มีแล้ว
+type PantryMatch struct {
+	FoodRecipeID    uint
+	IngredientCount int
+	OwnedCount      int
+	FoodRecipe      FoodRecipe `gorm:"-"`
+	Missing         []string   `gorm:"-"`
+}
+
+// Ratio สัดส่วนวัตถุดิบที่มีแล้ว ปัดทศนิยม 2 ตำแหน่ง
+func (match PantryMatch) Ratio() float64 {
+	if match.IngredientCount == 0 {
+		return 0
+	}
+	return math.Round(float64(match.OwnedCount)/float64(match.IngredientCount)*100) / 100
+}
+
+func (match PantryMatch) ToResponse() dto.PantryMatchResponse {
+	missing := match.Missing
+	if missing == nil {
+		missing = make([]string, 0)
+	}
+
+	return dto.PantryMatchResponse{
+		FoodRecipe:      match.FoodRecipe.ToResponse(),
+		IngredientCount: match.IngredientCount,
+		OwnedCount:      match.OwnedCount,
+		Match:           match.Ratio(),
+		Missing:         missing,
+	}
+}
+
+type PantryMatches []PantryMatch
+
+func (matches PantryMatches) ToResponse(total int64) dto.PantryMatchesResponse {
+	var results = make([]dto.PantryMatchResponse, 0)
+
+	for _, match := range matches {
+		results = append(results, match.ToResponse())
+	}
+
+	return dto.PantryMatchesResponse{
+		Total:   total,
+		Results: results,
+	}
+}
+
+const DefaultPantryMatchLimit = 20
+
+// PantryMatchQuery ตัวเลือกของ match-pantry
+type PantryMatchQuery struct {
+	MaxMissing *int   `form:"maxMissing" binding:"omitempty,min=0"` // ขาดวัตถุดิบได้ไม่เกินกี่อย่าง
+	Page       int    `form:"page" binding:"omitempty,min=1"`
+	Limit      int    `form:"limit" binding:"omitempty,min=1,max=50"`
+	Units      string `form:"units" binding:"omitempty,oneof=metric imperial"`
+}
@@ -0,0 +1,51 @@
+package model_test
+
+import (
+	"testing"
+
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPantryItemsFromRequest(t *testing.T) {
+	existing := model.PantryItems{{UserID: "user-1", Name: "Garlic"}}
+
+	items := existing.FromRequest(dto.PantryRequest{Names: []string{" garlic ", "Eggs", "eggs", "  ", "ไข่ไก่"}}, "user-1")
+
+	assert.Len(t, items, 2)
+	assert.Equal(t, "Eggs", items[0].Name)
+	assert.Equal(t, "ไข่ไก่", items[1].Name)
+	assert.Equal(t, "user-1", items[1].UserID)
+}
+
+func TestPantryItemsMissing(t *testing.T) {
+	pantry := model.PantryItems{{Name: "garlic"}, {Name: "Jasmine rice"}, {Name: "ไข่ไก่"}, {Name: "ปลา"}, {Name: "egg"}, {Name: "salt"}}
+	ingredients := model.RecipeIngredients{
+		{Name: "Garlic "},
+		{Name: "jasmine rice"},
+		{Name: "ไข่ไก่"},
+		{Name: "น้ำปลา"},
+		{Name: "Eggplant"},
+		{Name: "unsalted butter"},
+	}
+
+	assert.True(t, pantry.Covers(model.RecipeIngredient{Name: "GARLIC"}))
+	assert.False(t, pantry.Covers(model.RecipeIngredient{Name: "onion"}))
+	assert.Equal(t, []string{"น้ำปลา", "Eggplant", "unsalted butter"}, pantry.Missing(ingredients))
+}
+
+func TestPantryItemsCoversLinkedIngredient(t *testing.T) {
+	pantry := model.PantryItems{{Name: "ไข่ไก่", IngredientID: linked(1)}, {Name: "ปลา"}}
+
+	assert.True(t, pantry.Covers(model.RecipeIngredient{Name: "Eggs", IngredientID: linked(1)}))
+	assert.False(t, pantry.Covers(model.RecipeIngredient{Name: "น้ำปลา", IngredientID: linked(21)}))
+}
+
+func TestPantryMatchToResponse(t *testing.T) {
+	response := model.PantryMatch{FoodRecipeID: 1, IngredientCount: 3, OwnedCount: 2}.ToResponse()
+
+	assert.Equal(t, 0.67, response.Match)
+	assert.NotNil(t, response.Missing)
+}
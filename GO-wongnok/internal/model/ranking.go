@@ -0,0 +1,50 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// BayesianPrior ค่าตั้งต้นของคะแนนเฉลี่ยแบบ Bayesian
+// สูตรที่มีคนให้คะแนนน้อยจะถูกดึงเข้าหา Mean จนกว่าจำนวนคะแนนจะมากกว่า MinVotes พอสมควร
+// คะแนน = (ผลรวมคะแนน + MinVotes*Mean) / (จำนวนคะแนน + MinVotes)
+// service เป็นผู้กำหนดค่า (จาก config หรือค่าเฉลี่ยของระบบ) แล้วส่งมากับ query
+type BayesianPrior struct {
+	MinVotes float64
+	Mean     float64
+}
+
+func (prior BayesianPrior) Score(sum float64, count int) float64 {
+	votes := float64(count) + prior.MinVotes
+	if votes <= 0 {
+		return 0
+	}
+	return (sum + prior.MinVotes*prior.Mean) / votes
+}
+
+// RecipeMeanRating ค่าเฉลี่ยของคะแนนทุกรายการในระบบ ใช้เป็น prior เมื่อไม่ได้กำหนด Mean
+const RecipeMeanRating = "(SELECT COALESCE(SUM(rating_sum) / NULLIF(SUM(rating_count), 0), 0) FROM food_recipes WHERE deleted_at IS NULL)"
+
+// SQL คืนนิพจน์คะแนน Bayesian ของ food_recipes ตาม prior
+// ค่าตัวเลขมาจาก service ไม่ใช่ผู้ใช้ จึงใส่ลงใน SQL ได้ตรง ๆ ทำให้ใช้ได้ทั้งใน ORDER BY และเงื่อนไข cursor
+func (prior BayesianPrior) SQL() string {
+	mean := RecipeMeanRating
+	if prior.Mean > 0 {
+		mean = formatFloat(prior.Mean)
+	}
+	minVotes := formatFloat(prior.MinVotes)
+
+	return fmt.Sprintf("COALESCE((food_recipes.rating_sum + %s * %s) / NULLIF(food_recipes.rating_count + %s, 0), 0)", minVotes, mean, minVotes)
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// TopRecipesQuery ตัวเลือกของรายการสูตรคะแนนสูงสุด
+type TopRecipesQuery struct {
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=50"`
+	Units string `form:"units" binding:"omitempty,oneof=metric imperial"`
+}
+
+const DefaultTopRecipesLimit = 10
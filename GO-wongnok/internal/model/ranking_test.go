@@ -0,0 +1,85 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestBayesianPriorScore(t *testing.T) {
+	prior := model.BayesianPrior{MinVotes: 10, Mean: 3.5}
+
+	t.Run("ShouldRankManyGoodRatingsAboveSinglePerfectOne", func(t *testing.T) {
+		single := prior.Score(5, 1)
+		many := prior.Score(4.8*200, 200)
+
+		assert.Greater(t, many, single)
+	})
+
+	t.Run("ShouldReturnMeanWhenNoRatings", func(t *testing.T) {
+		assert.Equal(t, 3.5, prior.Score(0, 0))
+	})
+
+	t.Run("ShouldFallBackToPlainAverageWithoutPrior", func(t *testing.T) {
+		assert.Equal(t, 4.0, model.BayesianPrior{}.Score(8, 2))
+		assert.Equal(t, 0.0, model.BayesianPrior{}.Score(0, 0))
+	})
+}
+
+func TestBayesianPriorSQL(t *testing.T) {
+	t.Run("ShouldInlineConfiguredValues", func(t *testing.T) {
+		sql := model.BayesianPrior{MinVotes: 10, Mean: 3.5}.SQL()
+
+		assert.Equal(t, "COALESCE((food_recipes.rating_sum + 10 * 3.5) / NULLIF(food_recipes.rating_count + 10, 0), 0)", sql)
+	})
+
+	t.Run("ShouldUseOverallMeanWhenNotConfigured", func(t *testing.T) {
+		sql := model.BayesianPrior{MinVotes: 5}.SQL()
+
+		assert.Contains(t, sql, model.RecipeMeanRating)
+	})
+}
+
+func TestTopRatedSort(t *testing.T) {
+	prior := model.BayesianPrior{MinVotes: 10, Mean: 4}
+
+	t.Run("ShouldOrderByBayesianRating", func(t *testing.T) {
+		order := model.RecipeOrder(model.SortTopRated, prior)
+
+		assert.True(t, strings.HasPrefix(order, prior.SQL()+" desc"))
+		assert.True(t, strings.HasSuffix(order, "food_recipes.id desc"))
+	})
+
+	t.Run("ShouldUseBayesianRatingInCursor", func(t *testing.T) {
+		query := model.FoodRecipeQuery{Limit: 10, Sort: model.SortTopRated, Prior: prior}
+		query.Cursor = model.RecipeCursor{Sort: model.SortTopRated, Key: "4.2", ID: 3}.Encode()
+
+		after, err := query.AfterCursor()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "("+prior.SQL()+", food_recipes.id) < (CAST(? AS NUMERIC), ?)", after.SQL)
+	})
+
+	t.Run("ShouldKeepMeanOfFirstPage", func(t *testing.T) {
+		query := model.FoodRecipeQuery{Limit: 1, Sort: model.SortTopRated}.WithPrior(model.BayesianPrior{MinVotes: 10, Mean: 3.8})
+		key := "4.1"
+		_, next := model.FoodRecipes{{Model: gorm.Model{ID: 7}, SortKey: &key}, {}}.Page(query)
+
+		query = model.FoodRecipeQuery{Limit: 1, Sort: model.SortTopRated, Cursor: next}.WithPrior(model.BayesianPrior{MinVotes: 10, Mean: 3.9})
+
+		assert.Equal(t, model.BayesianPrior{MinVotes: 10, Mean: 3.8}, query.Prior)
+	})
+
+	t.Run("ShouldCalculateBayesianRatingForRecipes", func(t *testing.T) {
+		recipes := model.FoodRecipes{{RatingCount: 10, RatingSum: 50}}
+
+		recipes = recipes.CalculateBayesianRatings(prior)
+
+		assert.Equal(t, 4.5, recipes[0].BayesianRating)
+		assert.Equal(t, 4.5, recipes[0].ToResponse().BayesianRating)
+	})
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"strings"
 	"wongnok/internal/model/dto"
 
 	"gorm.io/gorm"
@@ -11,21 +12,45 @@ type Rating struct {
 	Score        float64
 	FoodRecipeID uint
 	UserID       string
+	User         User
+	// รีวิวแบบข้อความ (ไม่บังคับ)
+	Title     string
+	Body      string
+	PhotoURLs StringList `gorm:"column:photo_urls;type:jsonb"`
+	// จำนวนโหวตว่ามีประโยชน์ (นับจาก review_votes ตอนดึงข้อมูล)
+	HelpfulCount int64 `gorm:"->;-:migration"`
 }
 
 func (rating Rating) FromRequest(request dto.RatingRequest) Rating {
 	return Rating{
-		Score: request.Score,
+		Score:     request.Score,
+		Title:     strings.TrimSpace(request.Title),
+		Body:      strings.TrimSpace(request.Body),
+		PhotoURLs: StringList(request.PhotoURLs),
 	}
 }
 
 func (rating Rating) ToResponse() dto.RatingResponse {
-	return dto.RatingResponse{
+	response := dto.RatingResponse{
 		ID:           rating.ID,
 		Score:        rating.Score,
 		FoodRecipeID: rating.FoodRecipeID,
 		UserID:       rating.UserID,
+		Title:        rating.Title,
+		Body:         rating.Body,
+		PhotoURLs:    rating.PhotoURLs,
+		HelpfulCount: rating.HelpfulCount,
+		CreatedAt:    rating.CreatedAt,
+		UpdatedAt:    rating.UpdatedAt,
 	}
+
+	// แสดงผู้รีวิวเฉพาะเมื่อโหลด User มาด้วย
+	if rating.User.ID != "" {
+		user := rating.User.ToResponse()
+		response.User = &user
+	}
+
+	return response
 }
 
 // Ratings คือ "ชุดของ Rating หลาย ๆ อัน"
@@ -44,3 +69,26 @@ func (ratings Ratings) ToResponse() dto.RatingsResponse {
 		Results: results,
 	}
 }
+
+const DefaultRatingLimit = 10
+
+// RatingQuery การแบ่งหน้าและเรียงรีวิวของสูตรอาหาร
+type RatingQuery struct {
+	Page  int    `form:"page" binding:"omitempty,min=1"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=50"`
+	Sort  string `form:"sort" binding:"omitempty,oneof=newest most-helpful highest lowest"`
+}
+
+// OrderBy คืน ORDER BY ของ sort ที่เลือก (ค่าเริ่มต้นคือใหม่สุดก่อน) ปิดท้ายด้วย id เพื่อให้ลำดับคงที่
+func (query RatingQuery) OrderBy() string {
+	switch query.Sort {
+	case "most-helpful":
+		return "helpful_count desc, ratings.created_at desc, ratings.id desc"
+	case "highest":
+		return "ratings.score desc, ratings.created_at desc, ratings.id desc"
+	case "lowest":
+		return "ratings.score asc, ratings.created_at desc, ratings.id desc"
+	default:
+		return "ratings.created_at desc, ratings.id desc"
+	}
+}
@@ -0,0 +1,34 @@
+package model
+
+import "wongnok/internal/model/dto"
+
+// RatingHistogram จำนวนคะแนนแยกตามดาว 1-5 เก็บเป็นคอลัมน์ rating_star1..rating_star5 ใน food_recipes
+// คะแนนที่มีทศนิยมนับเข้าดาวที่ปัดลง เช่น 3.5 นับเป็น 3 ดาว
+type RatingHistogram struct {
+	Star1 int
+	Star2 int
+	Star3 int
+	Star4 int
+	Star5 int
+}
+
+func (histogram RatingHistogram) ToResponse() *dto.RatingHistogramResponse {
+	return &dto.RatingHistogramResponse{
+		Star1: histogram.Star1,
+		Star2: histogram.Star2,
+		Star3: histogram.Star3,
+		Star4: histogram.Star4,
+		Star5: histogram.Star5,
+	}
+}
+
+// RatingAggregateColumns คอลัมน์สรุปคะแนนใน food_recipes ซึ่งดูแลโดย rating repository เท่านั้น
+var RatingAggregateColumns = []string{
+	"rating_count",
+	"rating_sum",
+	"rating_star1",
+	"rating_star2",
+	"rating_star3",
+	"rating_star4",
+	"rating_star5",
+}
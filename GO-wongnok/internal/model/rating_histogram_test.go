@@ -0,0 +1,51 @@
+package model_test
+
+import (
+	"testing"
+
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRatingHistogramToResponse(t *testing.T) {
+	t.Run("ShouldMapEveryStar", func(t *testing.T) {
+		histogram := model.RatingHistogram{Star1: 1, Star2: 2, Star3: 3, Star4: 4, Star5: 5}
+
+		response := histogram.ToResponse()
+
+		assert.Equal(t, 1, response.Star1)
+		assert.Equal(t, 2, response.Star2)
+		assert.Equal(t, 3, response.Star3)
+		assert.Equal(t, 4, response.Star4)
+		assert.Equal(t, 5, response.Star5)
+	})
+}
+
+func TestCalculateAverageRatingFromAggregates(t *testing.T) {
+	t.Run("ShouldUseAggregateColumns", func(t *testing.T) {
+		recipe := model.FoodRecipe{RatingCount: 2, RatingSum: 8}
+
+		result := recipe.CalculateAverageRating()
+
+		assert.Equal(t, 4.0, result.AverageRating)
+	})
+
+	t.Run("ShouldPreferAggregatesOverLoadedRatings", func(t *testing.T) {
+		recipe := model.FoodRecipe{
+			RatingCount: 4,
+			RatingSum:   18,
+			Ratings:     model.Ratings{{Score: 1}},
+		}
+
+		result := recipe.CalculateAverageRating()
+
+		assert.Equal(t, 4.5, result.AverageRating)
+	})
+
+	t.Run("ShouldIncludeRatingCountInResponse", func(t *testing.T) {
+		recipe := model.FoodRecipe{RatingCount: 7}
+
+		assert.Equal(t, 7, recipe.ToResponse().RatingCount)
+	})
+}
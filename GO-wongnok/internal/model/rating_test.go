@@ -289,4 +289,52 @@ func TestRatingsToResponse(t *testing.T) {
 		assert.Equal(t, 2.0, result.Results[2].Score)
 		assert.Equal(t, uint(200), result.Results[2].FoodRecipeID)
 	})
-}
\ No newline at end of file
+}
+func TestRatingReview(t *testing.T) {
+	t.Run("ShouldMapReviewFromRequest", func(t *testing.T) {
+		request := dto.RatingRequest{
+			Score:     4,
+			Title:     "  อร่อยมาก ",
+			Body:      "ทำตามแล้วได้รสชาติเหมือนร้าน",
+			PhotoURLs: []string{"https://example.com/a.jpg"},
+		}
+
+		result := model.Rating{}.FromRequest(request)
+
+		assert.Equal(t, "อร่อยมาก", result.Title)
+		assert.Equal(t, request.Body, result.Body)
+		assert.Equal(t, model.StringList{"https://example.com/a.jpg"}, result.PhotoURLs)
+	})
+
+	t.Run("ShouldIncludeReviewerWhenLoaded", func(t *testing.T) {
+		rating := model.Rating{
+			Score:  5,
+			UserID: "user-1",
+			User:   model.User{ID: "user-1", FirstName: "Somchai"},
+		}
+
+		result := rating.ToResponse()
+
+		assert.NotNil(t, result.User)
+		assert.Equal(t, "Somchai", result.User.FirstName)
+	})
+
+	t.Run("ShouldIncludeHelpfulCount", func(t *testing.T) {
+		result := model.Rating{Score: 5, HelpfulCount: 7}.ToResponse()
+
+		assert.Equal(t, int64(7), result.HelpfulCount)
+	})
+
+	t.Run("ShouldOmitReviewerWhenNotLoaded", func(t *testing.T) {
+		result := model.Rating{Score: 5, UserID: "user-1"}.ToResponse()
+
+		assert.Nil(t, result.User)
+	})
+}
+
+func TestRatingQueryOrderBy(t *testing.T) {
+	assert.Equal(t, "ratings.created_at desc, ratings.id desc", model.RatingQuery{}.OrderBy())
+	assert.Equal(t, "helpful_count desc, ratings.created_at desc, ratings.id desc", model.RatingQuery{Sort: "most-helpful"}.OrderBy())
+	assert.Equal(t, "ratings.score desc, ratings.created_at desc, ratings.id desc", model.RatingQuery{Sort: "highest"}.OrderBy())
+	assert.Equal(t, "ratings.score asc, ratings.created_at desc, ratings.id desc", model.RatingQuery{Sort: "lowest"}.OrderBy())
+}
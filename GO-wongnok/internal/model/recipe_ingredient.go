@@ -0,0 +1,191 @@
+package model
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"wongnok/internal/model/dto"
+	"wongnok/internal/units"
+
+	"gorm.io/gorm"
+)
+
+type RecipeIngredient struct {
+	gorm.Model
+	FoodRecipeID uint
+	Position     int
+	Name         string
+	Quantity     *float64
+	Unit         string
+	Note         string
+	Group        string `gorm:"column:group_name"`
+	IngredientID *uint
+	Ingredient   *Ingredient
+}
+
+func (ingredient RecipeIngredient) FromRequest(request dto.RecipeIngredientRequest) RecipeIngredient {
+	return RecipeIngredient{
+		Name:     strings.TrimSpace(request.Name),
+		Quantity: request.Quantity,
+		Unit:     strings.TrimSpace(request.Unit),
+		Note:     strings.TrimSpace(request.Note),
+		Group:    strings.TrimSpace(request.Group),
+	}
+}
+
+func (ingredient RecipeIngredient) ToResponse() dto.RecipeIngredientResponse {
+	return dto.RecipeIngredientResponse{
+		ID:           ingredient.ID,
+		Position:     ingredient.Position,
+		Name:         ingredient.Name,
+		Quantity:     ingredient.Quantity,
+		Unit:         ingredient.Unit,
+		Note:         ingredient.Note,
+		Group:        ingredient.Group,
+		IngredientID: ingredient.IngredientID,
+	}
+}
+
+// String แปลงวัตถุดิบกลับเป็นข้อความแบบเดิม เช่น "200 g chicken"
+func (ingredient RecipeIngredient) String() string {
+	parts := make([]string, 0, 3)
+	if ingredient.Quantity != nil {
+		parts = append(parts, strconv.FormatFloat(*ingredient.Quantity, 'f', -1, 64))
+	}
+	if ingredient.Unit != "" {
+		parts = append(parts, ingredient.Unit)
+	}
+	parts = append(parts, ingredient.Name)
+
+	return strings.Join(parts, " ")
+}
+
+// Scale คูณปริมาณวัตถุดิบด้วย factor แล้วปัดเศษให้เหมาะกับหน่วย
+// วัตถุดิบที่ไม่ได้ระบุปริมาณ (เช่น "เกลือเล็กน้อย") จะคงเดิม
+func (ingredient RecipeIngredient) Scale(factor float64) RecipeIngredient {
+	if ingredient.Quantity == nil {
+		return ingredient
+	}
+
+	quantity := roundQuantity(*ingredient.Quantity*factor, ingredient.Unit, ingredient.Name)
+	ingredient.Quantity = &quantity
+
+	return ingredient
+}
+
+// ConvertUnits แปลงปริมาณให้อยู่ในระบบหน่วยที่ต้องการ
+// หน่วยที่แปลงไม่ได้ (เช่น "a pinch") จะคงค่าเดิมไว้
+func (ingredient RecipeIngredient) ConvertUnits(system units.System) RecipeIngredient {
+	if ingredient.Quantity == nil {
+		return ingredient
+	}
+
+	quantity, unit, err := units.Normalize(*ingredient.Quantity, ingredient.Unit, system)
+	if err != nil {
+		return ingredient
+	}
+
+	ingredient.Quantity = &quantity
+	ingredient.Unit = unit
+
+	return ingredient
+}
+
+func roundQuantity(quantity float64, unit string, name string) float64 {
+	measure, known := units.Lookup(unit)
+
+	switch {
+	case !known:
+		return math.Round(quantity*100) / 100
+	case measure.Kind == units.Count && (isEgg(name) || isEgg(unit)):
+		// ไข่แบ่งครึ่งไม่ได้ ปัดเป็นจำนวนเต็ม
+		return math.Max(1, math.Round(quantity))
+	case measure.Kind == units.Count:
+		return math.Max(0.5, roundTo(quantity, 0.5))
+	case measure.System == units.Kitchen:
+		// ช้อน/ถ้วย ปัดทีละ 1/4
+		return math.Max(0.25, roundTo(quantity, 0.25))
+	case measure.Factor == 1 && quantity >= 100:
+		// กรัม/มิลลิลิตร
+		return roundTo(quantity, 5)
+	case measure.Factor == 1 && quantity >= 10:
+		return math.Round(quantity)
+	default:
+		return math.Round(quantity*100) / 100
+	}
+}
+
+func isEgg(name string) bool {
+	name = strings.ToLower(name)
+	return strings.Contains(name, "egg") || strings.HasPrefix(name, "ไข่") || name == "ฟอง"
+}
+
+func roundTo(value float64, step float64) float64 {
+	return math.Round(value/step) * step
+}
+
+type RecipeIngredients []RecipeIngredient
+
+func (ingredients RecipeIngredients) FromRequest(requests []dto.RecipeIngredientRequest) RecipeIngredients {
+	var results = make(RecipeIngredients, 0, len(requests))
+
+	for index, request := range requests {
+		ingredient := RecipeIngredient{}.FromRequest(request)
+		ingredient.Position = index + 1
+		results = append(results, ingredient)
+	}
+
+	return results
+}
+
+func (ingredients RecipeIngredients) ToResponse() []dto.RecipeIngredientResponse {
+	var results = make([]dto.RecipeIngredientResponse, 0)
+
+	for _, ingredient := range ingredients {
+		results = append(results, ingredient.ToResponse())
+	}
+
+	return results
+}
+
+// String รวมวัตถุดิบเป็นข้อความคั่นด้วย comma เพื่อเก็บลงคอลัมน์ ingredient เดิม
+func (ingredients RecipeIngredients) String() string {
+	texts := make([]string, 0, len(ingredients))
+	for _, ingredient := range ingredients {
+		texts = append(texts, ingredient.String())
+	}
+
+	return strings.Join(texts, ", ")
+}
+
+var (
+	ingredientSeparator = regexp.MustCompile(`\s*[,\n]\s*`)
+	leadingQuantity     = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s+(.+)$`)
+)
+
+// ParseIngredients แยกข้อความวัตถุดิบแบบ free-text (คั่นด้วย comma หรือขึ้นบรรทัดใหม่)
+// ออกเป็นรายการ ถ้าขึ้นต้นด้วยตัวเลขจะถือเป็นปริมาณ ที่เหลือเป็นชื่อวัตถุดิบ
+// ใช้กติกาเดียวกับ migration ที่แยกข้อมูลเดิม
+func ParseIngredients(text string) RecipeIngredients {
+	var results = make(RecipeIngredients, 0)
+
+	for _, raw := range ingredientSeparator.Split(strings.TrimSpace(text), -1) {
+		if raw == "" {
+			continue
+		}
+
+		ingredient := RecipeIngredient{Name: raw}
+		if matches := leadingQuantity.FindStringSubmatch(raw); matches != nil {
+			if quantity, err := strconv.ParseFloat(matches[1], 64); err == nil {
+				ingredient.Quantity = &quantity
+				ingredient.Name = matches[2]
+			}
+		}
+		ingredient.Position = len(results) + 1
+
+		results = append(results, ingredient)
+	}
+
+	return results
+}
@@ -0,0 +1,209 @@
+package model_test
+
+import (
+	"testing"
+
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+	"wongnok/internal/units"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecipeIngredientsFromRequest(t *testing.T) {
+	t.Run("ShouldMapFieldsAndAssignPositions", func(t *testing.T) {
+		quantity := 200.0
+		requests := []dto.RecipeIngredientRequest{
+			{Name: " chicken ", Quantity: &quantity, Unit: "g", Note: "sliced"},
+			{Name: "fish sauce", Group: "sauce"},
+		}
+
+		result := model.RecipeIngredients{}.FromRequest(requests)
+
+		assert.Len(t, result, 2)
+		assert.Equal(t, "chicken", result[0].Name)
+		assert.Equal(t, 200.0, *result[0].Quantity)
+		assert.Equal(t, "g", result[0].Unit)
+		assert.Equal(t, "sliced", result[0].Note)
+		assert.Equal(t, 1, result[0].Position)
+		assert.Equal(t, "sauce", result[1].Group)
+		assert.Equal(t, 2, result[1].Position)
+	})
+}
+
+func TestRecipeIngredientsString(t *testing.T) {
+	t.Run("ShouldJoinIngredientsAsText", func(t *testing.T) {
+		quantity := 1.5
+		ingredients := model.RecipeIngredients{
+			{Name: "chicken", Quantity: &quantity, Unit: "kg"},
+			{Name: "salt"},
+		}
+
+		assert.Equal(t, "1.5 kg chicken, salt", ingredients.String())
+	})
+}
+
+func TestParseIngredients(t *testing.T) {
+	t.Run("ShouldSplitCommaSeparatedText", func(t *testing.T) {
+		result := model.ParseIngredients("Spaghetti, Eggs,Parmesan ,  Black Pepper")
+
+		assert.Len(t, result, 4)
+		assert.Equal(t, "Spaghetti", result[0].Name)
+		assert.Equal(t, "Black Pepper", result[3].Name)
+		assert.Equal(t, 4, result[3].Position)
+		assert.Nil(t, result[0].Quantity)
+	})
+
+	t.Run("ShouldExtractLeadingQuantity", func(t *testing.T) {
+		result := model.ParseIngredients("2 eggs\n200 g chicken")
+
+		assert.Len(t, result, 2)
+		assert.Equal(t, "eggs", result[0].Name)
+		assert.Equal(t, 2.0, *result[0].Quantity)
+		assert.Equal(t, "g chicken", result[1].Name)
+		assert.Equal(t, 200.0, *result[1].Quantity)
+	})
+
+	t.Run("ShouldSupportThaiText", func(t *testing.T) {
+		result := model.ParseIngredients("แป้ง, น้ำตาล, ไข่")
+
+		assert.Len(t, result, 3)
+		assert.Equal(t, "น้ำตาล", result[1].Name)
+	})
+
+	t.Run("ShouldSkipEmptyItems", func(t *testing.T) {
+		result := model.ParseIngredients(" , ,")
+
+		assert.Len(t, result, 0)
+	})
+}
+
+func TestFoodRecipeFromRequestIngredients(t *testing.T) {
+	t.Run("ShouldParseIngredientTextWhenNoStructuredIngredients", func(t *testing.T) {
+		request := dto.FoodRecipeRequest{Ingredient: "Rice, Chicken"}
+
+		result := model.FoodRecipe{}.FromRequest(request, model.Claims{ID: "user_id"})
+
+		assert.Equal(t, "Rice, Chicken", result.Ingredient)
+		assert.Len(t, result.Ingredients, 2)
+		assert.Equal(t, "Chicken", result.Ingredients[1].Name)
+	})
+
+	t.Run("ShouldBuildIngredientTextFromStructuredIngredients", func(t *testing.T) {
+		quantity := 3.0
+		request := dto.FoodRecipeRequest{
+			Ingredients: []dto.RecipeIngredientRequest{
+				{Name: "eggs", Quantity: &quantity},
+				{Name: "soy sauce", Group: "sauce"},
+			},
+		}
+
+		result := model.FoodRecipe{}.FromRequest(request, model.Claims{ID: "user_id"})
+
+		assert.Equal(t, "3 eggs, soy sauce", result.Ingredient)
+		assert.Len(t, result.Ingredients, 2)
+
+		response := result.ToResponse()
+		assert.Len(t, response.Ingredients, 2)
+		assert.Equal(t, "sauce", response.Ingredients[1].Group)
+	})
+}
+
+func TestRecipeIngredientScale(t *testing.T) {
+	quantity := func(value float64) *float64 { return &value }
+
+	testCases := []struct {
+		name       string
+		ingredient model.RecipeIngredient
+		factor     float64
+		expected   float64
+	}{
+		{"ShouldRoundEggsToWholeNumber", model.RecipeIngredient{Name: "eggs", Quantity: quantity(3)}, 0.5, 2},
+		{"ShouldKeepAtLeastOneEgg", model.RecipeIngredient{Name: "ไข่ไก่", Unit: "ฟอง", Quantity: quantity(1)}, 0.25, 1},
+		{"ShouldRoundSpoonsToQuarter", model.RecipeIngredient{Name: "sugar", Unit: "tsp", Quantity: quantity(1)}, 1.0 / 3.0, 0.25},
+		{"ShouldRoundThaiSpoonsToQuarter", model.RecipeIngredient{Name: "น้ำปลา", Unit: "ช้อนโต๊ะ", Quantity: quantity(2)}, 1.5, 3},
+		{"ShouldRoundCountToHalf", model.RecipeIngredient{Name: "onion", Quantity: quantity(1)}, 1.3, 1.5},
+		{"ShouldRoundLargeGramsToFive", model.RecipeIngredient{Name: "chicken", Unit: "g", Quantity: quantity(200)}, 4.0 / 3.0, 265},
+		{"ShouldRoundGramsToWhole", model.RecipeIngredient{Name: "butter", Unit: "g", Quantity: quantity(15)}, 1.5, 23},
+		{"ShouldRoundUnknownUnitsToTwoDecimals", model.RecipeIngredient{Name: "milk", Unit: "oz", Quantity: quantity(1)}, 1.0 / 3.0, 0.33},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := tc.ingredient.Scale(tc.factor)
+
+			assert.Equal(t, tc.expected, *result.Quantity)
+		})
+	}
+
+	t.Run("ShouldKeepIngredientWithoutQuantity", func(t *testing.T) {
+		result := model.RecipeIngredient{Name: "salt"}.Scale(2)
+
+		assert.Nil(t, result.Quantity)
+	})
+}
+
+func TestFoodRecipeScaleServings(t *testing.T) {
+	quantity := 200.0
+	recipe := model.FoodRecipe{
+		Servings:   2,
+		Ingredient: "200 g chicken, salt",
+		Ingredients: model.RecipeIngredients{
+			{Name: "chicken", Unit: "g", Quantity: &quantity},
+			{Name: "salt"},
+		},
+	}
+
+	t.Run("ShouldScaleQuantitiesAndIngredientText", func(t *testing.T) {
+		result := recipe.ScaleServings(4)
+
+		assert.Equal(t, 4, result.Servings)
+		assert.Equal(t, 2, result.OriginalServings)
+		assert.Equal(t, 400.0, *result.Ingredients[0].Quantity)
+		assert.Equal(t, "400 g chicken, salt", result.Ingredient)
+
+		// Original recipe must not change
+		assert.Equal(t, 200.0, *recipe.Ingredients[0].Quantity)
+	})
+
+	t.Run("ShouldReturnRecipeUnchangedWhenServingsAreEqual", func(t *testing.T) {
+		result := recipe.ScaleServings(2)
+
+		assert.Equal(t, 0, result.OriginalServings)
+		assert.Equal(t, 200.0, *result.Ingredients[0].Quantity)
+	})
+}
+
+func TestFoodRecipeFromRequestServings(t *testing.T) {
+	t.Run("ShouldKeepExistingServingsWhenRequestHasNone", func(t *testing.T) {
+		result := model.FoodRecipe{Servings: 4}.FromRequest(dto.FoodRecipeRequest{}, model.Claims{})
+
+		assert.Equal(t, 4, result.Servings)
+	})
+
+	t.Run("ShouldDefaultServingsForNewRecipe", func(t *testing.T) {
+		result := model.FoodRecipe{}.FromRequest(dto.FoodRecipeRequest{}, model.Claims{})
+
+		assert.Equal(t, model.DefaultServings, result.Servings)
+	})
+}
+
+func TestFoodRecipeConvertUnits(t *testing.T) {
+	t.Run("ShouldConvertKnownUnitsAndKeepUnknownUnits", func(t *testing.T) {
+		chicken := 1.0
+		salt := 1.0
+		recipe := model.FoodRecipe{
+			Ingredients: model.RecipeIngredients{
+				{Name: "chicken", Unit: "lb", Quantity: &chicken},
+				{Name: "salt", Unit: "a pinch", Quantity: &salt},
+			},
+		}
+
+		result := recipe.ConvertUnits(units.Metric)
+
+		assert.Equal(t, 454.0, *result.Ingredients[0].Quantity)
+		assert.Equal(t, "g", result.Ingredients[0].Unit)
+		assert.Equal(t, "a pinch", result.Ingredients[1].Unit)
+		assert.Equal(t, "454 g chicken, 1 a pinch salt", result.Ingredient)
+	})
+}
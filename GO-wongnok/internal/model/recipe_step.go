@@ -0,0 +1,108 @@
+package model
+
+import (
+	"regexp"
+	"strings"
+	"wongnok/internal/model/dto"
+
+	"gorm.io/gorm"
+)
+
+type RecipeStep struct {
+	gorm.Model
+	FoodRecipeID    uint
+	Position        int
+	Text            string
+	DurationSeconds *int
+	ImageURL        *string
+}
+
+func (step RecipeStep) FromRequest(request dto.RecipeStepRequest) RecipeStep {
+	return RecipeStep{
+		Model:           gorm.Model{ID: request.ID},
+		Text:            strings.TrimSpace(request.Text),
+		DurationSeconds: request.DurationSeconds,
+		ImageURL:        request.ImageURL,
+	}
+}
+
+func (step RecipeStep) ToResponse() dto.RecipeStepResponse {
+	return dto.RecipeStepResponse{
+		ID:              step.ID,
+		Position:        step.Position,
+		Text:            step.Text,
+		DurationSeconds: step.DurationSeconds,
+		ImageURL:        step.ImageURL,
+	}
+}
+
+type RecipeSteps []RecipeStep
+
+func (steps RecipeSteps) FromRequest(requests []dto.RecipeStepRequest) RecipeSteps {
+	var results = make(RecipeSteps, 0, len(requests))
+
+	for index, request := range requests {
+		step := RecipeStep{}.FromRequest(request)
+		step.Position = index + 1
+		results = append(results, step)
+	}
+
+	return results
+}
+
+func (steps RecipeSteps) ToResponse() []dto.RecipeStepResponse {
+	var results = make([]dto.RecipeStepResponse, 0)
+
+	for _, step := range steps {
+		results = append(results, step.ToResponse())
+	}
+
+	return results
+}
+
+// String รวมขั้นตอนเป็นข้อความทีละบรรทัด เพื่อเก็บลงคอลัมน์ instruction เดิม
+func (steps RecipeSteps) String() string {
+	texts := make([]string, 0, len(steps))
+	for _, step := range steps {
+		texts = append(texts, step.Text)
+	}
+
+	return strings.Join(texts, "\n")
+}
+
+// IDs คืน id ของขั้นตอนที่มีอยู่แล้วในฐานข้อมูล (ไม่รวมขั้นตอนใหม่)
+func (steps RecipeSteps) IDs() []uint {
+	ids := make([]uint, 0, len(steps))
+	for _, step := range steps {
+		if step.ID != 0 {
+			ids = append(ids, step.ID)
+		}
+	}
+
+	return ids
+}
+
+var (
+	stepSeparator = regexp.MustCompile(`\s*\n\s*`)
+	stepNumbering = regexp.MustCompile(`^\d+\s*[.)]\s*`)
+)
+
+// ParseSteps แยกข้อความวิธีทำแบบ free-text ออกเป็นขั้นตอนทีละบรรทัด
+// และตัดเลขลำดับนำหน้าออก เช่น "1. ต้มน้ำ" -> "ต้มน้ำ"
+func ParseSteps(text string) RecipeSteps {
+	var results = make(RecipeSteps, 0)
+
+	for _, raw := range stepSeparator.Split(strings.TrimSpace(text), -1) {
+		raw = strings.TrimSpace(stepNumbering.ReplaceAllString(raw, ""))
+		if raw == "" {
+			continue
+		}
+
+		results = append(results, RecipeStep{
+			Position: len(results) + 1,
+			Text:     raw,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,74 @@
+package model_test
+
+import (
+	"testing"
+
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecipeStepsFromRequest(t *testing.T) {
+	t.Run("ShouldKeepIDsAndAssignPositionsByOrder", func(t *testing.T) {
+		duration := 300
+		requests := []dto.RecipeStepRequest{
+			{ID: 7, Text: "Boil water"},
+			{Text: " Cook noodles ", DurationSeconds: &duration},
+		}
+
+		result := model.RecipeSteps{}.FromRequest(requests)
+
+		assert.Len(t, result, 2)
+		assert.Equal(t, uint(7), result[0].ID)
+		assert.Equal(t, 1, result[0].Position)
+		assert.Equal(t, "Cook noodles", result[1].Text)
+		assert.Equal(t, 2, result[1].Position)
+		assert.Equal(t, 300, *result[1].DurationSeconds)
+		assert.Equal(t, []uint{7}, result.IDs())
+	})
+}
+
+func TestParseSteps(t *testing.T) {
+	t.Run("ShouldSplitLinesAndStripNumbering", func(t *testing.T) {
+		result := model.ParseSteps("1. Boil water\n2) Cook noodles\n\n 3 . Serve")
+
+		assert.Len(t, result, 3)
+		assert.Equal(t, "Boil water", result[0].Text)
+		assert.Equal(t, "Cook noodles", result[1].Text)
+		assert.Equal(t, "Serve", result[2].Text)
+		assert.Equal(t, 3, result[2].Position)
+	})
+
+	t.Run("ShouldKeepSingleLineAsOneStep", func(t *testing.T) {
+		result := model.ParseSteps("ทอดกล้วยชุบแป้ง จนเหลืองกรอบ")
+
+		assert.Len(t, result, 1)
+		assert.Equal(t, "ทอดกล้วยชุบแป้ง จนเหลืองกรอบ", result[0].Text)
+	})
+}
+
+func TestFoodRecipeFromRequestSteps(t *testing.T) {
+	t.Run("ShouldBuildInstructionFromSteps", func(t *testing.T) {
+		request := dto.FoodRecipeRequest{
+			Steps: []dto.RecipeStepRequest{
+				{Text: "Boil water"},
+				{Text: "Cook noodles"},
+			},
+		}
+
+		result := model.FoodRecipe{}.FromRequest(request, model.Claims{ID: "user_id"})
+
+		assert.Equal(t, "Boil water\nCook noodles", result.Instruction)
+		assert.Len(t, result.ToResponse().Steps, 2)
+	})
+
+	t.Run("ShouldParseStepsFromInstruction", func(t *testing.T) {
+		request := dto.FoodRecipeRequest{Instruction: "Boil water\nCook noodles"}
+
+		result := model.FoodRecipe{}.FromRequest(request, model.Claims{ID: "user_id"})
+
+		assert.Equal(t, "Boil water\nCook noodles", result.Instruction)
+		assert.Len(t, result.Steps, 2)
+	})
+}
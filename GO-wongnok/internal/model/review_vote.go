@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// ReviewVote การโหวตว่ารีวิว (rating ที่มีข้อความ) มีประโยชน์หรือไม่ ผู้ใช้หนึ่งคนโหวตรีวิวหนึ่งได้ครั้งเดียว
+type ReviewVote struct {
+	RatingID  uint   `gorm:"primaryKey"`
+	UserID    string `gorm:"primaryKey"`
+	Helpful   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,238 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"wongnok/internal/model/dto"
+	"wongnok/internal/units"
+
+	"gorm.io/gorm"
+)
+
+// ShoppingList รายการซื้อของของผู้ใช้ ที่รวมวัตถุดิบจากหลายสูตรไว้ด้วยกัน
+type ShoppingList struct {
+	gorm.Model
+	UserID string
+	Name   string
+	Items  ShoppingListItems
+}
+
+func (list ShoppingList) ToResponse() dto.ShoppingListResponse {
+	categories := make([]dto.ShoppingListCategoryResponse, 0)
+	checked := 0
+
+	for _, item := range list.Items {
+		if item.Checked {
+			checked++
+		}
+
+		// Items เรียงตามหมวดอยู่แล้ว (ดู NewShoppingListItems)
+		if len(categories) == 0 || categories[len(categories)-1].Category != item.Category {
+			categories = append(categories, dto.ShoppingListCategoryResponse{Category: item.Category, Items: make([]dto.ShoppingListItemResponse, 0)})
+		}
+		last := &categories[len(categories)-1]
+		last.Items = append(last.Items, item.ToResponse())
+	}
+
+	return dto.ShoppingListResponse{
+		ID:           list.ID,
+		Name:         list.Name,
+		ItemCount:    len(list.Items),
+		CheckedCount: checked,
+		Categories:   categories,
+		CreatedAt:    list.CreatedAt,
+		UpdatedAt:    list.UpdatedAt,
+	}
+}
+
+type ShoppingLists []ShoppingList
+
+func (lists ShoppingLists) ToResponse() dto.ShoppingListsResponse {
+	var results = make([]dto.ShoppingListResponse, 0)
+
+	for _, list := range lists {
+		results = append(results, list.ToResponse())
+	}
+
+	return dto.ShoppingListsResponse{
+		Results: results,
+	}
+}
+
+type ShoppingListItem struct {
+	gorm.Model
+	ShoppingListID uint
+	Position       int
+	Name           string
+	Quantity       *float64
+	Unit           string
+	Category       string
+	Checked        bool
+}
+
+func (item ShoppingListItem) ToResponse() dto.ShoppingListItemResponse {
+	return dto.ShoppingListItemResponse{
+		ID:       item.ID,
+		Name:     item.Name,
+		Quantity: item.Quantity,
+		Unit:     item.Unit,
+		Category: item.Category,
+		Checked:  item.Checked,
+	}
+}
+
+type ShoppingListItems []ShoppingListItem
+
+// shoppingTotal ยอดรวมของวัตถุดิบเดียวกันที่หน่วยบวกกันได้
+type shoppingTotal struct {
+	Identity string // ดู shoppingIdentity
+	Name     string
+	Category string
+	Unit     string     // หน่วยของรายการแรก
+	Measure  units.Unit // หน่วยที่รู้จัก (ถ้า Known)
+	Known    bool
+	SameUnit bool    // ทุกรายการใช้หน่วยเดียวกัน จะแสดงผลด้วยหน่วยนั้น
+	Amount   float64 // หน่วยฐานเมื่อเป็น mass/volume ไม่อย่างนั้นเป็นหน่วยเดิม
+	Counted  bool    // มีปริมาณระบุ
+}
+
+// shoppingIdentity วัตถุดิบที่ผูกกับพจนานุกรมใช้ id ("ไข่" กับ "eggs" เป็นอันเดียวกัน) ไม่อย่างนั้นใช้ชื่อ
+func shoppingIdentity(ingredient RecipeIngredient) string {
+	if ingredient.IngredientID != nil {
+		return fmt.Sprintf("#%d", *ingredient.IngredientID)
+	}
+	return strings.ToLower(strings.TrimSpace(ingredient.Name))
+}
+
+// shoppingCategory ใช้หมวดจากพจนานุกรมถ้ามี ไม่อย่างนั้นเดาจากชื่อ
+func shoppingCategory(ingredient RecipeIngredient) string {
+	if ingredient.Ingredient != nil && ingredient.Ingredient.Category != "" {
+		return ingredient.Ingredient.Category
+	}
+	return AisleCategory(ingredient.Name)
+}
+
+// shoppingGroup กลุ่มหน่วยที่บวกกันได้ น้ำหนักกับน้ำหนัก ปริมาตรกับปริมาตร
+// หน่วยนับและหน่วยที่ไม่รู้จักบวกกันได้เฉพาะหน่วยเดียวกัน
+func shoppingGroup(ingredient RecipeIngredient) (string, units.Unit, bool) {
+	if ingredient.Quantity == nil {
+		return "", units.Unit{}, false
+	}
+
+	measure, known := units.Lookup(ingredient.Unit)
+	switch {
+	case !known:
+		return "unit:" + strings.ToLower(strings.TrimSpace(ingredient.Unit)), measure, false
+	case measure.Kind == units.Count:
+		return "count:" + measure.Name, measure, true
+	default:
+		return string(measure.Kind), measure, true
+	}
+}
+
+func (total *shoppingTotal) add(ingredient RecipeIngredient) {
+	if ingredient.Quantity == nil {
+		return
+	}
+
+	// หน่วยใน key เดียวกันเป็นชนิดเดียวกันเสมอ (ดู shoppingGroup)
+	amount := *ingredient.Quantity
+	if total.Known && total.Measure.Kind != units.Count {
+		measure, _ := units.Lookup(ingredient.Unit)
+		amount *= measure.Factor
+	}
+
+	total.Amount += amount
+	total.Counted = true
+	total.SameUnit = total.SameUnit && strings.EqualFold(strings.TrimSpace(ingredient.Unit), strings.TrimSpace(total.Unit))
+}
+
+func (total shoppingTotal) item() ShoppingListItem {
+	item := ShoppingListItem{Name: total.Name, Unit: total.Unit, Category: total.Category}
+	if !total.Counted {
+		return item
+	}
+
+	quantity := total.Amount
+	switch {
+	case !total.Known || total.Measure.Kind == units.Count:
+	case total.SameUnit:
+		quantity = total.Amount / total.Measure.Factor
+	default:
+		// หน่วยต่างกัน แสดงเป็นหน่วย metric ที่อ่านง่าย
+		base := "g"
+		if total.Measure.Kind == units.Volume {
+			base = "ml"
+		}
+		quantity, item.Unit, _ = units.Normalize(total.Amount, base, units.Metric)
+	}
+
+	quantity = units.Round(quantity)
+	item.Quantity = &quantity
+
+	return item
+}
+
+// NewShoppingListItems รวมวัตถุดิบจากหลายสูตรเป็นรายการซื้อของ
+// วัตถุดิบเดียวกัน (ผูกกับพจนานุกรมอันเดียวกัน หรือชื่อเดียวกัน) ที่หน่วยบวกกันได้
+// จะรวมปริมาณเป็นรายการเดียว (เช่น 1 cup นม + 250 ml milk)
+// รายการที่ไม่ระบุปริมาณ (เช่น "เกลือ") จะตัดออกถ้ามีรายการชื่อเดียวกันที่ระบุปริมาณอยู่แล้ว
+// ผลลัพธ์เรียงตามหมวด (AisleCategories) แล้วตามชื่อ
+func NewShoppingListItems(recipes FoodRecipes) ShoppingListItems {
+	totals := make(map[string]*shoppingTotal)
+	keys := make([]string, 0)
+	counted := make(map[string]bool)
+
+	for _, recipe := range recipes {
+		ingredients := recipe.Ingredients
+		if len(ingredients) == 0 {
+			ingredients = ParseIngredients(recipe.Ingredient)
+		}
+
+		for _, ingredient := range ingredients {
+			name := strings.TrimSpace(ingredient.Name)
+			if name == "" {
+				continue
+			}
+
+			identity := shoppingIdentity(ingredient)
+			group, measure, known := shoppingGroup(ingredient)
+			key := identity + "|" + group
+
+			total, ok := totals[key]
+			if !ok {
+				total = &shoppingTotal{Identity: identity, Name: name, Category: shoppingCategory(ingredient), Unit: strings.TrimSpace(ingredient.Unit), Measure: measure, Known: known, SameUnit: true}
+				totals[key] = total
+				keys = append(keys, key)
+			}
+			total.add(ingredient)
+
+			if ingredient.Quantity != nil {
+				counted[identity] = true
+			}
+		}
+	}
+
+	items := make(ShoppingListItems, 0, len(keys))
+	for _, key := range keys {
+		total := totals[key]
+		if !total.Counted && counted[total.Identity] {
+			continue
+		}
+		items = append(items, total.item())
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Category != items[j].Category {
+			return aisleOrder(items[i].Category) < aisleOrder(items[j].Category)
+		}
+		return strings.ToLower(items[i].Name) < strings.ToLower(items[j].Name)
+	})
+
+	for index := range items {
+		items[index].Position = index + 1
+	}
+
+	return items
+}
@@ -0,0 +1,128 @@
+package model_test
+
+import (
+	"testing"
+
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func quantity(value float64) *float64 {
+	return &value
+}
+
+func TestAisleCategory(t *testing.T) {
+	assert.Equal(t, model.AisleProduce, model.AisleCategory("Garlic"))
+	assert.Equal(t, model.AisleMeat, model.AisleCategory("หมูสับ"))
+	assert.Equal(t, model.AisleSpices, model.AisleCategory("น้ำปลา"))
+	assert.Equal(t, model.AisleSeafood, model.AisleCategory("ปลาทู"))
+	assert.Equal(t, model.AislePantry, model.AisleCategory("coconut milk"))
+	assert.Equal(t, model.AisleOther, model.AisleCategory("unobtainium"))
+}
+
+func TestNewShoppingListItems(t *testing.T) {
+	recipes := model.FoodRecipes{
+		{Ingredients: model.RecipeIngredients{
+			{Name: "Milk", Quantity: quantity(1), Unit: "cup"},
+			{Name: "Garlic", Quantity: quantity(2), Unit: "cloves"},
+			{Name: "Salt"},
+			{Name: "Sugar", Quantity: quantity(1), Unit: "tbsp"},
+		}},
+		{Ingredients: model.RecipeIngredients{
+			{Name: "milk", Quantity: quantity(250), Unit: "ml"},
+			{Name: "garlic", Quantity: quantity(3), Unit: "clove"},
+			{Name: "Salt", Quantity: quantity(1), Unit: "tsp"},
+			{Name: "Sugar", Quantity: quantity(2), Unit: "tbsp"},
+			{Name: "Chicken", Quantity: quantity(1), Unit: "a pinch"},
+			{Name: "Chicken", Quantity: quantity(200), Unit: "g"},
+			{Name: "Garlic", Quantity: quantity(1), Unit: "หัว"},
+			{Name: "Onion", Quantity: quantity(2)},
+			{Name: "Onion", Quantity: quantity(1), Unit: "slice"},
+		}},
+	}
+
+	items := model.NewShoppingListItems(recipes)
+
+	find := func(name string, unit string) model.ShoppingListItem {
+		for _, item := range items {
+			if item.Name == name && item.Unit == unit {
+				return item
+			}
+		}
+		t.Fatalf("item %s (%s) not found", name, unit)
+		return model.ShoppingListItem{}
+	}
+
+	t.Run("ShouldConvertMixedUnitsToMetric", func(t *testing.T) {
+		assert.Equal(t, 487.0, *find("Milk", "ml").Quantity)
+	})
+
+	t.Run("ShouldKeepUnitWhenAllTheSame", func(t *testing.T) {
+		assert.Equal(t, 3.0, *find("Sugar", "tbsp").Quantity)
+	})
+
+	t.Run("ShouldSumCounts", func(t *testing.T) {
+		assert.Equal(t, 5.0, *find("Garlic", "cloves").Quantity)
+	})
+
+	t.Run("ShouldKeepDifferentCountUnitsSeparate", func(t *testing.T) {
+		assert.Equal(t, 1.0, *find("Garlic", "หัว").Quantity)
+		assert.Equal(t, 2.0, *find("Onion", "").Quantity)
+		assert.Equal(t, 1.0, *find("Onion", "slice").Quantity)
+	})
+
+	t.Run("ShouldDropUnquantifiedDuplicate", func(t *testing.T) {
+		salt := find("Salt", "tsp")
+		assert.Equal(t, 1.0, *salt.Quantity)
+		assert.Len(t, items, 9)
+	})
+
+	t.Run("ShouldKeepIncompatibleUnitsSeparate", func(t *testing.T) {
+		assert.Equal(t, 1.0, *find("Chicken", "a pinch").Quantity)
+		assert.Equal(t, 200.0, *find("Chicken", "g").Quantity)
+	})
+
+	t.Run("ShouldOrderByAisle", func(t *testing.T) {
+		assert.Equal(t, "Garlic", items[0].Name)
+		assert.Equal(t, model.AisleProduce, items[0].Category)
+		assert.Equal(t, 1, items[0].Position)
+		assert.Equal(t, model.AisleSpices, items[len(items)-1].Category)
+	})
+}
+
+func TestShoppingListToResponse(t *testing.T) {
+	list := model.ShoppingList{
+		Model: gorm.Model{ID: 1},
+		Name:  "Weekend",
+		Items: model.ShoppingListItems{
+			{Model: gorm.Model{ID: 1}, Name: "Garlic", Category: model.AisleProduce, Checked: true},
+			{Model: gorm.Model{ID: 2}, Name: "Onion", Category: model.AisleProduce},
+			{Model: gorm.Model{ID: 3}, Name: "Pork", Category: model.AisleMeat},
+		},
+	}
+
+	response := list.ToResponse()
+
+	assert.Equal(t, 3, response.ItemCount)
+	assert.Equal(t, 1, response.CheckedCount)
+	assert.Len(t, response.Categories, 2)
+	assert.Len(t, response.Categories[0].Items, 2)
+	assert.Equal(t, model.AisleMeat, response.Categories[1].Category)
+}
+
+func TestNewShoppingListItemsWithLinkedIngredients(t *testing.T) {
+	eggID := uint(1)
+	egg := &model.Ingredient{Model: gorm.Model{ID: eggID}, Name: "egg", Category: model.AisleDairy}
+
+	items := model.NewShoppingListItems(model.FoodRecipes{
+		{Ingredients: model.RecipeIngredients{{Name: "Eggs", Quantity: quantity(2), Unit: "eggs", IngredientID: &eggID, Ingredient: egg}}},
+		{Ingredients: model.RecipeIngredients{{Name: "ไข่", Quantity: quantity(3), Unit: "ฟอง", IngredientID: &eggID, Ingredient: egg}}},
+	})
+
+	assert.Len(t, items, 1)
+	assert.Equal(t, "Eggs", items[0].Name)
+	assert.Equal(t, 5.0, *items[0].Quantity)
+	assert.Equal(t, model.AisleDairy, items[0].Category)
+}
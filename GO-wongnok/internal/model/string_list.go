@@ -0,0 +1,41 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// StringList รายการข้อความที่เก็บเป็น JSONB ในคอลัมน์เดียว เช่น URL รูปภาพ
+type StringList []string
+
+func (list StringList) Value() (driver.Value, error) {
+	if list == nil {
+		list = StringList{}
+	}
+
+	data, err := json.Marshal(list)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+func (list *StringList) Scan(value interface{}) error {
+	var data []byte
+
+	switch v := value.(type) {
+	case nil:
+		*list = StringList{}
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into StringList", value)
+	}
+
+	return json.Unmarshal(data, list)
+}
@@ -0,0 +1,34 @@
+package model_test
+
+import (
+	"testing"
+
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringList(t *testing.T) {
+	t.Run("ShouldStoreNilAsEmptyArray", func(t *testing.T) {
+		value, err := model.StringList(nil).Value()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "[]", value)
+	})
+
+	t.Run("ShouldScanJSONB", func(t *testing.T) {
+		var list model.StringList
+
+		err := list.Scan([]byte(`["https://example.com/a.jpg","https://example.com/b.jpg"]`))
+
+		assert.NoError(t, err)
+		assert.Equal(t, model.StringList{"https://example.com/a.jpg", "https://example.com/b.jpg"}, list)
+	})
+
+	t.Run("ShouldScanNullAsEmpty", func(t *testing.T) {
+		list := model.StringList{"old"}
+
+		assert.NoError(t, list.Scan(nil))
+		assert.Empty(t, list)
+	})
+}
@@ -0,0 +1,50 @@
+package model
+
+import "wongnok/internal/model/dto"
+
+// ประเภทของคำแนะนำในช่องค้นหา
+const (
+	SuggestionRecipe     = "recipe"
+	SuggestionTag        = "tag"
+	SuggestionIngredient = "ingredient"
+)
+
+const DefaultSuggestionLimit = 5
+
+// Suggestion คำแนะนำหนึ่งรายการ Score คือ word_similarity ของ pg_trgm (0-1)
+type Suggestion struct {
+	Type  string
+	ID    uint
+	Text  string
+	Slug  string
+	Score float64
+}
+
+func (suggestion Suggestion) ToResponse() dto.SuggestionResponse {
+	return dto.SuggestionResponse{
+		Type: suggestion.Type,
+		ID:   suggestion.ID,
+		Text: suggestion.Text,
+		Slug: suggestion.Slug,
+	}
+}
+
+type Suggestions []Suggestion
+
+func (suggestions Suggestions) ToResponse() dto.SuggestionsResponse {
+	var results = make([]dto.SuggestionResponse, 0)
+
+	for _, suggestion := range suggestions {
+		results = append(results, suggestion.ToResponse())
+	}
+
+	return dto.SuggestionsResponse{
+		Results: results,
+	}
+}
+
+// SuggestQuery คำที่ผู้ใช้พิมพ์ในช่องค้นหา Limit คือจำนวนสูงสุดต่อประเภท
+type SuggestQuery struct {
+	Q     string `form:"q" binding:"required,max=100"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=10"`
+}
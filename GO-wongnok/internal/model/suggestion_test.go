@@ -0,0 +1,33 @@
+package model_test
+
+import (
+	"testing"
+
+	"wongnok/internal/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggestionsToResponse(t *testing.T) {
+	t.Run("ShouldKeepTypeAndOrder", func(t *testing.T) {
+		suggestions := model.Suggestions{
+			{Type: model.SuggestionRecipe, ID: 7, Text: "Spaghetti Carbonara", Score: 0.8},
+			{Type: model.SuggestionTag, ID: 2, Text: "Italian", Slug: "italian", Score: 0.5},
+			{Type: model.SuggestionIngredient, Text: "Carrot", Score: 0.4},
+		}
+
+		result := suggestions.ToResponse()
+
+		assert.Len(t, result.Results, 3)
+		assert.Equal(t, "recipe", result.Results[0].Type)
+		assert.Equal(t, "Spaghetti Carbonara", result.Results[0].Text)
+		assert.Equal(t, "italian", result.Results[1].Slug)
+		assert.Equal(t, uint(0), result.Results[2].ID)
+	})
+
+	t.Run("ShouldReturnEmptySlice", func(t *testing.T) {
+		result := model.Suggestions{}.ToResponse()
+
+		assert.NotNil(t, result.Results)
+	})
+}
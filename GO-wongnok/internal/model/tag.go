@@ -0,0 +1,92 @@
+package model
+
+import (
+	"strings"
+	"wongnok/internal/model/dto"
+
+	"gorm.io/gorm"
+)
+
+type Tag struct {
+	gorm.Model
+	Name     string
+	Slug     string
+	Category string
+}
+
+func (tag Tag) FromRequest(request dto.TagRequest) Tag {
+	slug := request.Slug
+	if slug == "" {
+		slug = request.Name
+	}
+
+	return Tag{
+		Model:    tag.Model,
+		Name:     strings.TrimSpace(request.Name),
+		Slug:     Slugify(slug),
+		Category: request.Category,
+	}
+}
+
+func (tag Tag) ToResponse() dto.TagResponse {
+	return dto.TagResponse{
+		ID:       tag.ID,
+		Name:     tag.Name,
+		Slug:     tag.Slug,
+		Category: tag.Category,
+	}
+}
+
+type Tags []Tag
+
+// TagsFromIDs สร้างรายการ tag จาก id ที่ client ส่งมา (ตัด id ซ้ำออก)
+func TagsFromIDs(ids []uint) Tags {
+	var results = make(Tags, 0, len(ids))
+	seen := make(map[uint]bool, len(ids))
+
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		results = append(results, Tag{Model: gorm.Model{ID: id}})
+	}
+
+	return results
+}
+
+func (tags Tags) IDs() []uint {
+	ids := make([]uint, 0, len(tags))
+	for _, tag := range tags {
+		ids = append(ids, tag.ID)
+	}
+
+	return ids
+}
+
+func (tags Tags) ToResponse() dto.TagsResponse {
+	var results = make([]dto.TagResponse, 0)
+
+	for _, tag := range tags {
+		results = append(results, tag.ToResponse())
+	}
+
+	return dto.TagsResponse{
+		Results: results,
+	}
+}
+
+// FoodRecipeTag ตารางเชื่อมระหว่างสูตรอาหารกับ tag
+type FoodRecipeTag struct {
+	FoodRecipeID uint `gorm:"primaryKey"`
+	TagID        uint `gorm:"primaryKey"`
+}
+
+type TagQuery struct {
+	Category string `form:"category" binding:"omitempty,oneof=cuisine meal_type diet occasion"`
+}
+
+// Slugify แปลงชื่อเป็น slug ตัวพิมพ์เล็กคั่นด้วย "-" (รองรับภาษาไทย)
+func Slugify(value string) string {
+	return strings.Join(strings.Fields(strings.ToLower(value)), "-")
+}
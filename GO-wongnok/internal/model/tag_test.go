@@ -0,0 +1,80 @@
+package model_test
+
+import (
+	"testing"
+
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestSlugify(t *testing.T) {
+	t.Run("ShouldLowercaseAndJoinWords", func(t *testing.T) {
+		assert.Equal(t, "street-food", model.Slugify("  Street   Food "))
+	})
+
+	t.Run("ShouldKeepThaiCharacters", func(t *testing.T) {
+		assert.Equal(t, "อาหาร-ไทย", model.Slugify("อาหาร ไทย"))
+	})
+}
+
+func TestTagFromRequest(t *testing.T) {
+	t.Run("ShouldDeriveSlugFromName", func(t *testing.T) {
+		tag := model.Tag{}.FromRequest(dto.TagRequest{Name: "Meal Prep", Category: "occasion"})
+
+		assert.Equal(t, "Meal Prep", tag.Name)
+		assert.Equal(t, "meal-prep", tag.Slug)
+		assert.Equal(t, "occasion", tag.Category)
+	})
+
+	t.Run("ShouldUseGivenSlug", func(t *testing.T) {
+		tag := model.Tag{Model: gorm.Model{ID: 3}}.FromRequest(dto.TagRequest{Name: "ไทย", Slug: "Thai", Category: "cuisine"})
+
+		assert.Equal(t, uint(3), tag.ID)
+		assert.Equal(t, "thai", tag.Slug)
+	})
+}
+
+func TestTagsFromIDs(t *testing.T) {
+	t.Run("ShouldRemoveDuplicateIDs", func(t *testing.T) {
+		tags := model.TagsFromIDs([]uint{2, 1, 2})
+
+		assert.Equal(t, []uint{2, 1}, tags.IDs())
+	})
+}
+
+func TestFoodRecipeFromRequestTags(t *testing.T) {
+	existing := model.FoodRecipe{Tags: model.Tags{{Model: gorm.Model{ID: 1}}}}
+
+	t.Run("ShouldKeepTagsWhenNotSent", func(t *testing.T) {
+		recipe := existing.FromRequest(dto.FoodRecipeRequest{Name: "Tom Yum"}, model.Claims{})
+
+		assert.Equal(t, []uint{1}, recipe.Tags.IDs())
+	})
+
+	t.Run("ShouldReplaceTagsWhenSent", func(t *testing.T) {
+		recipe := existing.FromRequest(dto.FoodRecipeRequest{Name: "Tom Yum", TagIDs: []uint{}}, model.Claims{})
+
+		assert.Empty(t, recipe.Tags.IDs())
+	})
+}
+
+func TestFoodRecipeQueryTagSlugs(t *testing.T) {
+	query := model.FoodRecipeQuery{Tags: "Thai, vegan,,thai"}
+
+	assert.Equal(t, []string{"thai", "vegan"}, query.TagSlugs())
+}
+
+func TestClaimsIsAdmin(t *testing.T) {
+	t.Run("ShouldBeAdminWithRealmRole", func(t *testing.T) {
+		claims := model.Claims{RealmAccess: &model.RealmAccess{Roles: []string{"user", "admin"}}}
+
+		assert.True(t, claims.IsAdmin())
+	})
+
+	t.Run("ShouldNotBeAdminWithoutRole", func(t *testing.T) {
+		assert.False(t, model.Claims{}.IsAdmin())
+	})
+}
@@ -0,0 +1,221 @@
+package pantry_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+	"wongnok/internal/pantry"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewHandler(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		handler := pantry.NewHandler(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(handler))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler pantry.IHandler
+	service *MockIService
+
+	// Mock data
+	items model.PantryItems
+
+	// Helper
+	server func(method string, url string, payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+// This will run once before all tests in the suite
+func (suite *HandlerTestSuite) SetupSuite() {
+	// Gin testing mode
+	gin.SetMode(gin.TestMode)
+}
+
+// This will run before each test
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = pantry.Handler{
+		Service: suite.service,
+	}
+
+	suite.items = model.PantryItems{
+		{Model: gorm.Model{ID: 2}, UserID: "UID", Name: "eggs", IngredientID: linked(1)},
+		{Model: gorm.Model{ID: 1}, UserID: "UID", Name: "Garlic"},
+	}
+
+	suite.server = func(method string, url string, payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder {
+		// Create router
+		router := gin.Default()
+
+		// Set context
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+
+		router.GET("/api/v1/users/self/pantry", suite.handler.Get)
+		router.POST("/api/v1/users/self/pantry", suite.handler.Create)
+		router.DELETE("/api/v1/users/self/pantry/:id", suite.handler.Delete)
+
+		recorder := httptest.NewRecorder()
+
+		// Create request
+		request, err := http.NewRequest(method, url, payload)
+		suite.NoError(err)
+
+		// Start testing server
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+}
+
+// Extend
+type HandlerGetTestSuite struct {
+	HandlerTestSuite
+}
+
+func (suite *HandlerGetTestSuite) TestResponseItems() {
+	suite.service.On("Get", model.Claims{ID: "UID"}).Return(suite.items, nil)
+
+	response := suite.server(http.MethodGet, "/api/v1/users/self/pantry", nil, &model.Claims{ID: "UID"})
+
+	expectedJson, _ := json.Marshal(suite.items.ToResponse())
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+}
+
+func (suite *HandlerGetTestSuite) TestErrorWhenNoClaims() {
+	response := suite.server(http.MethodGet, "/api/v1/users/self/pantry", nil, nil)
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+}
+
+func TestHandlerGet(t *testing.T) {
+	suite.Run(t, new(HandlerGetTestSuite))
+}
+
+// Extend
+type HandlerCreateTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	errServiceCreate error
+}
+
+// This will run before each test
+func (suite *HandlerCreateTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.errServiceCreate = nil
+
+	suite.service.On("Create", mock.Anything, mock.Anything).Return(func(dto.PantryRequest, model.Claims) (model.PantryItems, error) {
+		return suite.items, suite.errServiceCreate
+	})
+}
+
+func (suite *HandlerCreateTestSuite) TestResponseAllItems() {
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(http.MethodPost, "/api/v1/users/self/pantry", strings.NewReader(`{"names": ["eggs"]}`), &claims)
+
+	expectedJson, _ := json.Marshal(suite.items.ToResponse())
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Create", dto.PantryRequest{Names: []string{"eggs"}}, claims)
+}
+
+func (suite *HandlerCreateTestSuite) TestErrorWhenServiceFailed() {
+	suite.errServiceCreate = errors.Wrap(assert.AnError, "link pantry items")
+
+	response := suite.server(http.MethodPost, "/api/v1/users/self/pantry", strings.NewReader(`{"names": ["eggs"]}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusInternalServerError, response.Code)
+}
+
+func TestHandlerCreate(t *testing.T) {
+	suite.Run(t, new(HandlerCreateTestSuite))
+}
+
+// Extend
+type HandlerDeleteTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	errServiceDelete error
+}
+
+// This will run before each test
+func (suite *HandlerDeleteTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.errServiceDelete = nil
+
+	suite.service.On("Delete", mock.Anything, mock.Anything).Return(func(int, model.Claims) error {
+		return suite.errServiceDelete
+	})
+}
+
+func (suite *HandlerDeleteTestSuite) TestResponseMessageWithStatusCode200() {
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(http.MethodDelete, "/api/v1/users/self/pantry/1", nil, &claims)
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(`{"message":"Pantry item deleted successfully"}`, response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Delete", 1, claims)
+}
+
+func (suite *HandlerDeleteTestSuite) TestErrorWhenNotOwner() {
+	suite.errServiceDelete = global.ErrForbidden
+
+	response := suite.server(http.MethodDelete, "/api/v1/users/self/pantry/1", nil, &model.Claims{ID: "OTHER"})
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func (suite *HandlerDeleteTestSuite) TestErrorWhenNotFound() {
+	suite.errServiceDelete = errors.Wrap(gorm.ErrRecordNotFound, "find pantry item")
+
+	response := suite.server(http.MethodDelete, "/api/v1/users/self/pantry/99", nil, &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerDeleteTestSuite) TestErrorWhenInvalidID() {
+	response := suite.server(http.MethodDelete, "/api/v1/users/self/pantry/abc", nil, &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.Equal(`{"message":"invalid pantry item id"}`, response.Body.String())
+	suite.service.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func TestHandlerDelete(t *testing.T) {
+	suite.Run(t, new(HandlerDeleteTestSuite))
+}
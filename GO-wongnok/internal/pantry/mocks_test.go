@@ -0,0 +1,1397 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package pantry_test
+
+import (
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIHandler creates a new instance of MockIHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIHandler {
+	mock := &MockIHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIHandler is an autogenerated mock type for the IHandler type
+type MockIHandler struct {
+	mock.Mock
+}
+
+type MockIHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIHandler) EXPECT() *MockIHandler_Expecter {
+	return &MockIHandler_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Create(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIHandler_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Create(ctx interface{}) *MockIHandler_Create_Call {
+	return &MockIHandler_Create_Call{Call: _e.mock.On("Create", ctx)}
+}
+
+func (_c *MockIHandler_Create_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Create_Call) Return() *MockIHandler_Create_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Create_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Create_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Delete(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIHandler_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Delete(ctx interface{}) *MockIHandler_Delete_Call {
+	return &MockIHandler_Delete_Call{Call: _e.mock.On("Delete", ctx)}
+}
+
+func (_c *MockIHandler_Delete_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Delete_Call) Return() *MockIHandler_Delete_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Delete_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Delete_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Get(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIHandler_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Get(ctx interface{}) *MockIHandler_Get_Call {
+	return &MockIHandler_Get_Call{Call: _e.mock.On("Get", ctx)}
+}
+
+func (_c *MockIHandler_Get_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Get_Call) Return() *MockIHandler_Get_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Get_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Get_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockIRepository creates a new instance of MockIRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIRepository {
+	mock := &MockIRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIRepository is an autogenerated mock type for the IRepository type
+type MockIRepository struct {
+	mock.Mock
+}
+
+type MockIRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIRepository) EXPECT() *MockIRepository_Expecter {
+	return &MockIRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Create(items model.PantryItems) error {
+	ret := _mock.Called(items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(model.PantryItems) error); ok {
+		r0 = returnFunc(items)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - items model.PantryItems
+func (_e *MockIRepository_Expecter) Create(items interface{}) *MockIRepository_Create_Call {
+	return &MockIRepository_Create_Call{Call: _e.mock.On("Create", items)}
+}
+
+func (_c *MockIRepository_Create_Call) Run(run func(items model.PantryItems)) *MockIRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.PantryItems
+		if args[0] != nil {
+			arg0 = args[0].(model.PantryItems)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Create_Call) Return(err error) *MockIRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Create_Call) RunAndReturn(run func(items model.PantryItems) error) *MockIRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Delete(id uint) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id uint
+func (_e *MockIRepository_Expecter) Delete(id interface{}) *MockIRepository_Delete_Call {
+	return &MockIRepository_Delete_Call{Call: _e.mock.On("Delete", id)}
+}
+
+func (_c *MockIRepository_Delete_Call) Run(run func(id uint)) *MockIRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Delete_Call) Return(err error) *MockIRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Delete_Call) RunAndReturn(run func(id uint) error) *MockIRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Get(userID string) (model.PantryItems, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.PantryItems
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (model.PantryItems, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) model.PantryItems); ok {
+		r0 = returnFunc(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.PantryItems)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - userID string
+func (_e *MockIRepository_Expecter) Get(userID interface{}) *MockIRepository_Get_Call {
+	return &MockIRepository_Get_Call{Call: _e.mock.On("Get", userID)}
+}
+
+func (_c *MockIRepository_Get_Call) Run(run func(userID string)) *MockIRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Get_Call) Return(pantryItems model.PantryItems, err error) *MockIRepository_Get_Call {
+	_c.Call.Return(pantryItems, err)
+	return _c
+}
+
+func (_c *MockIRepository_Get_Call) RunAndReturn(run func(userID string) (model.PantryItems, error)) *MockIRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetByID(id int) (model.PantryItem, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.PantryItem
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (model.PantryItem, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) model.PantryItem); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.PantryItem)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - id int
+func (_e *MockIRepository_Expecter) GetByID(id interface{}) *MockIRepository_GetByID_Call {
+	return &MockIRepository_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+}
+
+func (_c *MockIRepository_GetByID_Call) Run(run func(id int)) *MockIRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetByID_Call) Return(pantryItem model.PantryItem, err error) *MockIRepository_GetByID_Call {
+	_c.Call.Return(pantryItem, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetByID_Call) RunAndReturn(run func(id int) (model.PantryItem, error)) *MockIRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIIngredientService creates a new instance of MockIIngredientService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIIngredientService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIIngredientService {
+	mock := &MockIIngredientService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIIngredientService is an autogenerated mock type for the IIngredientService type
+type MockIIngredientService struct {
+	mock.Mock
+}
+
+type MockIIngredientService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIIngredientService) EXPECT() *MockIIngredientService_Expecter {
+	return &MockIIngredientService_Expecter{mock: &_m.Mock}
+}
+
+// BackfillNutrition provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) BackfillNutrition() (int, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BackfillNutrition")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (int, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() int); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIIngredientService_BackfillNutrition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BackfillNutrition'
+type MockIIngredientService_BackfillNutrition_Call struct {
+	*mock.Call
+}
+
+// BackfillNutrition is a helper method to define mock.On call
+func (_e *MockIIngredientService_Expecter) BackfillNutrition() *MockIIngredientService_BackfillNutrition_Call {
+	return &MockIIngredientService_BackfillNutrition_Call{Call: _e.mock.On("BackfillNutrition")}
+}
+
+func (_c *MockIIngredientService_BackfillNutrition_Call) Run(run func()) *MockIIngredientService_BackfillNutrition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_BackfillNutrition_Call) Return(n int, err error) *MockIIngredientService_BackfillNutrition_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIIngredientService_BackfillNutrition_Call) RunAndReturn(run func() (int, error)) *MockIIngredientService_BackfillNutrition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) Create(request dto.IngredientRequest, claims model.Claims) (model.Ingredient, error) {
+	ret := _mock.Called(request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.Ingredient
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.IngredientRequest, model.Claims) (model.Ingredient, error)); ok {
+		return returnFunc(request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.IngredientRequest, model.Claims) model.Ingredient); ok {
+		r0 = returnFunc(request, claims)
+	} else {
+		r0 = ret.Get(0).(model.Ingredient)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.IngredientRequest, model.Claims) error); ok {
+		r1 = returnFunc(request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIIngredientService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIIngredientService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - request dto.IngredientRequest
+//   - claims model.Claims
+func (_e *MockIIngredientService_Expecter) Create(request interface{}, claims interface{}) *MockIIngredientService_Create_Call {
+	return &MockIIngredientService_Create_Call{Call: _e.mock.On("Create", request, claims)}
+}
+
+func (_c *MockIIngredientService_Create_Call) Run(run func(request dto.IngredientRequest, claims model.Claims)) *MockIIngredientService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.IngredientRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.IngredientRequest)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_Create_Call) Return(ingredient model.Ingredient, err error) *MockIIngredientService_Create_Call {
+	_c.Call.Return(ingredient, err)
+	return _c
+}
+
+func (_c *MockIIngredientService_Create_Call) RunAndReturn(run func(request dto.IngredientRequest, claims model.Claims) (model.Ingredient, error)) *MockIIngredientService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) Delete(id int, claims model.Claims) error {
+	ret := _mock.Called(id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) error); ok {
+		r0 = returnFunc(id, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIIngredientService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIIngredientService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id int
+//   - claims model.Claims
+func (_e *MockIIngredientService_Expecter) Delete(id interface{}, claims interface{}) *MockIIngredientService_Delete_Call {
+	return &MockIIngredientService_Delete_Call{Call: _e.mock.On("Delete", id, claims)}
+}
+
+func (_c *MockIIngredientService_Delete_Call) Run(run func(id int, claims model.Claims)) *MockIIngredientService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_Delete_Call) Return(err error) *MockIIngredientService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIIngredientService_Delete_Call) RunAndReturn(run func(id int, claims model.Claims) error) *MockIIngredientService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeriveDietary provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) DeriveDietary(ingredients model.RecipeIngredients) (model.Dietary, error) {
+	ret := _mock.Called(ingredients)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeriveDietary")
+	}
+
+	var r0 model.Dietary
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.RecipeIngredients) (model.Dietary, error)); ok {
+		return returnFunc(ingredients)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.RecipeIngredients) model.Dietary); ok {
+		r0 = returnFunc(ingredients)
+	} else {
+		r0 = ret.Get(0).(model.Dietary)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.RecipeIngredients) error); ok {
+		r1 = returnFunc(ingredients)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIIngredientService_DeriveDietary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeriveDietary'
+type MockIIngredientService_DeriveDietary_Call struct {
+	*mock.Call
+}
+
+// DeriveDietary is a helper method to define mock.On call
+//   - ingredients model.RecipeIngredients
+func (_e *MockIIngredientService_Expecter) DeriveDietary(ingredients interface{}) *MockIIngredientService_DeriveDietary_Call {
+	return &MockIIngredientService_DeriveDietary_Call{Call: _e.mock.On("DeriveDietary", ingredients)}
+}
+
+func (_c *MockIIngredientService_DeriveDietary_Call) Run(run func(ingredients model.RecipeIngredients)) *MockIIngredientService_DeriveDietary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.RecipeIngredients
+		if args[0] != nil {
+			arg0 = args[0].(model.RecipeIngredients)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_DeriveDietary_Call) Return(dietary model.Dietary, err error) *MockIIngredientService_DeriveDietary_Call {
+	_c.Call.Return(dietary, err)
+	return _c
+}
+
+func (_c *MockIIngredientService_DeriveDietary_Call) RunAndReturn(run func(ingredients model.RecipeIngredients) (model.Dietary, error)) *MockIIngredientService_DeriveDietary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DismissUnlinked provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) DismissUnlinked(id int, claims model.Claims) error {
+	ret := _mock.Called(id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DismissUnlinked")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) error); ok {
+		r0 = returnFunc(id, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIIngredientService_DismissUnlinked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DismissUnlinked'
+type MockIIngredientService_DismissUnlinked_Call struct {
+	*mock.Call
+}
+
+// DismissUnlinked is a helper method to define mock.On call
+//   - id int
+//   - claims model.Claims
+func (_e *MockIIngredientService_Expecter) DismissUnlinked(id interface{}, claims interface{}) *MockIIngredientService_DismissUnlinked_Call {
+	return &MockIIngredientService_DismissUnlinked_Call{Call: _e.mock.On("DismissUnlinked", id, claims)}
+}
+
+func (_c *MockIIngredientService_DismissUnlinked_Call) Run(run func(id int, claims model.Claims)) *MockIIngredientService_DismissUnlinked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_DismissUnlinked_Call) Return(err error) *MockIIngredientService_DismissUnlinked_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIIngredientService_DismissUnlinked_Call) RunAndReturn(run func(id int, claims model.Claims) error) *MockIIngredientService_DismissUnlinked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EstimateNutrition provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) EstimateNutrition(ingredients model.RecipeIngredients) (model.Nutrition, error) {
+	ret := _mock.Called(ingredients)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EstimateNutrition")
+	}
+
+	var r0 model.Nutrition
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.RecipeIngredients) (model.Nutrition, error)); ok {
+		return returnFunc(ingredients)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.RecipeIngredients) model.Nutrition); ok {
+		r0 = returnFunc(ingredients)
+	} else {
+		r0 = ret.Get(0).(model.Nutrition)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.RecipeIngredients) error); ok {
+		r1 = returnFunc(ingredients)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIIngredientService_EstimateNutrition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EstimateNutrition'
+type MockIIngredientService_EstimateNutrition_Call struct {
+	*mock.Call
+}
+
+// EstimateNutrition is a helper method to define mock.On call
+//   - ingredients model.RecipeIngredients
+func (_e *MockIIngredientService_Expecter) EstimateNutrition(ingredients interface{}) *MockIIngredientService_EstimateNutrition_Call {
+	return &MockIIngredientService_EstimateNutrition_Call{Call: _e.mock.On("EstimateNutrition", ingredients)}
+}
+
+func (_c *MockIIngredientService_EstimateNutrition_Call) Run(run func(ingredients model.RecipeIngredients)) *MockIIngredientService_EstimateNutrition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.RecipeIngredients
+		if args[0] != nil {
+			arg0 = args[0].(model.RecipeIngredients)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_EstimateNutrition_Call) Return(nutrition model.Nutrition, err error) *MockIIngredientService_EstimateNutrition_Call {
+	_c.Call.Return(nutrition, err)
+	return _c
+}
+
+func (_c *MockIIngredientService_EstimateNutrition_Call) RunAndReturn(run func(ingredients model.RecipeIngredients) (model.Nutrition, error)) *MockIIngredientService_EstimateNutrition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) Get(query model.IngredientQuery) (model.Ingredients, error) {
+	ret := _mock.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.Ingredients
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.IngredientQuery) (model.Ingredients, error)); ok {
+		return returnFunc(query)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.IngredientQuery) model.Ingredients); ok {
+		r0 = returnFunc(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.Ingredients)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.IngredientQuery) error); ok {
+		r1 = returnFunc(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIIngredientService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIIngredientService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - query model.IngredientQuery
+func (_e *MockIIngredientService_Expecter) Get(query interface{}) *MockIIngredientService_Get_Call {
+	return &MockIIngredientService_Get_Call{Call: _e.mock.On("Get", query)}
+}
+
+func (_c *MockIIngredientService_Get_Call) Run(run func(query model.IngredientQuery)) *MockIIngredientService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.IngredientQuery
+		if args[0] != nil {
+			arg0 = args[0].(model.IngredientQuery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_Get_Call) Return(ingredients model.Ingredients, err error) *MockIIngredientService_Get_Call {
+	_c.Call.Return(ingredients, err)
+	return _c
+}
+
+func (_c *MockIIngredientService_Get_Call) RunAndReturn(run func(query model.IngredientQuery) (model.Ingredients, error)) *MockIIngredientService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUnlinked provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) GetUnlinked(claims model.Claims) (model.UnlinkedIngredients, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnlinked")
+	}
+
+	var r0 model.UnlinkedIngredients
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.UnlinkedIngredients, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.UnlinkedIngredients); ok {
+		r0 = returnFunc(claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.UnlinkedIngredients)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIIngredientService_GetUnlinked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnlinked'
+type MockIIngredientService_GetUnlinked_Call struct {
+	*mock.Call
+}
+
+// GetUnlinked is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIIngredientService_Expecter) GetUnlinked(claims interface{}) *MockIIngredientService_GetUnlinked_Call {
+	return &MockIIngredientService_GetUnlinked_Call{Call: _e.mock.On("GetUnlinked", claims)}
+}
+
+func (_c *MockIIngredientService_GetUnlinked_Call) Run(run func(claims model.Claims)) *MockIIngredientService_GetUnlinked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_GetUnlinked_Call) Return(unlinkedIngredients model.UnlinkedIngredients, err error) *MockIIngredientService_GetUnlinked_Call {
+	_c.Call.Return(unlinkedIngredients, err)
+	return _c
+}
+
+func (_c *MockIIngredientService_GetUnlinked_Call) RunAndReturn(run func(claims model.Claims) (model.UnlinkedIngredients, error)) *MockIIngredientService_GetUnlinked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Link provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) Link(ingredients model.RecipeIngredients) (model.RecipeIngredients, error) {
+	ret := _mock.Called(ingredients)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Link")
+	}
+
+	var r0 model.RecipeIngredients
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.RecipeIngredients) (model.RecipeIngredients, error)); ok {
+		return returnFunc(ingredients)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.RecipeIngredients) model.RecipeIngredients); ok {
+		r0 = returnFunc(ingredients)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.RecipeIngredients)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.RecipeIngredients) error); ok {
+		r1 = returnFunc(ingredients)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIIngredientService_Link_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Link'
+type MockIIngredientService_Link_Call struct {
+	*mock.Call
+}
+
+// Link is a helper method to define mock.On call
+//   - ingredients model.RecipeIngredients
+func (_e *MockIIngredientService_Expecter) Link(ingredients interface{}) *MockIIngredientService_Link_Call {
+	return &MockIIngredientService_Link_Call{Call: _e.mock.On("Link", ingredients)}
+}
+
+func (_c *MockIIngredientService_Link_Call) Run(run func(ingredients model.RecipeIngredients)) *MockIIngredientService_Link_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.RecipeIngredients
+		if args[0] != nil {
+			arg0 = args[0].(model.RecipeIngredients)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_Link_Call) Return(recipeIngredients model.RecipeIngredients, err error) *MockIIngredientService_Link_Call {
+	_c.Call.Return(recipeIngredients, err)
+	return _c
+}
+
+func (_c *MockIIngredientService_Link_Call) RunAndReturn(run func(ingredients model.RecipeIngredients) (model.RecipeIngredients, error)) *MockIIngredientService_Link_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LinkPantry provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) LinkPantry(items model.PantryItems) (model.PantryItems, error) {
+	ret := _mock.Called(items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkPantry")
+	}
+
+	var r0 model.PantryItems
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.PantryItems) (model.PantryItems, error)); ok {
+		return returnFunc(items)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.PantryItems) model.PantryItems); ok {
+		r0 = returnFunc(items)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.PantryItems)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.PantryItems) error); ok {
+		r1 = returnFunc(items)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIIngredientService_LinkPantry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkPantry'
+type MockIIngredientService_LinkPantry_Call struct {
+	*mock.Call
+}
+
+// LinkPantry is a helper method to define mock.On call
+//   - items model.PantryItems
+func (_e *MockIIngredientService_Expecter) LinkPantry(items interface{}) *MockIIngredientService_LinkPantry_Call {
+	return &MockIIngredientService_LinkPantry_Call{Call: _e.mock.On("LinkPantry", items)}
+}
+
+func (_c *MockIIngredientService_LinkPantry_Call) Run(run func(items model.PantryItems)) *MockIIngredientService_LinkPantry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.PantryItems
+		if args[0] != nil {
+			arg0 = args[0].(model.PantryItems)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_LinkPantry_Call) Return(pantryItems model.PantryItems, err error) *MockIIngredientService_LinkPantry_Call {
+	_c.Call.Return(pantryItems, err)
+	return _c
+}
+
+func (_c *MockIIngredientService_LinkPantry_Call) RunAndReturn(run func(items model.PantryItems) (model.PantryItems, error)) *MockIIngredientService_LinkPantry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LinkUnlinked provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) LinkUnlinked(request dto.UnlinkedIngredientLinkRequest, id int, claims model.Claims) (model.Ingredient, error) {
+	ret := _mock.Called(request, id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkUnlinked")
+	}
+
+	var r0 model.Ingredient
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.UnlinkedIngredientLinkRequest, int, model.Claims) (model.Ingredient, error)); ok {
+		return returnFunc(request, id, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.UnlinkedIngredientLinkRequest, int, model.Claims) model.Ingredient); ok {
+		r0 = returnFunc(request, id, claims)
+	} else {
+		r0 = ret.Get(0).(model.Ingredient)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.UnlinkedIngredientLinkRequest, int, model.Claims) error); ok {
+		r1 = returnFunc(request, id, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIIngredientService_LinkUnlinked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkUnlinked'
+type MockIIngredientService_LinkUnlinked_Call struct {
+	*mock.Call
+}
+
+// LinkUnlinked is a helper method to define mock.On call
+//   - request dto.UnlinkedIngredientLinkRequest
+//   - id int
+//   - claims model.Claims
+func (_e *MockIIngredientService_Expecter) LinkUnlinked(request interface{}, id interface{}, claims interface{}) *MockIIngredientService_LinkUnlinked_Call {
+	return &MockIIngredientService_LinkUnlinked_Call{Call: _e.mock.On("LinkUnlinked", request, id, claims)}
+}
+
+func (_c *MockIIngredientService_LinkUnlinked_Call) Run(run func(request dto.UnlinkedIngredientLinkRequest, id int, claims model.Claims)) *MockIIngredientService_LinkUnlinked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.UnlinkedIngredientLinkRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.UnlinkedIngredientLinkRequest)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_LinkUnlinked_Call) Return(ingredient model.Ingredient, err error) *MockIIngredientService_LinkUnlinked_Call {
+	_c.Call.Return(ingredient, err)
+	return _c
+}
+
+func (_c *MockIIngredientService_LinkUnlinked_Call) RunAndReturn(run func(request dto.UnlinkedIngredientLinkRequest, id int, claims model.Claims) (model.Ingredient, error)) *MockIIngredientService_LinkUnlinked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIIngredientService
+func (_mock *MockIIngredientService) Update(request dto.IngredientRequest, id int, claims model.Claims) (model.Ingredient, error) {
+	ret := _mock.Called(request, id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.Ingredient
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.IngredientRequest, int, model.Claims) (model.Ingredient, error)); ok {
+		return returnFunc(request, id, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.IngredientRequest, int, model.Claims) model.Ingredient); ok {
+		r0 = returnFunc(request, id, claims)
+	} else {
+		r0 = ret.Get(0).(model.Ingredient)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.IngredientRequest, int, model.Claims) error); ok {
+		r1 = returnFunc(request, id, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIIngredientService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIIngredientService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - request dto.IngredientRequest
+//   - id int
+//   - claims model.Claims
+func (_e *MockIIngredientService_Expecter) Update(request interface{}, id interface{}, claims interface{}) *MockIIngredientService_Update_Call {
+	return &MockIIngredientService_Update_Call{Call: _e.mock.On("Update", request, id, claims)}
+}
+
+func (_c *MockIIngredientService_Update_Call) Run(run func(request dto.IngredientRequest, id int, claims model.Claims)) *MockIIngredientService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.IngredientRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.IngredientRequest)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIIngredientService_Update_Call) Return(ingredient model.Ingredient, err error) *MockIIngredientService_Update_Call {
+	_c.Call.Return(ingredient, err)
+	return _c
+}
+
+func (_c *MockIIngredientService_Update_Call) RunAndReturn(run func(request dto.IngredientRequest, id int, claims model.Claims) (model.Ingredient, error)) *MockIIngredientService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIService creates a new instance of MockIService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIService {
+	mock := &MockIService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIService is an autogenerated mock type for the IService type
+type MockIService struct {
+	mock.Mock
+}
+
+type MockIService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIService) EXPECT() *MockIService_Expecter {
+	return &MockIService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockIService
+func (_mock *MockIService) Create(request dto.PantryRequest, claims model.Claims) (model.PantryItems, error) {
+	ret := _mock.Called(request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.PantryItems
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.PantryRequest, model.Claims) (model.PantryItems, error)); ok {
+		return returnFunc(request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.PantryRequest, model.Claims) model.PantryItems); ok {
+		r0 = returnFunc(request, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.PantryItems)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.PantryRequest, model.Claims) error); ok {
+		r1 = returnFunc(request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - request dto.PantryRequest
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Create(request interface{}, claims interface{}) *MockIService_Create_Call {
+	return &MockIService_Create_Call{Call: _e.mock.On("Create", request, claims)}
+}
+
+func (_c *MockIService_Create_Call) Run(run func(request dto.PantryRequest, claims model.Claims)) *MockIService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.PantryRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.PantryRequest)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Create_Call) Return(pantryItems model.PantryItems, err error) *MockIService_Create_Call {
+	_c.Call.Return(pantryItems, err)
+	return _c
+}
+
+func (_c *MockIService_Create_Call) RunAndReturn(run func(request dto.PantryRequest, claims model.Claims) (model.PantryItems, error)) *MockIService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIService
+func (_mock *MockIService) Delete(id int, claims model.Claims) error {
+	ret := _mock.Called(id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) error); ok {
+		r0 = returnFunc(id, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Delete(id interface{}, claims interface{}) *MockIService_Delete_Call {
+	return &MockIService_Delete_Call{Call: _e.mock.On("Delete", id, claims)}
+}
+
+func (_c *MockIService_Delete_Call) Run(run func(id int, claims model.Claims)) *MockIService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Delete_Call) Return(err error) *MockIService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIService_Delete_Call) RunAndReturn(run func(id int, claims model.Claims) error) *MockIService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIService
+func (_mock *MockIService) Get(claims model.Claims) (model.PantryItems, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 model.PantryItems
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.PantryItems, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.PantryItems); ok {
+		r0 = returnFunc(claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.PantryItems)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Get(claims interface{}) *MockIService_Get_Call {
+	return &MockIService_Get_Call{Call: _e.mock.On("Get", claims)}
+}
+
+func (_c *MockIService_Get_Call) Run(run func(claims model.Claims)) *MockIService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Get_Call) Return(pantryItems model.PantryItems, err error) *MockIService_Get_Call {
+	_c.Call.Return(pantryItems, err)
+	return _c
+}
+
+func (_c *MockIService_Get_Call) RunAndReturn(run func(claims model.Claims) (model.PantryItems, error)) *MockIService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,59 @@
+package pantry
+
+import (
+	"wongnok/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IRepository interface {
+	Get(userID string) (model.PantryItems, error)
+	GetByID(id int) (model.PantryItem, error)
+	Create(items model.PantryItems) error
+	Delete(id uint) error
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+// Get วัตถุดิบทั้งหมดในตู้ของผู้ใช้ เรียงตามชื่อ
+func (repo Repository) Get(userID string) (model.PantryItems, error) {
+	var items = make(model.PantryItems, 0)
+
+	if err := repo.DB.Where("user_id = ?", userID).Order("LOWER(name) asc").Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (repo Repository) GetByID(id int) (model.PantryItem, error) {
+	var item model.PantryItem
+
+	if err := repo.DB.First(&item, id).Error; err != nil {
+		return model.PantryItem{}, err
+	}
+
+	return item, nil
+}
+
+// Create เพิ่มหลายรายการ ชื่อที่ซ้ำกับที่มีอยู่ (unique index บน LOWER(name)) จะถูกข้าม
+func (repo Repository) Create(items model.PantryItems) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	return repo.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&items).Error
+}
+
+func (repo Repository) Delete(id uint) error {
+	return repo.DB.Delete(&model.PantryItem{}, id).Error
+}
@@ -0,0 +1,157 @@
+package pantry_test
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+	"wongnok/internal/model"
+	"wongnok/internal/pantry"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	driver "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestNewRepository(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		repo := pantry.NewRepository(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(repo))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+type RepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	container  *postgres.PostgresContainer
+	db         *gorm.DB
+	repository pantry.IRepository
+}
+
+// This will run once before all tests in the suite
+func (suite *RepositoryTestSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	container, err := postgres.Run(
+		suite.ctx,
+		"postgres:17-alpine",
+		postgres.WithInitScripts(filepath.Join("../..", "tests", "init-db.sql")),
+		postgres.WithDatabase("wongnok-test"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").WithOccurrence(2).WithStartupTimeout(
+				(5 * time.Second),
+			),
+		),
+	)
+	suite.NoError(err)
+	suite.container = container
+}
+
+// This will run once after all tests in the suite
+func (suite *RepositoryTestSuite) TearDownSuite() {
+	err := suite.container.Terminate(suite.ctx)
+	suite.NoError(err)
+}
+
+// This will run before each test
+func (suite *RepositoryTestSuite) SetupTest() {
+	conn, err := suite.container.ConnectionString(suite.ctx, "sslmode=disable")
+	suite.NoError(err)
+
+	db, err := gorm.Open(driver.Open(conn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	suite.NoError(err)
+
+	suite.repository = &pantry.Repository{
+		DB: db,
+	}
+
+	suite.db = db
+}
+
+// This will run after each test
+func (suite *RepositoryTestSuite) TearDownTest() {
+	sqldb, _ := suite.db.DB()
+	sqldb.Close()
+}
+
+const demoUserID = "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"
+
+// Extend
+type RepositoryCreateTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryCreateTestSuite) TestSkipDuplicateNames() {
+	suite.NoError(suite.repository.Create(model.PantryItems{
+		{UserID: demoUserID, Name: "garlic"},
+		{UserID: demoUserID, Name: "eggs", IngredientID: linked(1)},
+	}))
+
+	// ชื่อซ้ำ (ไม่สนตัวพิมพ์) ถูกข้ามโดยไม่ error
+	suite.NoError(suite.repository.Create(model.PantryItems{
+		{UserID: demoUserID, Name: "Garlic"},
+		{UserID: demoUserID, Name: "Basil"},
+	}))
+
+	items, err := suite.repository.Get(demoUserID)
+	suite.NoError(err)
+
+	suite.Len(items, 3)
+	suite.Equal([]string{"Basil", "eggs", "garlic"}, []string{items[0].Name, items[1].Name, items[2].Name})
+	suite.Equal(linked(1), items[1].IngredientID)
+}
+
+func (suite *RepositoryCreateTestSuite) TestSkipWhenEmpty() {
+	suite.NoError(suite.repository.Create(model.PantryItems{}))
+}
+
+func TestRepositoryCreate(t *testing.T) {
+	suite.Run(t, new(RepositoryCreateTestSuite))
+}
+
+// Extend
+type RepositoryDeleteTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryDeleteTestSuite) TestAddAgainAfterDelete() {
+	suite.NoError(suite.repository.Create(model.PantryItems{{UserID: demoUserID, Name: "salt"}}))
+
+	items, err := suite.repository.Get(demoUserID)
+	suite.NoError(err)
+	suite.Len(items, 1)
+
+	suite.NoError(suite.repository.Delete(items[0].ID))
+
+	_, err = suite.repository.GetByID(int(items[0].ID))
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	// unique index ครอบคลุมเฉพาะแถวที่ยังไม่ถูกลบ
+	suite.NoError(suite.repository.Create(model.PantryItems{{UserID: demoUserID, Name: "Salt"}}))
+
+	items, err = suite.repository.Get(demoUserID)
+	suite.NoError(err)
+	suite.Len(items, 1)
+}
+
+func TestRepositoryDelete(t *testing.T) {
+	suite.Run(t, new(RepositoryDeleteTestSuite))
+}
@@ -0,0 +1,78 @@
+package pantry
+
+import (
+	"wongnok/internal/global"
+	"wongnok/internal/ingredient"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IIngredientService ingredient.IService
+
+type IService interface {
+	Get(claims model.Claims) (model.PantryItems, error)
+	Create(request dto.PantryRequest, claims model.Claims) (model.PantryItems, error)
+	Delete(id int, claims model.Claims) error
+}
+
+type Service struct {
+	Repository        IRepository
+	IngredientService IIngredientService
+}
+
+func NewService(db *gorm.DB) IService {
+	return &Service{
+		Repository:        NewRepository(db),
+		IngredientService: ingredient.NewService(db),
+	}
+}
+
+func (service Service) Get(claims model.Claims) (model.PantryItems, error) {
+	items, err := service.Repository.Get(claims.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "find pantry")
+	}
+
+	return items, nil
+}
+
+// Create เพิ่มวัตถุดิบเข้าตู้ (ข้ามชื่อที่มีอยู่แล้ว) ผูกกับพจนานุกรม แล้วคืนรายการทั้งหมด
+func (service Service) Create(request dto.PantryRequest, claims model.Claims) (model.PantryItems, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return nil, errors.Wrap(err, "request invalid")
+	}
+
+	existing, err := service.Get(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := service.IngredientService.LinkPantry(existing.FromRequest(request, claims.ID))
+	if err != nil {
+		return nil, errors.Wrap(err, "link pantry items")
+	}
+
+	if err := service.Repository.Create(items); err != nil {
+		return nil, errors.Wrap(err, "create pantry items")
+	}
+
+	return service.Get(claims)
+}
+
+func (service Service) Delete(id int, claims model.Claims) error {
+	item, err := service.Repository.GetByID(id)
+	if err != nil {
+		return errors.Wrap(err, "find pantry item")
+	}
+
+	if item.UserID != claims.ID {
+		return global.ErrForbidden
+	}
+
+	return service.Repository.Delete(item.ID)
+}
@@ -0,0 +1,160 @@
+package pantry_test
+
+import (
+	"reflect"
+	"testing"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+	"wongnok/internal/pantry"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewService(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		service := pantry.NewService(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(service))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+func linked(id uint) *uint {
+	return &id
+}
+
+type ServiceCreateTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service           pantry.IService
+	repo              *MockIRepository
+	ingredientService *MockIIngredientService
+
+	// Mock data
+	respGet       model.PantryItems
+	errLinkPantry error
+	errRepoCreate error
+}
+
+// This will run before each test
+func (suite *ServiceCreateTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.ingredientService = new(MockIIngredientService)
+	suite.service = &pantry.Service{
+		Repository:        suite.repo,
+		IngredientService: suite.ingredientService,
+	}
+
+	suite.respGet = model.PantryItems{{Model: gorm.Model{ID: 1}, UserID: "UID", Name: "Garlic"}}
+	suite.errLinkPantry = nil
+	suite.errRepoCreate = nil
+
+	suite.repo.On("Get", "UID").Return(func(string) (model.PantryItems, error) {
+		return suite.respGet, nil
+	})
+	suite.ingredientService.On("LinkPantry", mock.Anything).Return(func(items model.PantryItems) (model.PantryItems, error) {
+		for index := range items {
+			if items[index].Name == "eggs" {
+				items[index].IngredientID = linked(1)
+			}
+		}
+		return items, suite.errLinkPantry
+	})
+	suite.repo.On("Create", mock.Anything).Return(func(model.PantryItems) error {
+		return suite.errRepoCreate
+	})
+}
+
+func (suite *ServiceCreateTestSuite) TestCreateLinkedItems() {
+	_, err := suite.service.Create(dto.PantryRequest{Names: []string{"garlic", " eggs ", "salt"}}, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	// ข้ามชื่อที่มีอยู่แล้ว และบันทึกพร้อม ingredient id
+	suite.repo.AssertCalled(suite.T(), "Create", model.PantryItems{
+		{UserID: "UID", Name: "eggs", IngredientID: linked(1)},
+		{UserID: "UID", Name: "salt"},
+	})
+	suite.repo.AssertNumberOfCalls(suite.T(), "Get", 2)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenRequestInvalid() {
+	_, err := suite.service.Create(dto.PantryRequest{}, model.Claims{ID: "UID"})
+	suite.ErrorAs(err, &validator.ValidationErrors{})
+
+	suite.repo.AssertNotCalled(suite.T(), "Get", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenLinkPantry() {
+	suite.errLinkPantry = assert.AnError
+
+	_, err := suite.service.Create(dto.PantryRequest{Names: []string{"eggs"}}, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, assert.AnError)
+
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenRepositoryCreate() {
+	suite.errRepoCreate = assert.AnError
+
+	_, err := suite.service.Create(dto.PantryRequest{Names: []string{"eggs"}}, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, assert.AnError)
+}
+
+func TestServiceCreate(t *testing.T) {
+	suite.Run(t, new(ServiceCreateTestSuite))
+}
+
+type ServiceDeleteTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service pantry.IService
+	repo    *MockIRepository
+}
+
+// This will run before each test
+func (suite *ServiceDeleteTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &pantry.Service{
+		Repository: suite.repo,
+	}
+
+	suite.repo.On("GetByID", 1).Return(model.PantryItem{Model: gorm.Model{ID: 1}, UserID: "UID", Name: "Garlic"}, nil)
+	suite.repo.On("GetByID", 99).Return(model.PantryItem{}, gorm.ErrRecordNotFound)
+	suite.repo.On("Delete", mock.Anything).Return(nil)
+}
+
+func (suite *ServiceDeleteTestSuite) TestDeleteOwnItem() {
+	err := suite.service.Delete(1, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "Delete", uint(1))
+}
+
+func (suite *ServiceDeleteTestSuite) TestErrorWhenNotOwner() {
+	err := suite.service.Delete(1, model.Claims{ID: "OTHER"})
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
+
+func (suite *ServiceDeleteTestSuite) TestErrorWhenNotFound() {
+	err := suite.service.Delete(99, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func TestServiceDelete(t *testing.T) {
+	suite.Run(t, new(ServiceDeleteTestSuite))
+}
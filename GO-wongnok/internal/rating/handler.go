@@ -4,7 +4,9 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"wongnok/internal/global"
 	"wongnok/internal/helper"
+	"wongnok/internal/model"
 	"wongnok/internal/model/dto"
 
 	"github.com/gin-gonic/gin"
@@ -15,6 +17,10 @@ import (
 type IHandler interface {
 	Get(ctx *gin.Context)
 	Create(ctx *gin.Context)
+	Update(ctx *gin.Context)
+	Delete(ctx *gin.Context)
+	Vote(ctx *gin.Context)
+	Unvote(ctx *gin.Context)
 }
 
 type Handler struct {
@@ -29,12 +35,15 @@ func NewHandler(db *gorm.DB) *Handler {
 
 // Get godoc
 // @Summary Get ratings
-// @Description Get ratings for a food recipe by ID
+// @Description Get ratings and written reviews for a food recipe by ID
 // @Tags ratings
 // @Accept json
 // @Produce json
 // @Param id path string false "Food Recipe ID"
-// @Success 200 {object} dto.FoodRecipesResponse
+// @Param page query int false "Page number" (default 1)
+// @Param limit query int false "Items per page" (default 10)
+// @Param sort query string false "Sort order (newest, most-helpful, highest, lowest)"
+// @Success 200 {object} dto.RatingsResponse
 // @Failure 400 {object} map[string]interface{}
 // @Failure 500 {object} map[string]interface{}
 // @Router /api/v1/food-recipes/{id}/ratings [get]
@@ -48,7 +57,13 @@ func (handler Handler) Get(ctx *gin.Context) {
 		}
 	}
 
-	ratings, err := handler.Service.Get(id)
+	var query model.RatingQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	ratings, total, err := handler.Service.Get(id, query)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			ctx.JSON(http.StatusInternalServerError, gin.H{"message": "Rating not found"})
@@ -58,12 +73,14 @@ func (handler Handler) Get(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, ratings.ToResponse())
+	response := ratings.ToResponse()
+	response.Total = total
+	ctx.JSON(http.StatusOK, response)
 }
 
 // Create godoc
 // @Summary Create a rating
-// @Description Create a new rating for a food recipe by ID
+// @Description Rate a food recipe by ID, replacing the user's previous score if any
 // @Tags ratings
 // @Accept json
 // @Produce json
@@ -110,3 +127,195 @@ func (handler Handler) Create(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusCreated, rating.ToResponse())
 }
+
+// Update godoc
+// @Summary Update my rating
+// @Description Update the logged-in user's rating for a food recipe
+// @Tags ratings
+// @Accept json
+// @Produce json
+// @Param id path string true "Food Recipe ID"
+// @Param request body dto.RatingRequest true "Rating Request"
+// @Success 200 {object} dto.RatingResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/food-recipes/{id}/ratings/mine [put]
+func (handler Handler) Update(ctx *gin.Context) {
+	var request dto.RatingRequest
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid food recipe id"})
+		return
+	}
+
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	rating, err := handler.Service.Update(request, id, claims)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.As(err, &validator.ValidationErrors{}) {
+			statusCode = http.StatusBadRequest
+		}
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			statusCode = http.StatusNotFound
+		}
+
+		ctx.JSON(statusCode, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rating.ToResponse())
+}
+
+// Delete godoc
+// @Summary Delete my rating
+// @Description Delete the logged-in user's rating for a food recipe
+// @Tags ratings
+// @Accept json
+// @Produce json
+// @Param id path string true "Food Recipe ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/food-recipes/{id}/ratings/mine [delete]
+func (handler Handler) Delete(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid food recipe id"})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	if err := handler.Service.Delete(id, claims); err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			statusCode = http.StatusNotFound
+		}
+
+		ctx.JSON(statusCode, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Rating deleted successfully"})
+}
+
+// Vote godoc
+// @Summary Vote on a review
+// @Description Mark a review as helpful or not, replacing the user's previous vote
+// @Tags ratings
+// @Accept json
+// @Produce json
+// @Param id path string true "Rating ID"
+// @Param request body dto.ReviewVoteRequest true "Vote Request"
+// @Success 200 {object} dto.RatingResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/ratings/{id}/votes [post]
+func (handler Handler) Vote(ctx *gin.Context) {
+	var request dto.ReviewVoteRequest
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid rating id"})
+		return
+	}
+
+	if err := ctx.BindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	rating, err := handler.Service.Vote(request, id, claims)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.As(err, &validator.ValidationErrors{}) {
+			statusCode = http.StatusBadRequest
+		}
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			statusCode = http.StatusNotFound
+		}
+
+		if errors.Is(err, global.ErrForbidden) {
+			statusCode = http.StatusForbidden
+		}
+
+		ctx.JSON(statusCode, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rating.ToResponse())
+}
+
+// Unvote godoc
+// @Summary Remove my vote on a review
+// @Description Remove the logged-in user's helpful vote on a review
+// @Tags ratings
+// @Accept json
+// @Produce json
+// @Param id path string true "Rating ID"
+// @Success 200 {object} dto.RatingResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /api/v1/ratings/{id}/votes [delete]
+func (handler Handler) Unvote(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": "invalid rating id"})
+		return
+	}
+
+	claims, err := helper.DecodeClaims(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		return
+	}
+
+	rating, err := handler.Service.Unvote(id, claims)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			statusCode = http.StatusNotFound
+		}
+
+		ctx.JSON(statusCode, gin.H{"message": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rating.ToResponse())
+}
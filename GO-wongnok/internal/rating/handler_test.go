@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"wongnok/internal/global"
 	"wongnok/internal/model"
 	"wongnok/internal/model/dto"
 	"wongnok/internal/rating"
@@ -202,6 +203,7 @@ type HandlerGetRatingsTestSuite struct {
 	errServiceGet  error
 
 	// Helper
+	query  string
 	server func(payload io.Reader) *httptest.ResponseRecorder
 }
 
@@ -229,7 +231,7 @@ func (suite *HandlerGetRatingsTestSuite) SetupTest() {
 		// Create request
 		request, err := http.NewRequest(
 			http.MethodGet,
-			"/api/v1/food-recipes/1/ratings",
+			"/api/v1/food-recipes/1/ratings"+suite.query,
 			payload,
 		)
 		suite.NoError(err)
@@ -253,12 +255,13 @@ func (suite *HandlerGetRatingsTestSuite) SetupTest() {
 		},
 	}
 
+	suite.query = ""
 	suite.errServiceGet = nil
-	suite.service.On("Get", mock.AnythingOfType("int")).Return(func(id int) (model.Ratings, error) {
+	suite.service.On("Get", mock.AnythingOfType("int"), mock.AnythingOfType("model.RatingQuery")).Return(func(id int, query model.RatingQuery) (model.Ratings, int64, error) {
 		if id == 1 {
-			return suite.respServiceGet, suite.errServiceGet
+			return suite.respServiceGet, int64(len(suite.respServiceGet)), suite.errServiceGet
 		}
-		return model.Ratings{}, assert.AnError
+		return model.Ratings{}, 0, assert.AnError
 	})
 }
 
@@ -270,6 +273,7 @@ func (suite *HandlerGetRatingsTestSuite) TestResponseRatingsWithStatusCode200()
 	defer body.Close()
 
 	expectedResponse := dto.RatingsResponse{
+		Total: 2,
 		Results: []dto.RatingResponse{
 			{Score: 5, FoodRecipeID: 1, UserID: "1a"},
 			{Score: 4, FoodRecipeID: 1, UserID: "1a"},
@@ -283,7 +287,62 @@ func (suite *HandlerGetRatingsTestSuite) TestResponseRatingsWithStatusCode200()
 	println("[DEBUG] expected:", string(expectedJson))
 	println("[DEBUG] actual:", response.Body.String())
 	suite.Equal(string(expectedJson), response.Body.String())
-	suite.service.AssertCalled(suite.T(), "Get", 1)
+	suite.service.AssertCalled(suite.T(), "Get", 1, model.RatingQuery{})
+}
+
+func (suite *HandlerGetRatingsTestSuite) TestResponseReviewWithReviewer() {
+	suite.respServiceGet = model.Ratings{
+		{
+			Model:        gorm.Model{ID: 3},
+			Score:        4.5,
+			FoodRecipeID: 1,
+			UserID:       "1a",
+			User:         model.User{ID: "1a", FirstName: "Demo", LastName: "User"},
+			Title:        "Crispy",
+			Body:         "Used less sugar.",
+			PhotoURLs:    model.StringList{"https://example.com/omelet.jpg"},
+			HelpfulCount: 2,
+		},
+	}
+
+	response := suite.server(nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	var actual dto.RatingsResponse
+	suite.NoError(json.Unmarshal(response.Body.Bytes(), &actual))
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(int64(1), actual.Total)
+	suite.Equal("Crispy", actual.Results[0].Title)
+	suite.Equal("Used less sugar.", actual.Results[0].Body)
+	suite.Equal([]string{"https://example.com/omelet.jpg"}, actual.Results[0].PhotoURLs)
+	suite.Equal(&dto.UserResponse{ID: "1a", FirstName: "Demo", LastName: "User"}, actual.Results[0].User)
+}
+
+func (suite *HandlerGetRatingsTestSuite) TestPassPagingAndSortToService() {
+	suite.query = "?page=2&limit=5&sort=most-helpful"
+
+	response := suite.server(nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.service.AssertCalled(suite.T(), "Get", 1, model.RatingQuery{Page: 2, Limit: 5, Sort: "most-helpful"})
+}
+
+func (suite *HandlerGetRatingsTestSuite) TestResponseErrorWhenSortInvalid() {
+	suite.query = "?sort=random"
+
+	response := suite.server(nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Get", mock.Anything, mock.Anything)
 }
 
 func (suite *HandlerGetRatingsTestSuite) TestResponseErrorWhenRecipeNotFound() {
@@ -302,3 +361,354 @@ func (suite *HandlerGetRatingsTestSuite) TestResponseErrorWhenRecipeNotFound() {
 func TestHandlerGetRatings(t *testing.T) {
 	suite.Run(t, new(HandlerGetRatingsTestSuite))
 }
+
+type HandlerUpdateRatingTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler rating.IHandler
+	service *MockIService
+
+	// Mock data
+	respServiceUpdate model.Rating
+	errServiceUpdate  error
+
+	// Helper
+	server func(payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+// This will run once before all tests in the suite
+func (suite *HandlerUpdateRatingTestSuite) SetupSuite() {
+	// Gin testing mode
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerUpdateRatingTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = rating.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder {
+		router := gin.Default()
+
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+
+		router.PUT("/api/v1/food-recipes/:id/ratings/mine", suite.handler.Update)
+
+		recorder := httptest.NewRecorder()
+
+		request, err := http.NewRequest(
+			http.MethodPut,
+			"/api/v1/food-recipes/1/ratings/mine",
+			payload,
+		)
+		suite.NoError(err)
+
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	suite.respServiceUpdate = model.Rating{
+		Model:        gorm.Model{ID: 7},
+		Score:        4.5,
+		FoodRecipeID: 1,
+		UserID:       "UID",
+	}
+
+	suite.errServiceUpdate = nil
+	suite.service.On("Update",
+		mock.AnythingOfType("dto.RatingRequest"),
+		mock.AnythingOfType("int"),
+		mock.AnythingOfType("model.Claims"),
+	).Return(func(dto.RatingRequest, int, model.Claims) (model.Rating, error) {
+		return suite.respServiceUpdate, suite.errServiceUpdate
+	})
+}
+
+func (suite *HandlerUpdateRatingTestSuite) TestResponseRatingWithStatusCode200() {
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(strings.NewReader(`{"score": 4.5}`), &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	expectedJson, _ := json.Marshal(suite.respServiceUpdate.ToResponse())
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Update", dto.RatingRequest{Score: 4.5}, 1, claims)
+}
+
+func (suite *HandlerUpdateRatingTestSuite) TestResponseErrorStatusCode404WhenNotRated() {
+	suite.errServiceUpdate = gorm.ErrRecordNotFound
+
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(strings.NewReader(`{"score": 4.5}`), &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerUpdateRatingTestSuite) TestResponseErrorStatusCode401() {
+	response := suite.server(strings.NewReader(`{"score": 4.5}`), nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandlerUpdateRating(t *testing.T) {
+	suite.Run(t, new(HandlerUpdateRatingTestSuite))
+}
+
+type HandlerDeleteRatingTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler rating.IHandler
+	service *MockIService
+
+	// Mock data
+	errServiceDelete error
+
+	// Helper
+	server func(claims *model.Claims) *httptest.ResponseRecorder
+}
+
+// This will run once before all tests in the suite
+func (suite *HandlerDeleteRatingTestSuite) SetupSuite() {
+	// Gin testing mode
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerDeleteRatingTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = rating.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(claims *model.Claims) *httptest.ResponseRecorder {
+		router := gin.Default()
+
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+
+		router.DELETE("/api/v1/food-recipes/:id/ratings/mine", suite.handler.Delete)
+
+		recorder := httptest.NewRecorder()
+
+		request, err := http.NewRequest(
+			http.MethodDelete,
+			"/api/v1/food-recipes/1/ratings/mine",
+			nil,
+		)
+		suite.NoError(err)
+
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	suite.errServiceDelete = nil
+	suite.service.On("Delete", mock.AnythingOfType("int"), mock.AnythingOfType("model.Claims")).Return(func(int, model.Claims) error {
+		return suite.errServiceDelete
+	})
+}
+
+func (suite *HandlerDeleteRatingTestSuite) TestResponseWithStatusCode200() {
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(&claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(`{"message":"Rating deleted successfully"}`, response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Delete", 1, claims)
+}
+
+func (suite *HandlerDeleteRatingTestSuite) TestResponseErrorStatusCode404WhenNotRated() {
+	suite.errServiceDelete = gorm.ErrRecordNotFound
+
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(&claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerDeleteRatingTestSuite) TestResponseErrorStatusCode401() {
+	response := suite.server(nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Delete", mock.Anything, mock.Anything)
+}
+
+func TestHandlerDeleteRating(t *testing.T) {
+	suite.Run(t, new(HandlerDeleteRatingTestSuite))
+}
+
+type HandlerVoteReviewTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler rating.IHandler
+	service *MockIService
+
+	// Mock data
+	respServiceVote model.Rating
+	errServiceVote  error
+
+	// Helper
+	server func(method string, payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+// This will run once before all tests in the suite
+func (suite *HandlerVoteReviewTestSuite) SetupSuite() {
+	// Gin testing mode
+	gin.SetMode(gin.TestMode)
+}
+
+func (suite *HandlerVoteReviewTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = rating.Handler{
+		Service: suite.service,
+	}
+
+	suite.server = func(method string, payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder {
+		router := gin.Default()
+
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+
+		router.POST("/api/v1/ratings/:id/votes", suite.handler.Vote)
+		router.DELETE("/api/v1/ratings/:id/votes", suite.handler.Unvote)
+
+		recorder := httptest.NewRecorder()
+
+		request, err := http.NewRequest(method, "/api/v1/ratings/7/votes", payload)
+		suite.NoError(err)
+
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+
+	suite.respServiceVote = model.Rating{
+		Model:        gorm.Model{ID: 7},
+		Score:        4,
+		FoodRecipeID: 1,
+		UserID:       "author",
+		HelpfulCount: 2,
+	}
+
+	suite.errServiceVote = nil
+	suite.service.On("Vote",
+		mock.AnythingOfType("dto.ReviewVoteRequest"),
+		mock.AnythingOfType("int"),
+		mock.AnythingOfType("model.Claims"),
+	).Return(func(dto.ReviewVoteRequest, int, model.Claims) (model.Rating, error) {
+		return suite.respServiceVote, suite.errServiceVote
+	})
+	suite.service.On("Unvote", mock.AnythingOfType("int"), mock.AnythingOfType("model.Claims")).Return(func(int, model.Claims) (model.Rating, error) {
+		return suite.respServiceVote, suite.errServiceVote
+	})
+}
+
+func (suite *HandlerVoteReviewTestSuite) TestResponseHelpfulCountWithStatusCode200() {
+	claims := model.Claims{ID: "reader"}
+	response := suite.server(http.MethodPost, strings.NewReader(`{"helpful": true}`), &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	var actual dto.RatingResponse
+	suite.NoError(json.Unmarshal(response.Body.Bytes(), &actual))
+
+	helpful := true
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(int64(2), actual.HelpfulCount)
+	suite.service.AssertCalled(suite.T(), "Vote", dto.ReviewVoteRequest{Helpful: &helpful}, 7, claims)
+}
+
+func (suite *HandlerVoteReviewTestSuite) TestResponseErrorStatusCode403WhenVoteOwnReview() {
+	suite.errServiceVote = global.ErrForbidden
+
+	claims := model.Claims{ID: "author"}
+	response := suite.server(http.MethodPost, strings.NewReader(`{"helpful": true}`), &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func (suite *HandlerVoteReviewTestSuite) TestResponseErrorStatusCode404WhenReviewNotFound() {
+	suite.errServiceVote = gorm.ErrRecordNotFound
+
+	claims := model.Claims{ID: "reader"}
+	response := suite.server(http.MethodPost, strings.NewReader(`{"helpful": true}`), &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerVoteReviewTestSuite) TestResponseErrorStatusCode401() {
+	response := suite.server(http.MethodPost, strings.NewReader(`{"helpful": true}`), nil)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "Vote", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *HandlerVoteReviewTestSuite) TestUnvoteWithStatusCode200() {
+	claims := model.Claims{ID: "reader"}
+	response := suite.server(http.MethodDelete, nil, &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.service.AssertCalled(suite.T(), "Unvote", 7, claims)
+}
+
+func (suite *HandlerVoteReviewTestSuite) TestUnvoteErrorStatusCode404WhenReviewNotFound() {
+	suite.errServiceVote = gorm.ErrRecordNotFound
+
+	claims := model.Claims{ID: "reader"}
+	response := suite.server(http.MethodDelete, nil, &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func TestHandlerVoteReview(t *testing.T) {
+	suite.Run(t, new(HandlerVoteReviewTestSuite))
+}
@@ -79,6 +79,46 @@ func (_c *MockIHandler_Create_Call) RunAndReturn(run func(ctx *gin.Context)) *Mo
 	return _c
 }
 
+// Delete provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Delete(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIHandler_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Delete(ctx interface{}) *MockIHandler_Delete_Call {
+	return &MockIHandler_Delete_Call{Call: _e.mock.On("Delete", ctx)}
+}
+
+func (_c *MockIHandler_Delete_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Delete_Call) Return() *MockIHandler_Delete_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Delete_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Delete_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Get provides a mock function for the type MockIHandler
 func (_mock *MockIHandler) Get(ctx *gin.Context) {
 	_mock.Called(ctx)
@@ -119,6 +159,126 @@ func (_c *MockIHandler_Get_Call) RunAndReturn(run func(ctx *gin.Context)) *MockI
 	return _c
 }
 
+// Unvote provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Unvote(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Unvote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unvote'
+type MockIHandler_Unvote_Call struct {
+	*mock.Call
+}
+
+// Unvote is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Unvote(ctx interface{}) *MockIHandler_Unvote_Call {
+	return &MockIHandler_Unvote_Call{Call: _e.mock.On("Unvote", ctx)}
+}
+
+func (_c *MockIHandler_Unvote_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Unvote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Unvote_Call) Return() *MockIHandler_Unvote_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Unvote_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Unvote_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Update(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIHandler_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Update(ctx interface{}) *MockIHandler_Update_Call {
+	return &MockIHandler_Update_Call{Call: _e.mock.On("Update", ctx)}
+}
+
+func (_c *MockIHandler_Update_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Update_Call) Return() *MockIHandler_Update_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Update_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Update_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Vote provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Vote(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Vote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Vote'
+type MockIHandler_Vote_Call struct {
+	*mock.Call
+}
+
+// Vote is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Vote(ctx interface{}) *MockIHandler_Vote_Call {
+	return &MockIHandler_Vote_Call{Call: _e.mock.On("Vote", ctx)}
+}
+
+func (_c *MockIHandler_Vote_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Vote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Vote_Call) Return() *MockIHandler_Vote_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Vote_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Vote_Call {
+	_c.Run(run)
+	return _c
+}
+
 // NewMockIRepository creates a new instance of MockIRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockIRepository(t interface {
@@ -146,16 +306,76 @@ func (_m *MockIRepository) EXPECT() *MockIRepository_Expecter {
 	return &MockIRepository_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) Create(rating *model.Rating) error {
+// Count provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Count(recipeID int) (int64, error) {
+	ret := _mock.Called(recipeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (int64, error)); ok {
+		return returnFunc(recipeID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) int64); ok {
+		r0 = returnFunc(recipeID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(recipeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type MockIRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - recipeID int
+func (_e *MockIRepository_Expecter) Count(recipeID interface{}) *MockIRepository_Count_Call {
+	return &MockIRepository_Count_Call{Call: _e.mock.On("Count", recipeID)}
+}
+
+func (_c *MockIRepository_Count_Call) Run(run func(recipeID int)) *MockIRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Count_Call) Return(n int64, err error) *MockIRepository_Count_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockIRepository_Count_Call) RunAndReturn(run func(recipeID int) (int64, error)) *MockIRepository_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Delete(rating model.Rating) error {
 	ret := _mock.Called(rating)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*model.Rating) error); ok {
+	if returnFunc, ok := ret.Get(0).(func(model.Rating) error); ok {
 		r0 = returnFunc(rating)
 	} else {
 		r0 = ret.Error(0)
@@ -163,22 +383,22 @@ func (_mock *MockIRepository) Create(rating *model.Rating) error {
 	return r0
 }
 
-// MockIRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockIRepository_Create_Call struct {
+// MockIRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIRepository_Delete_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
-//   - rating *model.Rating
-func (_e *MockIRepository_Expecter) Create(rating interface{}) *MockIRepository_Create_Call {
-	return &MockIRepository_Create_Call{Call: _e.mock.On("Create", rating)}
+// Delete is a helper method to define mock.On call
+//   - rating model.Rating
+func (_e *MockIRepository_Expecter) Delete(rating interface{}) *MockIRepository_Delete_Call {
+	return &MockIRepository_Delete_Call{Call: _e.mock.On("Delete", rating)}
 }
 
-func (_c *MockIRepository_Create_Call) Run(run func(rating *model.Rating)) *MockIRepository_Create_Call {
+func (_c *MockIRepository_Delete_Call) Run(run func(rating model.Rating)) *MockIRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *model.Rating
+		var arg0 model.Rating
 		if args[0] != nil {
-			arg0 = args[0].(*model.Rating)
+			arg0 = args[0].(model.Rating)
 		}
 		run(
 			arg0,
@@ -187,19 +407,19 @@ func (_c *MockIRepository_Create_Call) Run(run func(rating *model.Rating)) *Mock
 	return _c
 }
 
-func (_c *MockIRepository_Create_Call) Return(err error) *MockIRepository_Create_Call {
+func (_c *MockIRepository_Delete_Call) Return(err error) *MockIRepository_Delete_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockIRepository_Create_Call) RunAndReturn(run func(rating *model.Rating) error) *MockIRepository_Create_Call {
+func (_c *MockIRepository_Delete_Call) RunAndReturn(run func(rating model.Rating) error) *MockIRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Get provides a mock function for the type MockIRepository
-func (_mock *MockIRepository) Get(recipeID int) (model.Ratings, error) {
-	ret := _mock.Called(recipeID)
+func (_mock *MockIRepository) Get(recipeID int, query model.RatingQuery) (model.Ratings, error) {
+	ret := _mock.Called(recipeID, query)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Get")
@@ -207,18 +427,18 @@ func (_mock *MockIRepository) Get(recipeID int) (model.Ratings, error) {
 
 	var r0 model.Ratings
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int) (model.Ratings, error)); ok {
-		return returnFunc(recipeID)
+	if returnFunc, ok := ret.Get(0).(func(int, model.RatingQuery) (model.Ratings, error)); ok {
+		return returnFunc(recipeID, query)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int) model.Ratings); ok {
-		r0 = returnFunc(recipeID)
+	if returnFunc, ok := ret.Get(0).(func(int, model.RatingQuery) model.Ratings); ok {
+		r0 = returnFunc(recipeID, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(model.Ratings)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
-		r1 = returnFunc(recipeID)
+	if returnFunc, ok := ret.Get(1).(func(int, model.RatingQuery) error); ok {
+		r1 = returnFunc(recipeID, query)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -232,18 +452,24 @@ type MockIRepository_Get_Call struct {
 
 // Get is a helper method to define mock.On call
 //   - recipeID int
-func (_e *MockIRepository_Expecter) Get(recipeID interface{}) *MockIRepository_Get_Call {
-	return &MockIRepository_Get_Call{Call: _e.mock.On("Get", recipeID)}
+//   - query model.RatingQuery
+func (_e *MockIRepository_Expecter) Get(recipeID interface{}, query interface{}) *MockIRepository_Get_Call {
+	return &MockIRepository_Get_Call{Call: _e.mock.On("Get", recipeID, query)}
 }
 
-func (_c *MockIRepository_Get_Call) Run(run func(recipeID int)) *MockIRepository_Get_Call {
+func (_c *MockIRepository_Get_Call) Run(run func(recipeID int, query model.RatingQuery)) *MockIRepository_Get_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
 			arg0 = args[0].(int)
 		}
+		var arg1 model.RatingQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.RatingQuery)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -254,162 +480,624 @@ func (_c *MockIRepository_Get_Call) Return(ratings model.Ratings, err error) *Mo
 	return _c
 }
 
-func (_c *MockIRepository_Get_Call) RunAndReturn(run func(recipeID int) (model.Ratings, error)) *MockIRepository_Get_Call {
+func (_c *MockIRepository_Get_Call) RunAndReturn(run func(recipeID int, query model.RatingQuery) (model.Ratings, error)) *MockIRepository_Get_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockIUserService creates a new instance of MockIUserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockIUserService(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockIUserService {
-	mock := &MockIUserService{}
-	mock.Mock.Test(t)
+// GetByID provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetByID(id int) (model.Rating, error) {
+	ret := _mock.Called(id)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
 
-	return mock
+	var r0 model.Rating
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (model.Rating, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) model.Rating); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.Rating)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockIUserService is an autogenerated mock type for the IUserService type
-type MockIUserService struct {
-	mock.Mock
+// MockIRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIRepository_GetByID_Call struct {
+	*mock.Call
 }
 
-type MockIUserService_Expecter struct {
-	mock *mock.Mock
+// GetByID is a helper method to define mock.On call
+//   - id int
+func (_e *MockIRepository_Expecter) GetByID(id interface{}) *MockIRepository_GetByID_Call {
+	return &MockIRepository_GetByID_Call{Call: _e.mock.On("GetByID", id)}
 }
 
-func (_m *MockIUserService) EXPECT() *MockIUserService_Expecter {
-	return &MockIUserService_Expecter{mock: &_m.Mock}
+func (_c *MockIRepository_GetByID_Call) Run(run func(id int)) *MockIRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
 }
 
-// GetByID provides a mock function for the type MockIUserService
-func (_mock *MockIUserService) GetByID(claims model.Claims) (model.User, error) {
-	ret := _mock.Called(claims)
+func (_c *MockIRepository_GetByID_Call) Return(rating model.Rating, err error) *MockIRepository_GetByID_Call {
+	_c.Call.Return(rating, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetByID_Call) RunAndReturn(run func(id int) (model.Rating, error)) *MockIRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUser provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetByUser(recipeID int, userID string) (model.Rating, error) {
+	ret := _mock.Called(recipeID, userID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for GetByUser")
 	}
 
-	var r0 model.User
+	var r0 model.Rating
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
-		return returnFunc(claims)
+	if returnFunc, ok := ret.Get(0).(func(int, string) (model.Rating, error)); ok {
+		return returnFunc(recipeID, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
-		r0 = returnFunc(claims)
+	if returnFunc, ok := ret.Get(0).(func(int, string) model.Rating); ok {
+		r0 = returnFunc(recipeID, userID)
 	} else {
-		r0 = ret.Get(0).(model.User)
+		r0 = ret.Get(0).(model.Rating)
 	}
-	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
-		r1 = returnFunc(claims)
+	if returnFunc, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = returnFunc(recipeID, userID)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIUserService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockIUserService_GetByID_Call struct {
+// MockIRepository_GetByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUser'
+type MockIRepository_GetByUser_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
-//   - claims model.Claims
-func (_e *MockIUserService_Expecter) GetByID(claims interface{}) *MockIUserService_GetByID_Call {
-	return &MockIUserService_GetByID_Call{Call: _e.mock.On("GetByID", claims)}
+// GetByUser is a helper method to define mock.On call
+//   - recipeID int
+//   - userID string
+func (_e *MockIRepository_Expecter) GetByUser(recipeID interface{}, userID interface{}) *MockIRepository_GetByUser_Call {
+	return &MockIRepository_GetByUser_Call{Call: _e.mock.On("GetByUser", recipeID, userID)}
+}
+
+func (_c *MockIRepository_GetByUser_Call) Run(run func(recipeID int, userID string)) *MockIRepository_GetByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetByUser_Call) Return(rating model.Rating, err error) *MockIRepository_GetByUser_Call {
+	_c.Call.Return(rating, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetByUser_Call) RunAndReturn(run func(recipeID int, userID string) (model.Rating, error)) *MockIRepository_GetByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unvote provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Unvote(ratingID int, userID string) error {
+	ret := _mock.Called(ratingID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unvote")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) error); ok {
+		r0 = returnFunc(ratingID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Unvote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unvote'
+type MockIRepository_Unvote_Call struct {
+	*mock.Call
+}
+
+// Unvote is a helper method to define mock.On call
+//   - ratingID int
+//   - userID string
+func (_e *MockIRepository_Expecter) Unvote(ratingID interface{}, userID interface{}) *MockIRepository_Unvote_Call {
+	return &MockIRepository_Unvote_Call{Call: _e.mock.On("Unvote", ratingID, userID)}
+}
+
+func (_c *MockIRepository_Unvote_Call) Run(run func(ratingID int, userID string)) *MockIRepository_Unvote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Unvote_Call) Return(err error) *MockIRepository_Unvote_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Unvote_Call) RunAndReturn(run func(ratingID int, userID string) error) *MockIRepository_Unvote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Update(rating *model.Rating) error {
+	ret := _mock.Called(rating)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Rating) error); ok {
+		r0 = returnFunc(rating)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - rating *model.Rating
+func (_e *MockIRepository_Expecter) Update(rating interface{}) *MockIRepository_Update_Call {
+	return &MockIRepository_Update_Call{Call: _e.mock.On("Update", rating)}
+}
+
+func (_c *MockIRepository_Update_Call) Run(run func(rating *model.Rating)) *MockIRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Rating
+		if args[0] != nil {
+			arg0 = args[0].(*model.Rating)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Update_Call) Return(err error) *MockIRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Update_Call) RunAndReturn(run func(rating *model.Rating) error) *MockIRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upsert provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Upsert(rating *model.Rating) error {
+	ret := _mock.Called(rating)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.Rating) error); ok {
+		r0 = returnFunc(rating)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Upsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upsert'
+type MockIRepository_Upsert_Call struct {
+	*mock.Call
+}
+
+// Upsert is a helper method to define mock.On call
+//   - rating *model.Rating
+func (_e *MockIRepository_Expecter) Upsert(rating interface{}) *MockIRepository_Upsert_Call {
+	return &MockIRepository_Upsert_Call{Call: _e.mock.On("Upsert", rating)}
+}
+
+func (_c *MockIRepository_Upsert_Call) Run(run func(rating *model.Rating)) *MockIRepository_Upsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.Rating
+		if args[0] != nil {
+			arg0 = args[0].(*model.Rating)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Upsert_Call) Return(err error) *MockIRepository_Upsert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Upsert_Call) RunAndReturn(run func(rating *model.Rating) error) *MockIRepository_Upsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Vote provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Vote(vote *model.ReviewVote) error {
+	ret := _mock.Called(vote)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Vote")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.ReviewVote) error); ok {
+		r0 = returnFunc(vote)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Vote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Vote'
+type MockIRepository_Vote_Call struct {
+	*mock.Call
+}
+
+// Vote is a helper method to define mock.On call
+//   - vote *model.ReviewVote
+func (_e *MockIRepository_Expecter) Vote(vote interface{}) *MockIRepository_Vote_Call {
+	return &MockIRepository_Vote_Call{Call: _e.mock.On("Vote", vote)}
+}
+
+func (_c *MockIRepository_Vote_Call) Run(run func(vote *model.ReviewVote)) *MockIRepository_Vote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.ReviewVote
+		if args[0] != nil {
+			arg0 = args[0].(*model.ReviewVote)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Vote_Call) Return(err error) *MockIRepository_Vote_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Vote_Call) RunAndReturn(run func(vote *model.ReviewVote) error) *MockIRepository_Vote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIUserService creates a new instance of MockIUserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIUserService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIUserService {
+	mock := &MockIUserService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIUserService is an autogenerated mock type for the IUserService type
+type MockIUserService struct {
+	mock.Mock
+}
+
+type MockIUserService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIUserService) EXPECT() *MockIUserService_Expecter {
+	return &MockIUserService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Create(claims model.Claims) (model.User, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
+		r0 = returnFunc(claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIUserService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) Create(claims interface{}) *MockIUserService_Create_Call {
+	return &MockIUserService_Create_Call{Call: _e.mock.On("Create", claims)}
+}
+
+func (_c *MockIUserService_Create_Call) Run(run func(claims model.Claims)) *MockIUserService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_Create_Call) Return(user model.User, err error) *MockIUserService_Create_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIUserService_Create_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIUserService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) GetByID(claims model.Claims) (model.User, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.User, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.User); ok {
+		r0 = returnFunc(claims)
+	} else {
+		r0 = ret.Get(0).(model.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIUserService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) GetByID(claims interface{}) *MockIUserService_GetByID_Call {
+	return &MockIUserService_GetByID_Call{Call: _e.mock.On("GetByID", claims)}
+}
+
+func (_c *MockIUserService_GetByID_Call) Run(run func(claims model.Claims)) *MockIUserService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUserService_GetByID_Call) Return(user model.User, err error) *MockIUserService_GetByID_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockIUserService_GetByID_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIUserService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecipes provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) GetRecipes(userID string, query model.UserRecipesQuery, claims model.Claims) (model.FoodRecipes, error) {
+	ret := _mock.Called(userID, query, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecipes")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, model.UserRecipesQuery, model.Claims) (model.FoodRecipes, error)); ok {
+		return returnFunc(userID, query, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, model.UserRecipesQuery, model.Claims) model.FoodRecipes); ok {
+		r0 = returnFunc(userID, query, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, model.UserRecipesQuery, model.Claims) error); ok {
+		r1 = returnFunc(userID, query, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUserService_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
+type MockIUserService_GetRecipes_Call struct {
+	*mock.Call
+}
+
+// GetRecipes is a helper method to define mock.On call
+//   - userID string
+//   - query model.UserRecipesQuery
+//   - claims model.Claims
+func (_e *MockIUserService_Expecter) GetRecipes(userID interface{}, query interface{}, claims interface{}) *MockIUserService_GetRecipes_Call {
+	return &MockIUserService_GetRecipes_Call{Call: _e.mock.On("GetRecipes", userID, query, claims)}
 }
 
-func (_c *MockIUserService_GetByID_Call) Run(run func(claims model.Claims)) *MockIUserService_GetByID_Call {
+func (_c *MockIUserService_GetRecipes_Call) Run(run func(userID string, query model.UserRecipesQuery, claims model.Claims)) *MockIUserService_GetRecipes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 model.Claims
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(model.Claims)
+			arg0 = args[0].(string)
+		}
+		var arg1 model.UserRecipesQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.UserRecipesQuery)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIUserService_GetByID_Call) Return(user model.User, err error) *MockIUserService_GetByID_Call {
-	_c.Call.Return(user, err)
+func (_c *MockIUserService_GetRecipes_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIUserService_GetRecipes_Call {
+	_c.Call.Return(foodRecipes, err)
 	return _c
 }
 
-func (_c *MockIUserService_GetByID_Call) RunAndReturn(run func(claims model.Claims) (model.User, error)) *MockIUserService_GetByID_Call {
+func (_c *MockIUserService_GetRecipes_Call) RunAndReturn(run func(userID string, query model.UserRecipesQuery, claims model.Claims) (model.FoodRecipes, error)) *MockIUserService_GetRecipes_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRecipes provides a mock function for the type MockIUserService
-func (_mock *MockIUserService) GetRecipes(userID string, claims model.Claims) (model.FoodRecipes, error) {
-	ret := _mock.Called(userID, claims)
+// Update provides a mock function for the type MockIUserService
+func (_mock *MockIUserService) Update(user *model.User) (model.User, error) {
+	ret := _mock.Called(user)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRecipes")
+		panic("no return value specified for Update")
 	}
 
-	var r0 model.FoodRecipes
+	var r0 model.User
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) (model.FoodRecipes, error)); ok {
-		return returnFunc(userID, claims)
+	if returnFunc, ok := ret.Get(0).(func(*model.User) (model.User, error)); ok {
+		return returnFunc(user)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, model.Claims) model.FoodRecipes); ok {
-		r0 = returnFunc(userID, claims)
+	if returnFunc, ok := ret.Get(0).(func(*model.User) model.User); ok {
+		r0 = returnFunc(user)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(model.FoodRecipes)
-		}
+		r0 = ret.Get(0).(model.User)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, model.Claims) error); ok {
-		r1 = returnFunc(userID, claims)
+	if returnFunc, ok := ret.Get(1).(func(*model.User) error); ok {
+		r1 = returnFunc(user)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIUserService_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
-type MockIUserService_GetRecipes_Call struct {
+// MockIUserService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIUserService_Update_Call struct {
 	*mock.Call
 }
 
-// GetRecipes is a helper method to define mock.On call
-//   - userID string
-//   - claims model.Claims
-func (_e *MockIUserService_Expecter) GetRecipes(userID interface{}, claims interface{}) *MockIUserService_GetRecipes_Call {
-	return &MockIUserService_GetRecipes_Call{Call: _e.mock.On("GetRecipes", userID, claims)}
+// Update is a helper method to define mock.On call
+//   - user *model.User
+func (_e *MockIUserService_Expecter) Update(user interface{}) *MockIUserService_Update_Call {
+	return &MockIUserService_Update_Call{Call: _e.mock.On("Update", user)}
 }
 
-func (_c *MockIUserService_GetRecipes_Call) Run(run func(userID string, claims model.Claims)) *MockIUserService_GetRecipes_Call {
+func (_c *MockIUserService_Update_Call) Run(run func(user *model.User)) *MockIUserService_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 *model.User
 		if args[0] != nil {
-			arg0 = args[0].(string)
-		}
-		var arg1 model.Claims
-		if args[1] != nil {
-			arg1 = args[1].(model.Claims)
+			arg0 = args[0].(*model.User)
 		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIUserService_GetRecipes_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIUserService_GetRecipes_Call {
-	_c.Call.Return(foodRecipes, err)
+func (_c *MockIUserService_Update_Call) Return(user1 model.User, err error) *MockIUserService_Update_Call {
+	_c.Call.Return(user1, err)
 	return _c
 }
 
-func (_c *MockIUserService_GetRecipes_Call) RunAndReturn(run func(userID string, claims model.Claims) (model.FoodRecipes, error)) *MockIUserService_GetRecipes_Call {
+func (_c *MockIUserService_Update_Call) RunAndReturn(run func(user *model.User) (model.User, error)) *MockIUserService_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -573,32 +1261,95 @@ func (_c *MockIService_Create_Call) RunAndReturn(run func(request dto.RatingRequ
 	return _c
 }
 
+// Delete provides a mock function for the type MockIService
+func (_mock *MockIService) Delete(recipeID int, claims model.Claims) error {
+	ret := _mock.Called(recipeID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) error); ok {
+		r0 = returnFunc(recipeID, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - recipeID int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Delete(recipeID interface{}, claims interface{}) *MockIService_Delete_Call {
+	return &MockIService_Delete_Call{Call: _e.mock.On("Delete", recipeID, claims)}
+}
+
+func (_c *MockIService_Delete_Call) Run(run func(recipeID int, claims model.Claims)) *MockIService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Delete_Call) Return(err error) *MockIService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIService_Delete_Call) RunAndReturn(run func(recipeID int, claims model.Claims) error) *MockIService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function for the type MockIService
-func (_mock *MockIService) Get(recipeID int) (model.Ratings, error) {
-	ret := _mock.Called(recipeID)
+func (_mock *MockIService) Get(recipeID int, query model.RatingQuery) (model.Ratings, int64, error) {
+	ret := _mock.Called(recipeID, query)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Get")
 	}
 
 	var r0 model.Ratings
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int) (model.Ratings, error)); ok {
-		return returnFunc(recipeID)
+	var r1 int64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.RatingQuery) (model.Ratings, int64, error)); ok {
+		return returnFunc(recipeID, query)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int) model.Ratings); ok {
-		r0 = returnFunc(recipeID)
+	if returnFunc, ok := ret.Get(0).(func(int, model.RatingQuery) model.Ratings); ok {
+		r0 = returnFunc(recipeID, query)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(model.Ratings)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
-		r1 = returnFunc(recipeID)
+	if returnFunc, ok := ret.Get(1).(func(int, model.RatingQuery) int64); ok {
+		r1 = returnFunc(recipeID, query)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int64)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(int, model.RatingQuery) error); ok {
+		r2 = returnFunc(recipeID, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
 // MockIService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
@@ -608,29 +1359,245 @@ type MockIService_Get_Call struct {
 
 // Get is a helper method to define mock.On call
 //   - recipeID int
-func (_e *MockIService_Expecter) Get(recipeID interface{}) *MockIService_Get_Call {
-	return &MockIService_Get_Call{Call: _e.mock.On("Get", recipeID)}
+//   - query model.RatingQuery
+func (_e *MockIService_Expecter) Get(recipeID interface{}, query interface{}) *MockIService_Get_Call {
+	return &MockIService_Get_Call{Call: _e.mock.On("Get", recipeID, query)}
 }
 
-func (_c *MockIService_Get_Call) Run(run func(recipeID int)) *MockIService_Get_Call {
+func (_c *MockIService_Get_Call) Run(run func(recipeID int, query model.RatingQuery)) *MockIService_Get_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
 			arg0 = args[0].(int)
 		}
+		var arg1 model.RatingQuery
+		if args[1] != nil {
+			arg1 = args[1].(model.RatingQuery)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIService_Get_Call) Return(ratings model.Ratings, err error) *MockIService_Get_Call {
-	_c.Call.Return(ratings, err)
+func (_c *MockIService_Get_Call) Return(ratings model.Ratings, n int64, err error) *MockIService_Get_Call {
+	_c.Call.Return(ratings, n, err)
+	return _c
+}
+
+func (_c *MockIService_Get_Call) RunAndReturn(run func(recipeID int, query model.RatingQuery) (model.Ratings, int64, error)) *MockIService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unvote provides a mock function for the type MockIService
+func (_mock *MockIService) Unvote(ratingID int, claims model.Claims) (model.Rating, error) {
+	ret := _mock.Called(ratingID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unvote")
+	}
+
+	var r0 model.Rating
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) (model.Rating, error)); ok {
+		return returnFunc(ratingID, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) model.Rating); ok {
+		r0 = returnFunc(ratingID, claims)
+	} else {
+		r0 = ret.Get(0).(model.Rating)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, model.Claims) error); ok {
+		r1 = returnFunc(ratingID, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Unvote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unvote'
+type MockIService_Unvote_Call struct {
+	*mock.Call
+}
+
+// Unvote is a helper method to define mock.On call
+//   - ratingID int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Unvote(ratingID interface{}, claims interface{}) *MockIService_Unvote_Call {
+	return &MockIService_Unvote_Call{Call: _e.mock.On("Unvote", ratingID, claims)}
+}
+
+func (_c *MockIService_Unvote_Call) Run(run func(ratingID int, claims model.Claims)) *MockIService_Unvote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Unvote_Call) Return(rating model.Rating, err error) *MockIService_Unvote_Call {
+	_c.Call.Return(rating, err)
+	return _c
+}
+
+func (_c *MockIService_Unvote_Call) RunAndReturn(run func(ratingID int, claims model.Claims) (model.Rating, error)) *MockIService_Unvote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIService
+func (_mock *MockIService) Update(request dto.RatingRequest, recipeID int, claims model.Claims) (model.Rating, error) {
+	ret := _mock.Called(request, recipeID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 model.Rating
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.RatingRequest, int, model.Claims) (model.Rating, error)); ok {
+		return returnFunc(request, recipeID, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.RatingRequest, int, model.Claims) model.Rating); ok {
+		r0 = returnFunc(request, recipeID, claims)
+	} else {
+		r0 = ret.Get(0).(model.Rating)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.RatingRequest, int, model.Claims) error); ok {
+		r1 = returnFunc(request, recipeID, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIService_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - request dto.RatingRequest
+//   - recipeID int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Update(request interface{}, recipeID interface{}, claims interface{}) *MockIService_Update_Call {
+	return &MockIService_Update_Call{Call: _e.mock.On("Update", request, recipeID, claims)}
+}
+
+func (_c *MockIService_Update_Call) Run(run func(request dto.RatingRequest, recipeID int, claims model.Claims)) *MockIService_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.RatingRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.RatingRequest)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Update_Call) Return(rating model.Rating, err error) *MockIService_Update_Call {
+	_c.Call.Return(rating, err)
+	return _c
+}
+
+func (_c *MockIService_Update_Call) RunAndReturn(run func(request dto.RatingRequest, recipeID int, claims model.Claims) (model.Rating, error)) *MockIService_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Vote provides a mock function for the type MockIService
+func (_mock *MockIService) Vote(request dto.ReviewVoteRequest, ratingID int, claims model.Claims) (model.Rating, error) {
+	ret := _mock.Called(request, ratingID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Vote")
+	}
+
+	var r0 model.Rating
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.ReviewVoteRequest, int, model.Claims) (model.Rating, error)); ok {
+		return returnFunc(request, ratingID, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.ReviewVoteRequest, int, model.Claims) model.Rating); ok {
+		r0 = returnFunc(request, ratingID, claims)
+	} else {
+		r0 = ret.Get(0).(model.Rating)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.ReviewVoteRequest, int, model.Claims) error); ok {
+		r1 = returnFunc(request, ratingID, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Vote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Vote'
+type MockIService_Vote_Call struct {
+	*mock.Call
+}
+
+// Vote is a helper method to define mock.On call
+//   - request dto.ReviewVoteRequest
+//   - ratingID int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Vote(request interface{}, ratingID interface{}, claims interface{}) *MockIService_Vote_Call {
+	return &MockIService_Vote_Call{Call: _e.mock.On("Vote", request, ratingID, claims)}
+}
+
+func (_c *MockIService_Vote_Call) Run(run func(request dto.ReviewVoteRequest, ratingID int, claims model.Claims)) *MockIService_Vote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.ReviewVoteRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.ReviewVoteRequest)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 model.Claims
+		if args[2] != nil {
+			arg2 = args[2].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Vote_Call) Return(rating model.Rating, err error) *MockIService_Vote_Call {
+	_c.Call.Return(rating, err)
 	return _c
 }
 
-func (_c *MockIService_Get_Call) RunAndReturn(run func(recipeID int) (model.Ratings, error)) *MockIService_Get_Call {
+func (_c *MockIService_Vote_Call) RunAndReturn(run func(request dto.ReviewVoteRequest, ratingID int, claims model.Claims) (model.Rating, error)) *MockIService_Vote_Call {
 	_c.Call.Return(run)
 	return _c
 }
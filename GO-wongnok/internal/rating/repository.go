@@ -4,13 +4,24 @@ import (
 	"wongnok/internal/model"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type IRepository interface {
-	Get(recipeID int) (model.Ratings, error)
-	Create(rating *model.Rating) error
+	Get(recipeID int, query model.RatingQuery) (model.Ratings, error)
+	Count(recipeID int) (int64, error)
+	GetByUser(recipeID int, userID string) (model.Rating, error)
+	Upsert(rating *model.Rating) error
+	Update(rating *model.Rating) error
+	Delete(rating model.Rating) error
+	GetByID(id int) (model.Rating, error)
+	Vote(vote *model.ReviewVote) error
+	Unvote(ratingID int, userID string) error
 }
 
+// เลือกคอลัมน์ของ rating พร้อมจำนวนโหวตว่ามีประโยชน์
+const selectWithHelpfulCount = "ratings.*, (SELECT COUNT(*) FROM review_votes WHERE review_votes.rating_id = ratings.id AND review_votes.helpful) AS helpful_count"
+
 type Repository struct {
 	DB *gorm.DB
 }
@@ -21,20 +32,139 @@ func NewRepository(db *gorm.DB) IRepository {
 	}
 }
 
-func (repo Repository) Get(recipeID int) (model.Ratings, error) {
-	var ratings model.Ratings
+// Get ดึงคะแนนและรีวิวของสูตรอาหารทีละหน้า พร้อมข้อมูลผู้รีวิว
+func (repo Repository) Get(recipeID int, query model.RatingQuery) (model.Ratings, error) {
+	var ratings = make(model.Ratings, 0)
+	offset := (query.Page - 1) * query.Limit
 
-	if err := repo.DB.Where("food_recipe_id = ?", recipeID).Find(&ratings).Error; err != nil {
+	if err := repo.DB.Select(selectWithHelpfulCount).
+		Preload("User").
+		Where("food_recipe_id = ?", recipeID).
+		Order(query.OrderBy()).
+		Limit(query.Limit).
+		Offset(offset).
+		Find(&ratings).Error; err != nil {
 		return nil, err
 	}
 
 	return ratings, nil
 }
 
-func (repo Repository) Create(rating *model.Rating) error {
-	if err := repo.DB.Create(rating).First(&rating).Error; err != nil {
+func (repo Repository) Count(recipeID int) (int64, error) {
+	var count int64
+
+	if err := repo.DB.Model(&model.Rating{}).Where("food_recipe_id = ?", recipeID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// คะแนนที่ผู้ใช้คนนี้ให้กับสูตรนี้ (มีได้อย่างมากหนึ่งรายการ)
+func (repo Repository) GetByUser(recipeID int, userID string) (model.Rating, error) {
+	var rating model.Rating
+
+	if err := repo.DB.Where("food_recipe_id = ? AND user_id = ?", recipeID, userID).First(&rating).Error; err != nil {
+		return model.Rating{}, err
+	}
+
+	return rating, nil
+}
+
+// Upsert สร้างคะแนนใหม่ หรือแก้คะแนนเดิมถ้าผู้ใช้เคยให้คะแนนสูตรนี้แล้ว
+// อาศัย unique index (food_recipe_id, user_id) WHERE deleted_at IS NULL
+func (repo Repository) Upsert(rating *model.Rating) error {
+	err := repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:     []clause.Column{{Name: "food_recipe_id"}, {Name: "user_id"}},
+			TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "deleted_at IS NULL"}}},
+			DoUpdates:   clause.AssignmentColumns([]string{"score", "title", "body", "photo_urls", "updated_at"}),
+		}).Create(rating).Error; err != nil {
+			return err
+		}
+
+		return refreshRecipeAggregates(tx, rating.FoodRecipeID)
+	})
+	if err != nil {
+		return err
+	}
+
+	// โหลดใหม่เพื่อให้ได้ created_at ของแถวเดิมกรณีที่เป็นการแก้คะแนน
+	return repo.DB.Select(selectWithHelpfulCount).Preload("User").First(rating, rating.ID).Error
+}
+
+func (repo Repository) Update(rating *model.Rating) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(rating).Select("score", "title", "body", "photo_urls").Updates(rating).Error; err != nil {
+			return err
+		}
+
+		return refreshRecipeAggregates(tx, rating.FoodRecipeID)
+	})
+}
+
+func (repo Repository) Delete(rating model.Rating) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&model.Rating{}, rating.ID).Error; err != nil {
+			return err
+		}
+
+		return refreshRecipeAggregates(tx, rating.FoodRecipeID)
+	})
+}
+
+// refreshRecipeAggregates คำนวณคอลัมน์สรุปคะแนนของสูตรใหม่จากตาราง ratings
+// ต้องเรียกใน transaction เดียวกับที่แก้ ratings เพื่อให้ค่าสรุปตรงกับข้อมูลเสมอ
+func refreshRecipeAggregates(tx *gorm.DB, recipeID uint) error {
+	// ล็อกแถวของสูตรก่อน ให้ transaction ที่ให้คะแนนสูตรเดียวกันพร้อมกันคำนวณทีละราย
+	// คำสั่งถัดไปจะเห็นแถวที่อีก transaction commit แล้ว (ไม่อย่างนั้นต่างฝ่ายอาจนับไม่เห็นคะแนนของอีกฝ่าย)
+	// ใช้ NO KEY UPDATE เพราะไม่ชนกับ KEY SHARE ที่ foreign key ของ ratings ถือไว้อยู่แล้ว จึงไม่เกิด deadlock
+	if err := tx.Exec("SELECT 1 FROM food_recipes WHERE id = ? FOR NO KEY UPDATE", recipeID).Error; err != nil {
 		return err
 	}
 
-	return nil
+	return tx.Exec(`
+		UPDATE food_recipes SET
+			rating_count = agg.count,
+			rating_sum = agg.sum,
+			rating_star1 = agg.star1,
+			rating_star2 = agg.star2,
+			rating_star3 = agg.star3,
+			rating_star4 = agg.star4,
+			rating_star5 = agg.star5
+		FROM (
+			SELECT
+				COUNT(*) AS count,
+				COALESCE(SUM(score), 0) AS sum,
+				COUNT(*) FILTER (WHERE LEAST(GREATEST(FLOOR(score), 1), 5) = 1) AS star1,
+				COUNT(*) FILTER (WHERE LEAST(GREATEST(FLOOR(score), 1), 5) = 2) AS star2,
+				COUNT(*) FILTER (WHERE LEAST(GREATEST(FLOOR(score), 1), 5) = 3) AS star3,
+				COUNT(*) FILTER (WHERE LEAST(GREATEST(FLOOR(score), 1), 5) = 4) AS star4,
+				COUNT(*) FILTER (WHERE LEAST(GREATEST(FLOOR(score), 1), 5) = 5) AS star5
+			FROM ratings
+			WHERE food_recipe_id = ? AND deleted_at IS NULL
+		) AS agg
+		WHERE food_recipes.id = ?`, recipeID, recipeID).Error
+}
+
+func (repo Repository) GetByID(id int) (model.Rating, error) {
+	var rating model.Rating
+
+	if err := repo.DB.Select(selectWithHelpfulCount).Preload("User").First(&rating, id).Error; err != nil {
+		return model.Rating{}, err
+	}
+
+	return rating, nil
+}
+
+// Vote บันทึกโหวต ถ้าเคยโหวตแล้วจะเปลี่ยนเป็นค่าใหม่
+func (repo Repository) Vote(vote *model.ReviewVote) error {
+	return repo.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "rating_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"helpful", "updated_at"}),
+	}).Create(vote).Error
+}
+
+func (repo Repository) Unvote(ratingID int, userID string) error {
+	return repo.DB.Where("rating_id = ? AND user_id = ?", ratingID, userID).Delete(&model.ReviewVote{}).Error
 }
@@ -101,18 +101,18 @@ type RepositoryCreateRatingTestSuite struct {
 func (suite *RepositoryCreateRatingTestSuite) TestReturnRatingPointerWhenRatingCreated() {
 	rating := model.Rating{
 		Score:        5,
-		FoodRecipeID: 1,
+		FoodRecipeID: 2,
 		UserID:       "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
 	}
 
-	err := suite.repository.Create(&rating)
+	err := suite.repository.Upsert(&rating)
 	suite.NoError(err)
 
 	// GORM จะ auto-assign ID ที่ database generate ให้
 	expectedRating := model.Rating{
-		Model:        gorm.Model{ID: 3}, // ← Database auto-increment มี 1 อยู่แล้วตอน initial data
+		Model:        gorm.Model{ID: 3}, // ← Database auto-increment มี 2 อยู่แล้วตอน initial data
 		Score:        5,
-		FoodRecipeID: 1,
+		FoodRecipeID: 2,
 		UserID:       "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
 	}
 
@@ -123,6 +123,25 @@ func (suite *RepositoryCreateRatingTestSuite) TestReturnRatingPointerWhenRatingC
 	suite.Equal(expectedRating, rating)
 }
 
+func (suite *RepositoryCreateRatingTestSuite) TestUpdateScoreWhenUserAlreadyRated() {
+	rating := model.Rating{
+		Score:        2,
+		FoodRecipeID: 1,
+		UserID:       "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
+	}
+
+	err := suite.repository.Upsert(&rating)
+	suite.NoError(err)
+
+	// แก้แถวเดิม (id 1) ไม่สร้างแถวใหม่
+	suite.Equal(uint(1), rating.ID)
+	suite.Equal(2.0, rating.Score)
+
+	ratings, err := suite.repository.Get(1, model.RatingQuery{Page: 1, Limit: 10})
+	suite.NoError(err)
+	suite.Len(ratings, 2)
+}
+
 func (suite *RepositoryCreateRatingTestSuite) TestErrorWhenDuplicateID() {
 	rating := model.Rating{
 		Model:        gorm.Model{ID: 1},
@@ -131,7 +150,7 @@ func (suite *RepositoryCreateRatingTestSuite) TestErrorWhenDuplicateID() {
 		UserID:       "1a",
 	}
 
-	err := suite.repository.Create(&rating)
+	err := suite.repository.Upsert(&rating)
 	suite.Error(err)
 
 	// SQL state 23505 is พยายามจะ insert ข้อมูลที่มี primary key ซ้ำ
@@ -149,13 +168,15 @@ type RepositoryGetRatingTestSuite struct {
 func (suite *RepositoryGetRatingTestSuite) TestReturnRatings() {
 	recipeID := 1
 
-	result, err := suite.repository.Get(recipeID)
+	result, err := suite.repository.Get(recipeID, model.RatingQuery{Page: 1, Limit: 10, Sort: "highest"})
 	suite.NoError(err)
 
 	suite.NotEmpty(result)
+	suite.Equal("Demo", result[0].User.FirstName)
 	for index, rating := range result {
 		rating.CreatedAt = time.Time{}
 		rating.UpdatedAt = time.Time{}
+		rating.User = model.User{}
 
 		result[index] = rating
 	}
@@ -166,12 +187,14 @@ func (suite *RepositoryGetRatingTestSuite) TestReturnRatings() {
 			Score:        5,
 			FoodRecipeID: 1,
 			UserID:       "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
+			PhotoURLs:    model.StringList{},
 		},
 		{
 			Model:        gorm.Model{ID: 2},
 			Score:        3,
 			FoodRecipeID: 1,
-			UserID:       "38fa4e9e-27de-42d5-a70f-9f01d41f32c2",
+			UserID:       "7c6d2f0a-5e3b-4c1d-9a8e-2f4b6d8c0e1a",
+			PhotoURLs:    model.StringList{},
 		},
 	}, result)
 }
@@ -180,7 +203,7 @@ func (suite *RepositoryGetRatingTestSuite) TestReturnEmptyWhenNotFound() {
 	recipeID := 2
 
 	// ไม่มี rating สำหรับ recipeID 2 ใน initial data
-	result, err := suite.repository.Get(recipeID)
+	result, err := suite.repository.Get(recipeID, model.RatingQuery{Page: 1, Limit: 10, Sort: "highest"})
 
 	suite.NoError(err)
 	suite.Empty(result)
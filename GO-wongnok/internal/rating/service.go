@@ -1,6 +1,7 @@
 package rating
 
 import (
+	"wongnok/internal/global"
 	"wongnok/internal/model"
 	"wongnok/internal/model/dto"
 	"wongnok/internal/user"
@@ -13,9 +14,13 @@ import (
 type IUserService user.IService
 
 type IService interface {
-	Get(recipeID int) (model.Ratings, error)
+	Get(recipeID int, query model.RatingQuery) (model.Ratings, int64, error)
 
 	Create(request dto.RatingRequest, recipeID int, claims model.Claims) (model.Rating, error)
+	Update(request dto.RatingRequest, recipeID int, claims model.Claims) (model.Rating, error)
+	Delete(recipeID int, claims model.Claims) error
+	Vote(request dto.ReviewVoteRequest, ratingID int, claims model.Claims) (model.Rating, error)
+	Unvote(ratingID int, claims model.Claims) (model.Rating, error)
 }
 
 type Service struct {
@@ -30,18 +35,49 @@ func NewService(db *gorm.DB) IService {
 	}
 }
 
-func (service Service) Get(recipeID int) (model.Ratings, error) {
-	ratings, err := service.Repository.Get(recipeID)
+// ratingValidator validator ที่รู้จักกฎคะแนนแบบครึ่งดาวของ dto.RatingRequest
+// สร้างและ register ครั้งเดียวตอนโหลด package (validator.Validate ใช้พร้อมกันหลาย goroutine ได้)
+var ratingValidator, errRatingValidator = newValidator()
+
+func newValidator() (*validator.Validate, error) {
+	validate := validator.New()
+	if err := dto.RegisterRatingValidations(validate); err != nil {
+		return nil, errors.Wrap(err, "register rating validations")
+	}
+	return validate, nil
+}
+
+func validateRating(request dto.RatingRequest) error {
+	if errRatingValidator != nil {
+		return errRatingValidator
+	}
+	return ratingValidator.Struct(request)
+}
+
+func (service Service) Get(recipeID int, query model.RatingQuery) (model.Ratings, int64, error) {
+	if query.Page == 0 {
+		query.Page = 1
+	}
+	if query.Limit == 0 {
+		query.Limit = model.DefaultRatingLimit
+	}
+
+	total, err := service.Repository.Count(recipeID)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return ratings, nil
+	ratings, err := service.Repository.Get(recipeID, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return ratings, total, nil
 }
 
+// Create ให้คะแนนสูตรอาหาร ถ้าเคยให้คะแนนไว้แล้วจะเป็นการแก้คะแนนเดิม
 func (service Service) Create(request dto.RatingRequest, recipeID int, claims model.Claims) (model.Rating, error) {
-	validate := validator.New()
-	if err := validate.Struct(request); err != nil {
+	if err := validateRating(request); err != nil {
 		return model.Rating{}, errors.Wrap(err, "request invalid")
 	}
 
@@ -56,9 +92,89 @@ func (service Service) Create(request dto.RatingRequest, recipeID int, claims mo
 
 	rating.UserID = userID.ID
 
-	if err := service.Repository.Create(&rating); err != nil {
+	if err := service.Repository.Upsert(&rating); err != nil {
 		return model.Rating{}, errors.Wrap(err, "create rating")
 	}
 
 	return rating, nil
 }
+
+// Update แก้คะแนนของผู้ใช้ที่ login ต้องเคยให้คะแนนสูตรนี้ไว้แล้ว
+func (service Service) Update(request dto.RatingRequest, recipeID int, claims model.Claims) (model.Rating, error) {
+	if err := validateRating(request); err != nil {
+		return model.Rating{}, errors.Wrap(err, "request invalid")
+	}
+
+	rating, err := service.Repository.GetByUser(recipeID, claims.ID)
+	if err != nil {
+		return model.Rating{}, errors.Wrap(err, "find rating")
+	}
+
+	updated := rating.FromRequest(request)
+	rating.Score = updated.Score
+	rating.Title = updated.Title
+	rating.Body = updated.Body
+	rating.PhotoURLs = updated.PhotoURLs
+
+	if err := service.Repository.Update(&rating); err != nil {
+		return model.Rating{}, errors.Wrap(err, "update rating")
+	}
+
+	return rating, nil
+}
+
+// Delete ลบคะแนนของผู้ใช้ที่ login
+func (service Service) Delete(recipeID int, claims model.Claims) error {
+	rating, err := service.Repository.GetByUser(recipeID, claims.ID)
+	if err != nil {
+		return errors.Wrap(err, "find rating")
+	}
+
+	return service.Repository.Delete(rating)
+}
+
+// Vote โหวตว่ารีวิวมีประโยชน์หรือไม่ โหวตรีวิวของตัวเองไม่ได้
+func (service Service) Vote(request dto.ReviewVoteRequest, ratingID int, claims model.Claims) (model.Rating, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Rating{}, errors.Wrap(err, "request invalid")
+	}
+
+	rating, err := service.Repository.GetByID(ratingID)
+	if err != nil {
+		return model.Rating{}, errors.Wrap(err, "find rating")
+	}
+
+	if rating.UserID == claims.ID {
+		return model.Rating{}, global.ErrForbidden
+	}
+
+	user, err := service.UserService.GetByID(claims)
+	if err != nil {
+		return model.Rating{}, errors.Wrap(err, "vote review")
+	}
+
+	vote := model.ReviewVote{
+		RatingID: rating.ID,
+		UserID:   user.ID,
+		Helpful:  *request.Helpful,
+	}
+	if err := service.Repository.Vote(&vote); err != nil {
+		return model.Rating{}, errors.Wrap(err, "vote review")
+	}
+
+	return service.Repository.GetByID(ratingID)
+}
+
+// Unvote ยกเลิกโหวตของผู้ใช้ที่ login (ไม่มีโหวตอยู่ก็ไม่ถือว่าผิดพลาด)
+func (service Service) Unvote(ratingID int, claims model.Claims) (model.Rating, error) {
+	if _, err := service.Repository.GetByID(ratingID); err != nil {
+		return model.Rating{}, errors.Wrap(err, "find rating")
+	}
+
+	if err := service.Repository.Unvote(ratingID, claims.ID); err != nil {
+		return model.Rating{}, errors.Wrap(err, "unvote review")
+	}
+
+	return service.Repository.GetByID(ratingID)
+}
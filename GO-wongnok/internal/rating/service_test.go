@@ -2,7 +2,9 @@ package rating_test
 
 import (
 	"reflect"
+	"strings"
 	"testing"
+	"wongnok/internal/global"
 	"wongnok/internal/model"
 	"wongnok/internal/model/dto"
 	"wongnok/internal/rating"
@@ -67,8 +69,14 @@ func (suite *ServiceGetRating) SetupTest() {
 
 	suite.errRepositoryGet = nil
 
-	// Mock the repository's Get method
-	suite.repo.On("Get", mock.AnythingOfType("int")).Return(func(id int) (model.Ratings, error) {
+	// Mock the repository's Count and Get method
+	suite.repo.On("Count", mock.AnythingOfType("int")).Return(func(id int) (int64, error) {
+		if id == 1 {
+			return int64(len(suite.respRepositoryGet)), nil
+		}
+		return 0, nil
+	})
+	suite.repo.On("Get", mock.AnythingOfType("int"), mock.AnythingOfType("model.RatingQuery")).Return(func(id int, query model.RatingQuery) (model.Ratings, error) {
 		if id == 1 {
 			return suite.respRepositoryGet, suite.errRepositoryGet
 		}
@@ -77,9 +85,11 @@ func (suite *ServiceGetRating) SetupTest() {
 }
 
 func (suite *ServiceGetRating) TestReturnRatingsWhenFound() {
-	ratings, err := suite.service.Get(1)
+	ratings, total, err := suite.service.Get(1, model.RatingQuery{})
 
-	suite.repo.AssertCalled(suite.T(), "Get", 1)
+	suite.repo.AssertCalled(suite.T(), "Get", 1, mock.AnythingOfType("model.RatingQuery"))
+
+	assert.Equal(suite.T(), int64(3), total)
 
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), len(suite.respRepositoryGet), len(ratings))
@@ -91,11 +101,29 @@ func (suite *ServiceGetRating) TestReturnRatingsWhenFound() {
 	assert.Equal(suite.T(), suite.respRepositoryGet[2].FoodRecipeID, ratings[2].FoodRecipeID)
 }
 
+func (suite *ServiceGetRating) TestUseDefaultPaging() {
+	_, _, err := suite.service.Get(1, model.RatingQuery{Sort: "most-helpful"})
+
+	suite.NoError(err)
+	suite.repo.AssertCalled(suite.T(), "Get", 1, model.RatingQuery{
+		Page:  1,
+		Limit: model.DefaultRatingLimit,
+		Sort:  "most-helpful",
+	})
+}
+
+func (suite *ServiceGetRating) TestKeepRequestedPaging() {
+	_, _, err := suite.service.Get(1, model.RatingQuery{Page: 3, Limit: 5, Sort: "lowest"})
+
+	suite.NoError(err)
+	suite.repo.AssertCalled(suite.T(), "Get", 1, model.RatingQuery{Page: 3, Limit: 5, Sort: "lowest"})
+}
+
 func (suite *ServiceGetRating) TestReturnErrorWhenRepositoryNotFound() {
-	ratings, err := suite.service.Get(2)
+	ratings, _, err := suite.service.Get(2, model.RatingQuery{})
 
 	// Expect call repo get with recipe ID 2
-	suite.repo.AssertCalled(suite.T(), "Get", 2)
+	suite.repo.AssertCalled(suite.T(), "Get", 2, mock.AnythingOfType("model.RatingQuery"))
 
 	assert.Error(suite.T(), err)
 	assert.Nil(suite.T(), ratings)
@@ -144,7 +172,7 @@ func (suite *ServiceCreateRating) SetupTest() {
 
 	suite.errUserServiceGetByID = nil
 
-	suite.repo.On("Create", mock.AnythingOfType("*model.Rating")).Run(func(args mock.Arguments) {
+	suite.repo.On("Upsert", mock.AnythingOfType("*model.Rating")).Run(func(args mock.Arguments) {
 		rating := args.Get(0).(*model.Rating)
 		*rating = model.Rating{
 			Score:        1,
@@ -179,7 +207,7 @@ func (suite *ServiceCreateRating) TestReturnRatingWhenCreated() {
 	rating, err := suite.service.Create(request, 1, claims)
 
 	suite.userService.AssertCalled(suite.T(), "GetByID", mock.AnythingOfType("model.Claims"))
-	suite.repo.AssertCalled(suite.T(), "Create", mock.AnythingOfType("*model.Rating"))
+	suite.repo.AssertCalled(suite.T(), "Upsert", mock.AnythingOfType("*model.Rating"))
 
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), 1, int(rating.Score))
@@ -202,7 +230,7 @@ func (suite *ServiceCreateRating) TestReturnErrorWhenRequestValidate() {
 
 	suite.userService.AssertNotCalled(suite.T(), "GetByID", mock.AnythingOfType("model.Claims"))
 
-	suite.repo.AssertNotCalled(suite.T(), "Create", mock.AnythingOfType("*model.Rating"))
+	suite.repo.AssertNotCalled(suite.T(), "Upsert", mock.AnythingOfType("*model.Rating"))
 
 	assert.Equal(suite.T(), model.Rating{}, rating)
 	assert.Equal(suite.T(), "request invalid: Key: 'RatingRequest.Score' Error:Field validation for 'Score' failed on the 'required' tag", err.Error())
@@ -211,3 +239,329 @@ func (suite *ServiceCreateRating) TestReturnErrorWhenRequestValidate() {
 func TestServiceCreateRating(t *testing.T) {
 	suite.Run(t, new(ServiceCreateRating))
 }
+
+type ServiceCreateReview struct {
+	suite.Suite
+
+	// Dependencies
+	service     rating.IService
+	userService *MockIUserService
+	repo        *MockIRepository
+}
+
+func (suite *ServiceCreateReview) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.userService = new(MockIUserService)
+	suite.service = &rating.Service{
+		Repository:  suite.repo,
+		UserService: suite.userService,
+	}
+
+	suite.userService.On("GetByID", mock.AnythingOfType("model.Claims")).Return(model.User{ID: "UID"}, nil)
+	suite.repo.On("Upsert", mock.AnythingOfType("*model.Rating")).Return(nil)
+}
+
+func (suite *ServiceCreateReview) TestSaveTrimmedReviewText() {
+	request := dto.RatingRequest{
+		Score:     4.5,
+		Title:     "  Crispy and quick  ",
+		Body:      " Used less sugar. ",
+		PhotoURLs: []string{"https://example.com/omelet.jpg"},
+	}
+
+	rating, err := suite.service.Create(request, 1, model.Claims{ID: "UID"})
+
+	suite.NoError(err)
+	suite.Equal(model.Rating{
+		Score:        4.5,
+		FoodRecipeID: 1,
+		UserID:       "UID",
+		Title:        "Crispy and quick",
+		Body:         "Used less sugar.",
+		PhotoURLs:    model.StringList{"https://example.com/omelet.jpg"},
+	}, rating)
+	suite.repo.AssertCalled(suite.T(), "Upsert", &rating)
+}
+
+func (suite *ServiceCreateReview) TestErrorWhenPhotoURLInvalid() {
+	request := dto.RatingRequest{
+		Score:     4,
+		PhotoURLs: []string{"not a url"},
+	}
+
+	rating, err := suite.service.Create(request, 1, model.Claims{ID: "UID"})
+
+	suite.Error(err)
+	suite.True(strings.HasPrefix(err.Error(), "request invalid"))
+	suite.Empty(rating)
+	suite.repo.AssertNotCalled(suite.T(), "Upsert", mock.Anything)
+}
+
+func TestServiceCreateReview(t *testing.T) {
+	suite.Run(t, new(ServiceCreateReview))
+}
+
+type ServiceUpdateRating struct {
+	suite.Suite
+
+	// Dependencies
+	service rating.IService
+	repo    *MockIRepository
+
+	// Mock data
+	respRepositoryGetByUser model.Rating
+	errRepositoryUpdate     error
+}
+
+func (suite *ServiceUpdateRating) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &rating.Service{
+		Repository: suite.repo,
+	}
+
+	suite.respRepositoryGetByUser = model.Rating{
+		Model:        gorm.Model{ID: 7},
+		Score:        3,
+		FoodRecipeID: 1,
+		UserID:       "UID",
+	}
+
+	suite.errRepositoryUpdate = nil
+
+	suite.repo.On("GetByUser", mock.AnythingOfType("int"), mock.AnythingOfType("string")).Return(func(id int, userID string) (model.Rating, error) {
+		if id == 1 && userID == "UID" {
+			return suite.respRepositoryGetByUser, nil
+		}
+		return model.Rating{}, gorm.ErrRecordNotFound
+	})
+
+	suite.repo.On("Update", mock.AnythingOfType("*model.Rating")).Return(func(*model.Rating) error {
+		return suite.errRepositoryUpdate
+	})
+}
+
+func (suite *ServiceUpdateRating) TestReturnRatingWhenUpdated() {
+	rating, err := suite.service.Update(dto.RatingRequest{Score: 4.5}, 1, model.Claims{ID: "UID"})
+
+	suite.NoError(err)
+	suite.Equal(model.Rating{
+		Model:        gorm.Model{ID: 7},
+		Score:        4.5,
+		FoodRecipeID: 1,
+		UserID:       "UID",
+	}, rating)
+	suite.repo.AssertCalled(suite.T(), "Update", &rating)
+}
+
+func (suite *ServiceUpdateRating) TestReplaceReviewText() {
+	suite.respRepositoryGetByUser.Title = "Old title"
+	suite.respRepositoryGetByUser.Body = "Old body"
+	suite.respRepositoryGetByUser.PhotoURLs = model.StringList{"https://example.com/old.jpg"}
+
+	rating, err := suite.service.Update(dto.RatingRequest{Score: 4, Title: " New title "}, 1, model.Claims{ID: "UID"})
+
+	// แก้รีวิวคือแทนที่ทั้งหมด ช่องที่ไม่ส่งมาจะว่าง
+	suite.NoError(err)
+	suite.Equal("New title", rating.Title)
+	suite.Empty(rating.Body)
+	suite.Empty(rating.PhotoURLs)
+}
+
+func (suite *ServiceUpdateRating) TestErrorWhenRatingNotFound() {
+	// ผู้ใช้ยังไม่เคยให้คะแนนสูตรนี้
+	rating, err := suite.service.Update(dto.RatingRequest{Score: 4}, 1, model.Claims{ID: "other"})
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.Empty(rating)
+	suite.repo.AssertNotCalled(suite.T(), "Update", mock.Anything)
+}
+
+func (suite *ServiceUpdateRating) TestErrorWhenRequestValidate() {
+	// คะแนนต้องเพิ่มทีละครึ่งดาว
+	rating, err := suite.service.Update(dto.RatingRequest{Score: 4.7}, 1, model.Claims{ID: "UID"})
+
+	suite.Error(err)
+	suite.True(strings.HasPrefix(err.Error(), "request invalid"))
+	suite.Empty(rating)
+	suite.repo.AssertNotCalled(suite.T(), "GetByUser", mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceUpdateRating) TestErrorWhenRepositoryUpdate() {
+	suite.errRepositoryUpdate = assert.AnError
+
+	rating, err := suite.service.Update(dto.RatingRequest{Score: 4}, 1, model.Claims{ID: "UID"})
+
+	suite.ErrorIs(err, assert.AnError)
+	suite.Empty(rating)
+}
+
+func TestServiceUpdateRating(t *testing.T) {
+	suite.Run(t, new(ServiceUpdateRating))
+}
+
+type ServiceDeleteRating struct {
+	suite.Suite
+
+	// Dependencies
+	service rating.IService
+	repo    *MockIRepository
+
+	// Mock data
+	respRepositoryGetByUser model.Rating
+}
+
+func (suite *ServiceDeleteRating) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &rating.Service{
+		Repository: suite.repo,
+	}
+
+	suite.respRepositoryGetByUser = model.Rating{
+		Model:        gorm.Model{ID: 7},
+		Score:        3,
+		FoodRecipeID: 1,
+		UserID:       "UID",
+	}
+
+	suite.repo.On("GetByUser", mock.AnythingOfType("int"), mock.AnythingOfType("string")).Return(func(id int, userID string) (model.Rating, error) {
+		if id == 1 && userID == "UID" {
+			return suite.respRepositoryGetByUser, nil
+		}
+		return model.Rating{}, gorm.ErrRecordNotFound
+	})
+
+	suite.repo.On("Delete", mock.AnythingOfType("model.Rating")).Return(nil)
+}
+
+func (suite *ServiceDeleteRating) TestDeleteOwnRating() {
+	err := suite.service.Delete(1, model.Claims{ID: "UID"})
+
+	suite.NoError(err)
+	suite.repo.AssertCalled(suite.T(), "Delete", suite.respRepositoryGetByUser)
+}
+
+func (suite *ServiceDeleteRating) TestErrorWhenRatingNotFound() {
+	err := suite.service.Delete(1, model.Claims{ID: "other"})
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
+
+func TestServiceDeleteRating(t *testing.T) {
+	suite.Run(t, new(ServiceDeleteRating))
+}
+
+type ServiceVoteReview struct {
+	suite.Suite
+
+	// Dependencies
+	service     rating.IService
+	userService *MockIUserService
+	repo        *MockIRepository
+
+	// Mock data
+	respRepositoryGetByID model.Rating
+}
+
+func (suite *ServiceVoteReview) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.userService = new(MockIUserService)
+	suite.service = &rating.Service{
+		Repository:  suite.repo,
+		UserService: suite.userService,
+	}
+
+	suite.respRepositoryGetByID = model.Rating{
+		Model:        gorm.Model{ID: 7},
+		Score:        4,
+		FoodRecipeID: 1,
+		UserID:       "author",
+		HelpfulCount: 1,
+	}
+
+	suite.repo.On("GetByID", mock.AnythingOfType("int")).Return(func(id int) (model.Rating, error) {
+		if id == 7 {
+			return suite.respRepositoryGetByID, nil
+		}
+		return model.Rating{}, gorm.ErrRecordNotFound
+	})
+	suite.userService.On("GetByID", mock.AnythingOfType("model.Claims")).Return(func(claims model.Claims) (model.User, error) {
+		return model.User{ID: claims.ID}, nil
+	})
+	suite.repo.On("Vote", mock.AnythingOfType("*model.ReviewVote")).Return(nil)
+	suite.repo.On("Unvote", mock.AnythingOfType("int"), mock.AnythingOfType("string")).Return(nil)
+}
+
+func (suite *ServiceVoteReview) TestVoteHelpful() {
+	helpful := true
+
+	rating, err := suite.service.Vote(dto.ReviewVoteRequest{Helpful: &helpful}, 7, model.Claims{ID: "reader"})
+
+	suite.NoError(err)
+	suite.Equal(suite.respRepositoryGetByID, rating)
+	suite.repo.AssertCalled(suite.T(), "Vote", &model.ReviewVote{
+		RatingID: 7,
+		UserID:   "reader",
+		Helpful:  true,
+	})
+}
+
+func (suite *ServiceVoteReview) TestVoteNotHelpful() {
+	helpful := false
+
+	_, err := suite.service.Vote(dto.ReviewVoteRequest{Helpful: &helpful}, 7, model.Claims{ID: "reader"})
+
+	suite.NoError(err)
+	suite.repo.AssertCalled(suite.T(), "Vote", &model.ReviewVote{
+		RatingID: 7,
+		UserID:   "reader",
+		Helpful:  false,
+	})
+}
+
+func (suite *ServiceVoteReview) TestErrorWhenVoteOwnReview() {
+	helpful := true
+
+	rating, err := suite.service.Vote(dto.ReviewVoteRequest{Helpful: &helpful}, 7, model.Claims{ID: "author"})
+
+	suite.ErrorIs(err, global.ErrForbidden)
+	suite.Empty(rating)
+	suite.repo.AssertNotCalled(suite.T(), "Vote", mock.Anything)
+}
+
+func (suite *ServiceVoteReview) TestErrorWhenHelpfulMissing() {
+	rating, err := suite.service.Vote(dto.ReviewVoteRequest{}, 7, model.Claims{ID: "reader"})
+
+	suite.Error(err)
+	suite.True(strings.HasPrefix(err.Error(), "request invalid"))
+	suite.Empty(rating)
+	suite.repo.AssertNotCalled(suite.T(), "Vote", mock.Anything)
+}
+
+func (suite *ServiceVoteReview) TestErrorWhenReviewNotFound() {
+	helpful := true
+
+	_, err := suite.service.Vote(dto.ReviewVoteRequest{Helpful: &helpful}, 8, model.Claims{ID: "reader"})
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.repo.AssertNotCalled(suite.T(), "Vote", mock.Anything)
+}
+
+func (suite *ServiceVoteReview) TestUnvote() {
+	rating, err := suite.service.Unvote(7, model.Claims{ID: "reader"})
+
+	suite.NoError(err)
+	suite.Equal(suite.respRepositoryGetByID, rating)
+	suite.repo.AssertCalled(suite.T(), "Unvote", 7, "reader")
+}
+
+func (suite *ServiceVoteReview) TestErrorWhenUnvoteReviewNotFound() {
+	_, err := suite.service.Unvote(8, model.Claims{ID: "reader"})
+
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.repo.AssertNotCalled(suite.T(), "Unvote", mock.Anything, mock.Anything)
+}
+
+func TestServiceVoteReview(t *testing.T) {
+	suite.Run(t, new(ServiceVoteReview))
+}
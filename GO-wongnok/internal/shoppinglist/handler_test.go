@@ -0,0 +1,243 @@
+package shoppinglist_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+	"wongnok/internal/shoppinglist"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewHandler(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		handler := shoppinglist.NewHandler(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(handler))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	handler shoppinglist.IHandler
+	service *MockIService
+
+	// Mock data
+	list model.ShoppingList
+
+	// Helper
+	server func(method string, url string, payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder
+}
+
+// This will run once before all tests in the suite
+func (suite *HandlerTestSuite) SetupSuite() {
+	// Gin testing mode
+	gin.SetMode(gin.TestMode)
+}
+
+// This will run before each test
+func (suite *HandlerTestSuite) SetupTest() {
+	suite.service = new(MockIService)
+	suite.handler = shoppinglist.Handler{
+		Service: suite.service,
+	}
+
+	suite.list = model.ShoppingList{
+		Model:  gorm.Model{ID: 7},
+		UserID: "UID",
+		Name:   "Shopping list",
+		Items: model.ShoppingListItems{
+			{Model: gorm.Model{ID: 70}, ShoppingListID: 7, Name: "egg", Quantity: quantity(3), Unit: "ฟอง", Category: "dairy-eggs"},
+		},
+	}
+
+	suite.server = func(method string, url string, payload io.Reader, claims *model.Claims) *httptest.ResponseRecorder {
+		// Create router
+		router := gin.Default()
+
+		// Set context
+		router.Use(func(ctx *gin.Context) {
+			if claims != nil {
+				ctx.Set("claims", *claims)
+			}
+		})
+
+		router.GET("/api/v1/shopping-lists", suite.handler.Get)
+		router.POST("/api/v1/shopping-lists", suite.handler.Create)
+		router.GET("/api/v1/shopping-lists/:id", suite.handler.GetByID)
+		router.DELETE("/api/v1/shopping-lists/:id", suite.handler.Delete)
+		router.PATCH("/api/v1/shopping-lists/:id/items/:itemId", suite.handler.UpdateItem)
+
+		recorder := httptest.NewRecorder()
+
+		// Create request
+		request, err := http.NewRequest(method, url, payload)
+		suite.NoError(err)
+
+		// Start testing server
+		router.ServeHTTP(recorder, request)
+
+		return recorder
+	}
+}
+
+// Extend
+type HandlerGetTestSuite struct {
+	HandlerTestSuite
+}
+
+func (suite *HandlerGetTestSuite) TestResponseLists() {
+	lists := model.ShoppingLists{suite.list}
+	suite.service.On("GetByUser", model.Claims{ID: "UID"}).Return(lists, nil)
+
+	response := suite.server(http.MethodGet, "/api/v1/shopping-lists", nil, &model.Claims{ID: "UID"})
+
+	expectedJson, _ := json.Marshal(lists.ToResponse())
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+}
+
+func (suite *HandlerGetTestSuite) TestResponseListByID() {
+	suite.service.On("GetByID", 7, model.Claims{ID: "UID"}).Return(suite.list, nil)
+
+	response := suite.server(http.MethodGet, "/api/v1/shopping-lists/7", nil, &model.Claims{ID: "UID"})
+
+	expectedJson, _ := json.Marshal(suite.list.ToResponse())
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+}
+
+func (suite *HandlerGetTestSuite) TestErrorWhenOtherList() {
+	suite.service.On("GetByID", 7, model.Claims{ID: "OTHER"}).Return(model.ShoppingList{}, global.ErrForbidden)
+
+	response := suite.server(http.MethodGet, "/api/v1/shopping-lists/7", nil, &model.Claims{ID: "OTHER"})
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func (suite *HandlerGetTestSuite) TestErrorWhenNoClaims() {
+	response := suite.server(http.MethodGet, "/api/v1/shopping-lists", nil, nil)
+
+	suite.Equal(http.StatusUnauthorized, response.Code)
+}
+
+func TestHandlerGet(t *testing.T) {
+	suite.Run(t, new(HandlerGetTestSuite))
+}
+
+// Extend
+type HandlerCreateTestSuite struct {
+	HandlerTestSuite
+
+	// Mock data
+	errServiceCreate error
+}
+
+// This will run before each test
+func (suite *HandlerCreateTestSuite) SetupTest() {
+	suite.HandlerTestSuite.SetupTest()
+
+	suite.errServiceCreate = nil
+
+	suite.service.On("Create", mock.Anything, mock.Anything).Return(func(dto.ShoppingListRequest, model.Claims) (model.ShoppingList, error) {
+		return suite.list, suite.errServiceCreate
+	})
+}
+
+func (suite *HandlerCreateTestSuite) TestResponseListWithStatusCode201() {
+	claims := model.Claims{ID: "UID"}
+	response := suite.server(http.MethodPost, "/api/v1/shopping-lists", strings.NewReader(`{"recipeIDs": [1]}`), &claims)
+
+	expectedJson, _ := json.Marshal(suite.list.ToResponse())
+
+	suite.Equal(http.StatusCreated, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+	suite.service.AssertCalled(suite.T(), "Create", dto.ShoppingListRequest{RecipeIDs: []uint{1}}, claims)
+}
+
+func (suite *HandlerCreateTestSuite) TestErrorWhenRecipeNotFound() {
+	suite.errServiceCreate = errors.Wrap(gorm.ErrRecordNotFound, "find food recipes")
+
+	response := suite.server(http.MethodPost, "/api/v1/shopping-lists", strings.NewReader(`{"recipeIDs": [99]}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusNotFound, response.Code)
+}
+
+func (suite *HandlerCreateTestSuite) TestErrorWhenDateRangeInvalid() {
+	suite.errServiceCreate = global.ErrInvalidDateRange
+
+	response := suite.server(http.MethodPost, "/api/v1/shopping-lists", strings.NewReader(`{"from": "2026-10-18", "to": "2026-10-12"}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+}
+
+func TestHandlerCreate(t *testing.T) {
+	suite.Run(t, new(HandlerCreateTestSuite))
+}
+
+// Extend
+type HandlerOwnershipTestSuite struct {
+	HandlerTestSuite
+}
+
+func (suite *HandlerOwnershipTestSuite) TestErrorWhenDeleteOtherList() {
+	suite.service.On("Delete", 7, model.Claims{ID: "OTHER"}).Return(global.ErrForbidden)
+
+	response := suite.server(http.MethodDelete, "/api/v1/shopping-lists/7", nil, &model.Claims{ID: "OTHER"})
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func (suite *HandlerOwnershipTestSuite) TestErrorWhenCheckOtherList() {
+	suite.service.On("UpdateItem", mock.Anything, 7, 70, model.Claims{ID: "OTHER"}).Return(model.ShoppingList{}, global.ErrForbidden)
+
+	response := suite.server(http.MethodPatch, "/api/v1/shopping-lists/7/items/70", strings.NewReader(`{"checked": true}`), &model.Claims{ID: "OTHER"})
+
+	suite.Equal(http.StatusForbidden, response.Code)
+}
+
+func (suite *HandlerOwnershipTestSuite) TestResponseListWhenCheckItem() {
+	suite.list.Items[0].Checked = true
+	suite.service.On("UpdateItem", dto.ShoppingListItemRequest{Checked: checked(true)}, 7, 70, model.Claims{ID: "UID"}).Return(suite.list, nil)
+
+	response := suite.server(http.MethodPatch, "/api/v1/shopping-lists/7/items/70", strings.NewReader(`{"checked": true}`), &model.Claims{ID: "UID"})
+
+	expectedJson, _ := json.Marshal(suite.list.ToResponse())
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.Equal(string(expectedJson), response.Body.String())
+}
+
+func (suite *HandlerOwnershipTestSuite) TestErrorWhenItemIDInvalid() {
+	response := suite.server(http.MethodPatch, "/api/v1/shopping-lists/7/items/abc", strings.NewReader(`{"checked": true}`), &model.Claims{ID: "UID"})
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.Equal(`{"message":"invalid shopping list item id"}`, response.Body.String())
+}
+
+func TestHandlerOwnership(t *testing.T) {
+	suite.Run(t, new(HandlerOwnershipTestSuite))
+}
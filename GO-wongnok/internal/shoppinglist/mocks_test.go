@@ -0,0 +1,1101 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package shoppinglist_test
+
+import (
+	"time"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIHandler creates a new instance of MockIHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIHandler {
+	mock := &MockIHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIHandler is an autogenerated mock type for the IHandler type
+type MockIHandler struct {
+	mock.Mock
+}
+
+type MockIHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIHandler) EXPECT() *MockIHandler_Expecter {
+	return &MockIHandler_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Create(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIHandler_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Create(ctx interface{}) *MockIHandler_Create_Call {
+	return &MockIHandler_Create_Call{Call: _e.mock.On("Create", ctx)}
+}
+
+func (_c *MockIHandler_Create_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Create_Call) Return() *MockIHandler_Create_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Create_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Create_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Delete(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIHandler_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Delete(ctx interface{}) *MockIHandler_Delete_Call {
+	return &MockIHandler_Delete_Call{Call: _e.mock.On("Delete", ctx)}
+}
+
+func (_c *MockIHandler_Delete_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Delete_Call) Return() *MockIHandler_Delete_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Delete_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Delete_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) Get(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockIHandler_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) Get(ctx interface{}) *MockIHandler_Get_Call {
+	return &MockIHandler_Get_Call{Call: _e.mock.On("Get", ctx)}
+}
+
+func (_c *MockIHandler_Get_Call) Run(run func(ctx *gin.Context)) *MockIHandler_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_Get_Call) Return() *MockIHandler_Get_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_Get_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_Get_Call {
+	_c.Run(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) GetByID(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIHandler_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) GetByID(ctx interface{}) *MockIHandler_GetByID_Call {
+	return &MockIHandler_GetByID_Call{Call: _e.mock.On("GetByID", ctx)}
+}
+
+func (_c *MockIHandler_GetByID_Call) Run(run func(ctx *gin.Context)) *MockIHandler_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_GetByID_Call) Return() *MockIHandler_GetByID_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_GetByID_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_GetByID_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UpdateItem provides a mock function for the type MockIHandler
+func (_mock *MockIHandler) UpdateItem(ctx *gin.Context) {
+	_mock.Called(ctx)
+	return
+}
+
+// MockIHandler_UpdateItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateItem'
+type MockIHandler_UpdateItem_Call struct {
+	*mock.Call
+}
+
+// UpdateItem is a helper method to define mock.On call
+//   - ctx *gin.Context
+func (_e *MockIHandler_Expecter) UpdateItem(ctx interface{}) *MockIHandler_UpdateItem_Call {
+	return &MockIHandler_UpdateItem_Call{Call: _e.mock.On("UpdateItem", ctx)}
+}
+
+func (_c *MockIHandler_UpdateItem_Call) Run(run func(ctx *gin.Context)) *MockIHandler_UpdateItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *gin.Context
+		if args[0] != nil {
+			arg0 = args[0].(*gin.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHandler_UpdateItem_Call) Return() *MockIHandler_UpdateItem_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIHandler_UpdateItem_Call) RunAndReturn(run func(ctx *gin.Context)) *MockIHandler_UpdateItem_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockIRepository creates a new instance of MockIRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIRepository {
+	mock := &MockIRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIRepository is an autogenerated mock type for the IRepository type
+type MockIRepository struct {
+	mock.Mock
+}
+
+type MockIRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIRepository) EXPECT() *MockIRepository_Expecter {
+	return &MockIRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Create(list *model.ShoppingList) error {
+	ret := _mock.Called(list)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.ShoppingList) error); ok {
+		r0 = returnFunc(list)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - list *model.ShoppingList
+func (_e *MockIRepository_Expecter) Create(list interface{}) *MockIRepository_Create_Call {
+	return &MockIRepository_Create_Call{Call: _e.mock.On("Create", list)}
+}
+
+func (_c *MockIRepository_Create_Call) Run(run func(list *model.ShoppingList)) *MockIRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.ShoppingList
+		if args[0] != nil {
+			arg0 = args[0].(*model.ShoppingList)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Create_Call) Return(err error) *MockIRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Create_Call) RunAndReturn(run func(list *model.ShoppingList) error) *MockIRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Delete(id uint) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id uint
+func (_e *MockIRepository_Expecter) Delete(id interface{}) *MockIRepository_Delete_Call {
+	return &MockIRepository_Delete_Call{Call: _e.mock.On("Delete", id)}
+}
+
+func (_c *MockIRepository_Delete_Call) Run(run func(id uint)) *MockIRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint
+		if args[0] != nil {
+			arg0 = args[0].(uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Delete_Call) Return(err error) *MockIRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Delete_Call) RunAndReturn(run func(id uint) error) *MockIRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetByID(id int) (model.ShoppingList, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.ShoppingList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (model.ShoppingList, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) model.ShoppingList); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Get(0).(model.ShoppingList)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIRepository_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - id int
+func (_e *MockIRepository_Expecter) GetByID(id interface{}) *MockIRepository_GetByID_Call {
+	return &MockIRepository_GetByID_Call{Call: _e.mock.On("GetByID", id)}
+}
+
+func (_c *MockIRepository_GetByID_Call) Run(run func(id int)) *MockIRepository_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetByID_Call) Return(shoppingList model.ShoppingList, err error) *MockIRepository_GetByID_Call {
+	_c.Call.Return(shoppingList, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetByID_Call) RunAndReturn(run func(id int) (model.ShoppingList, error)) *MockIRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUser provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetByUser(userID string) (model.ShoppingLists, error) {
+	ret := _mock.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUser")
+	}
+
+	var r0 model.ShoppingLists
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (model.ShoppingLists, error)); ok {
+		return returnFunc(userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) model.ShoppingLists); ok {
+		r0 = returnFunc(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.ShoppingLists)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUser'
+type MockIRepository_GetByUser_Call struct {
+	*mock.Call
+}
+
+// GetByUser is a helper method to define mock.On call
+//   - userID string
+func (_e *MockIRepository_Expecter) GetByUser(userID interface{}) *MockIRepository_GetByUser_Call {
+	return &MockIRepository_GetByUser_Call{Call: _e.mock.On("GetByUser", userID)}
+}
+
+func (_c *MockIRepository_GetByUser_Call) Run(run func(userID string)) *MockIRepository_GetByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetByUser_Call) Return(shoppingLists model.ShoppingLists, err error) *MockIRepository_GetByUser_Call {
+	_c.Call.Return(shoppingLists, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetByUser_Call) RunAndReturn(run func(userID string) (model.ShoppingLists, error)) *MockIRepository_GetByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetItem provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetItem(listID int, itemID int) (model.ShoppingListItem, error) {
+	ret := _mock.Called(listID, itemID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetItem")
+	}
+
+	var r0 model.ShoppingListItem
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) (model.ShoppingListItem, error)); ok {
+		return returnFunc(listID, itemID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int) model.ShoppingListItem); ok {
+		r0 = returnFunc(listID, itemID)
+	} else {
+		r0 = ret.Get(0).(model.ShoppingListItem)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = returnFunc(listID, itemID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetItem'
+type MockIRepository_GetItem_Call struct {
+	*mock.Call
+}
+
+// GetItem is a helper method to define mock.On call
+//   - listID int
+//   - itemID int
+func (_e *MockIRepository_Expecter) GetItem(listID interface{}, itemID interface{}) *MockIRepository_GetItem_Call {
+	return &MockIRepository_GetItem_Call{Call: _e.mock.On("GetItem", listID, itemID)}
+}
+
+func (_c *MockIRepository_GetItem_Call) Run(run func(listID int, itemID int)) *MockIRepository_GetItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetItem_Call) Return(shoppingListItem model.ShoppingListItem, err error) *MockIRepository_GetItem_Call {
+	_c.Call.Return(shoppingListItem, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetItem_Call) RunAndReturn(run func(listID int, itemID int) (model.ShoppingListItem, error)) *MockIRepository_GetItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPlannedEntries provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetPlannedEntries(userID string, from time.Time, to time.Time) (model.MealPlanEntries, error) {
+	ret := _mock.Called(userID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlannedEntries")
+	}
+
+	var r0 model.MealPlanEntries
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, time.Time, time.Time) (model.MealPlanEntries, error)); ok {
+		return returnFunc(userID, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, time.Time, time.Time) model.MealPlanEntries); ok {
+		r0 = returnFunc(userID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.MealPlanEntries)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, time.Time, time.Time) error); ok {
+		r1 = returnFunc(userID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetPlannedEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPlannedEntries'
+type MockIRepository_GetPlannedEntries_Call struct {
+	*mock.Call
+}
+
+// GetPlannedEntries is a helper method to define mock.On call
+//   - userID string
+//   - from time.Time
+//   - to time.Time
+func (_e *MockIRepository_Expecter) GetPlannedEntries(userID interface{}, from interface{}, to interface{}) *MockIRepository_GetPlannedEntries_Call {
+	return &MockIRepository_GetPlannedEntries_Call{Call: _e.mock.On("GetPlannedEntries", userID, from, to)}
+}
+
+func (_c *MockIRepository_GetPlannedEntries_Call) Run(run func(userID string, from time.Time, to time.Time)) *MockIRepository_GetPlannedEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetPlannedEntries_Call) Return(mealPlanEntries model.MealPlanEntries, err error) *MockIRepository_GetPlannedEntries_Call {
+	_c.Call.Return(mealPlanEntries, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetPlannedEntries_Call) RunAndReturn(run func(userID string, from time.Time, to time.Time) (model.MealPlanEntries, error)) *MockIRepository_GetPlannedEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecipes provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) GetRecipes(ids []uint) (model.FoodRecipes, error) {
+	ret := _mock.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecipes")
+	}
+
+	var r0 model.FoodRecipes
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]uint) (model.FoodRecipes, error)); ok {
+		return returnFunc(ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]uint) model.FoodRecipes); ok {
+		r0 = returnFunc(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.FoodRecipes)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = returnFunc(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_GetRecipes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipes'
+type MockIRepository_GetRecipes_Call struct {
+	*mock.Call
+}
+
+// GetRecipes is a helper method to define mock.On call
+//   - ids []uint
+func (_e *MockIRepository_Expecter) GetRecipes(ids interface{}) *MockIRepository_GetRecipes_Call {
+	return &MockIRepository_GetRecipes_Call{Call: _e.mock.On("GetRecipes", ids)}
+}
+
+func (_c *MockIRepository_GetRecipes_Call) Run(run func(ids []uint)) *MockIRepository_GetRecipes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []uint
+		if args[0] != nil {
+			arg0 = args[0].([]uint)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_GetRecipes_Call) Return(foodRecipes model.FoodRecipes, err error) *MockIRepository_GetRecipes_Call {
+	_c.Call.Return(foodRecipes, err)
+	return _c
+}
+
+func (_c *MockIRepository_GetRecipes_Call) RunAndReturn(run func(ids []uint) (model.FoodRecipes, error)) *MockIRepository_GetRecipes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateItem provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) UpdateItem(item *model.ShoppingListItem) error {
+	ret := _mock.Called(item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateItem")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*model.ShoppingListItem) error); ok {
+		r0 = returnFunc(item)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_UpdateItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateItem'
+type MockIRepository_UpdateItem_Call struct {
+	*mock.Call
+}
+
+// UpdateItem is a helper method to define mock.On call
+//   - item *model.ShoppingListItem
+func (_e *MockIRepository_Expecter) UpdateItem(item interface{}) *MockIRepository_UpdateItem_Call {
+	return &MockIRepository_UpdateItem_Call{Call: _e.mock.On("UpdateItem", item)}
+}
+
+func (_c *MockIRepository_UpdateItem_Call) Run(run func(item *model.ShoppingListItem)) *MockIRepository_UpdateItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *model.ShoppingListItem
+		if args[0] != nil {
+			arg0 = args[0].(*model.ShoppingListItem)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_UpdateItem_Call) Return(err error) *MockIRepository_UpdateItem_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_UpdateItem_Call) RunAndReturn(run func(item *model.ShoppingListItem) error) *MockIRepository_UpdateItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIService creates a new instance of MockIService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIService {
+	mock := &MockIService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIService is an autogenerated mock type for the IService type
+type MockIService struct {
+	mock.Mock
+}
+
+type MockIService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIService) EXPECT() *MockIService_Expecter {
+	return &MockIService_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockIService
+func (_mock *MockIService) Create(request dto.ShoppingListRequest, claims model.Claims) (model.ShoppingList, error) {
+	ret := _mock.Called(request, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 model.ShoppingList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.ShoppingListRequest, model.Claims) (model.ShoppingList, error)); ok {
+		return returnFunc(request, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.ShoppingListRequest, model.Claims) model.ShoppingList); ok {
+		r0 = returnFunc(request, claims)
+	} else {
+		r0 = ret.Get(0).(model.ShoppingList)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.ShoppingListRequest, model.Claims) error); ok {
+		r1 = returnFunc(request, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockIService_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - request dto.ShoppingListRequest
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Create(request interface{}, claims interface{}) *MockIService_Create_Call {
+	return &MockIService_Create_Call{Call: _e.mock.On("Create", request, claims)}
+}
+
+func (_c *MockIService_Create_Call) Run(run func(request dto.ShoppingListRequest, claims model.Claims)) *MockIService_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.ShoppingListRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.ShoppingListRequest)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Create_Call) Return(shoppingList model.ShoppingList, err error) *MockIService_Create_Call {
+	_c.Call.Return(shoppingList, err)
+	return _c
+}
+
+func (_c *MockIService_Create_Call) RunAndReturn(run func(request dto.ShoppingListRequest, claims model.Claims) (model.ShoppingList, error)) *MockIService_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockIService
+func (_mock *MockIService) Delete(id int, claims model.Claims) error {
+	ret := _mock.Called(id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) error); ok {
+		r0 = returnFunc(id, claims)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIService_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockIService_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - id int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) Delete(id interface{}, claims interface{}) *MockIService_Delete_Call {
+	return &MockIService_Delete_Call{Call: _e.mock.On("Delete", id, claims)}
+}
+
+func (_c *MockIService_Delete_Call) Run(run func(id int, claims model.Claims)) *MockIService_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_Delete_Call) Return(err error) *MockIService_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIService_Delete_Call) RunAndReturn(run func(id int, claims model.Claims) error) *MockIService_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockIService
+func (_mock *MockIService) GetByID(id int, claims model.Claims) (model.ShoppingList, error) {
+	ret := _mock.Called(id, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 model.ShoppingList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) (model.ShoppingList, error)); ok {
+		return returnFunc(id, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, model.Claims) model.ShoppingList); ok {
+		r0 = returnFunc(id, claims)
+	} else {
+		r0 = ret.Get(0).(model.ShoppingList)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, model.Claims) error); ok {
+		r1 = returnFunc(id, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockIService_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - id int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetByID(id interface{}, claims interface{}) *MockIService_GetByID_Call {
+	return &MockIService_GetByID_Call{Call: _e.mock.On("GetByID", id, claims)}
+}
+
+func (_c *MockIService_GetByID_Call) Run(run func(id int, claims model.Claims)) *MockIService_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 model.Claims
+		if args[1] != nil {
+			arg1 = args[1].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetByID_Call) Return(shoppingList model.ShoppingList, err error) *MockIService_GetByID_Call {
+	_c.Call.Return(shoppingList, err)
+	return _c
+}
+
+func (_c *MockIService_GetByID_Call) RunAndReturn(run func(id int, claims model.Claims) (model.ShoppingList, error)) *MockIService_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUser provides a mock function for the type MockIService
+func (_mock *MockIService) GetByUser(claims model.Claims) (model.ShoppingLists, error) {
+	ret := _mock.Called(claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUser")
+	}
+
+	var r0 model.ShoppingLists
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) (model.ShoppingLists, error)); ok {
+		return returnFunc(claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(model.Claims) model.ShoppingLists); ok {
+		r0 = returnFunc(claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.ShoppingLists)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(model.Claims) error); ok {
+		r1 = returnFunc(claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_GetByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUser'
+type MockIService_GetByUser_Call struct {
+	*mock.Call
+}
+
+// GetByUser is a helper method to define mock.On call
+//   - claims model.Claims
+func (_e *MockIService_Expecter) GetByUser(claims interface{}) *MockIService_GetByUser_Call {
+	return &MockIService_GetByUser_Call{Call: _e.mock.On("GetByUser", claims)}
+}
+
+func (_c *MockIService_GetByUser_Call) Run(run func(claims model.Claims)) *MockIService_GetByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 model.Claims
+		if args[0] != nil {
+			arg0 = args[0].(model.Claims)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_GetByUser_Call) Return(shoppingLists model.ShoppingLists, err error) *MockIService_GetByUser_Call {
+	_c.Call.Return(shoppingLists, err)
+	return _c
+}
+
+func (_c *MockIService_GetByUser_Call) RunAndReturn(run func(claims model.Claims) (model.ShoppingLists, error)) *MockIService_GetByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateItem provides a mock function for the type MockIService
+func (_mock *MockIService) UpdateItem(request dto.ShoppingListItemRequest, listID int, itemID int, claims model.Claims) (model.ShoppingList, error) {
+	ret := _mock.Called(request, listID, itemID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateItem")
+	}
+
+	var r0 model.ShoppingList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(dto.ShoppingListItemRequest, int, int, model.Claims) (model.ShoppingList, error)); ok {
+		return returnFunc(request, listID, itemID, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(dto.ShoppingListItemRequest, int, int, model.Claims) model.ShoppingList); ok {
+		r0 = returnFunc(request, listID, itemID, claims)
+	} else {
+		r0 = ret.Get(0).(model.ShoppingList)
+	}
+	if returnFunc, ok := ret.Get(1).(func(dto.ShoppingListItemRequest, int, int, model.Claims) error); ok {
+		r1 = returnFunc(request, listID, itemID, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIService_UpdateItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateItem'
+type MockIService_UpdateItem_Call struct {
+	*mock.Call
+}
+
+// UpdateItem is a helper method to define mock.On call
+//   - request dto.ShoppingListItemRequest
+//   - listID int
+//   - itemID int
+//   - claims model.Claims
+func (_e *MockIService_Expecter) UpdateItem(request interface{}, listID interface{}, itemID interface{}, claims interface{}) *MockIService_UpdateItem_Call {
+	return &MockIService_UpdateItem_Call{Call: _e.mock.On("UpdateItem", request, listID, itemID, claims)}
+}
+
+func (_c *MockIService_UpdateItem_Call) Run(run func(request dto.ShoppingListItemRequest, listID int, itemID int, claims model.Claims)) *MockIService_UpdateItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 dto.ShoppingListItemRequest
+		if args[0] != nil {
+			arg0 = args[0].(dto.ShoppingListItemRequest)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 model.Claims
+		if args[3] != nil {
+			arg3 = args[3].(model.Claims)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIService_UpdateItem_Call) Return(shoppingList model.ShoppingList, err error) *MockIService_UpdateItem_Call {
+	_c.Call.Return(shoppingList, err)
+	return _c
+}
+
+func (_c *MockIService_UpdateItem_Call) RunAndReturn(run func(request dto.ShoppingListItemRequest, listID int, itemID int, claims model.Claims) (model.ShoppingList, error)) *MockIService_UpdateItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,113 @@
+package shoppinglist
+
+import (
+	"time"
+	"wongnok/internal/model"
+
+	"gorm.io/gorm"
+)
+
+type IRepository interface {
+	GetByUser(userID string) (model.ShoppingLists, error)
+	GetByID(id int) (model.ShoppingList, error)
+	Create(list *model.ShoppingList) error
+	Delete(id uint) error
+	GetItem(listID int, itemID int) (model.ShoppingListItem, error)
+	UpdateItem(item *model.ShoppingListItem) error
+	GetRecipes(ids []uint) (model.FoodRecipes, error)
+	GetPlannedEntries(userID string, from time.Time, to time.Time) (model.MealPlanEntries, error)
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+// GetByUser รายการซื้อของทั้งหมดของผู้ใช้ ล่าสุดก่อน
+func (repo Repository) GetByUser(userID string) (model.ShoppingLists, error) {
+	var lists = make(model.ShoppingLists, 0)
+
+	if err := repo.DB.Preload("Items", orderByPosition).
+		Where("user_id = ?", userID).
+		Order("created_at desc, id desc").
+		Find(&lists).Error; err != nil {
+		return nil, err
+	}
+
+	return lists, nil
+}
+
+func (repo Repository) GetByID(id int) (model.ShoppingList, error) {
+	var list model.ShoppingList
+
+	if err := repo.DB.Preload("Items", orderByPosition).First(&list, id).Error; err != nil {
+		return model.ShoppingList{}, err
+	}
+
+	return list, nil
+}
+
+// Create บันทึกรายการพร้อมของทุกชิ้นในคราวเดียว
+func (repo Repository) Create(list *model.ShoppingList) error {
+	return repo.DB.Create(list).Error
+}
+
+func (repo Repository) Delete(id uint) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("shopping_list_id = ?", id).Delete(&model.ShoppingListItem{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&model.ShoppingList{}, id).Error
+	})
+}
+
+func (repo Repository) GetItem(listID int, itemID int) (model.ShoppingListItem, error) {
+	var item model.ShoppingListItem
+
+	if err := repo.DB.Where("shopping_list_id = ?", listID).First(&item, itemID).Error; err != nil {
+		return model.ShoppingListItem{}, err
+	}
+
+	return item, nil
+}
+
+func (repo Repository) UpdateItem(item *model.ShoppingListItem) error {
+	return repo.DB.Model(item).Select("checked").Updates(item).Error
+}
+
+// GetRecipes สูตรตาม id พร้อมวัตถุดิบ (id ที่ไม่มีอยู่จะไม่อยู่ในผลลัพธ์)
+func (repo Repository) GetRecipes(ids []uint) (model.FoodRecipes, error) {
+	var recipes = make(model.FoodRecipes, 0)
+
+	if err := repo.DB.Preload("Ingredients", orderByPosition).Preload("Ingredients.Ingredient").Where("id IN ?", ids).Find(&recipes).Error; err != nil {
+		return nil, err
+	}
+
+	return recipes, nil
+}
+
+// GetPlannedEntries มื้อในแผนของผู้ใช้ตั้งแต่ from ถึง to (รวมทั้งสองวัน) พร้อมวัตถุดิบของสูตร
+func (repo Repository) GetPlannedEntries(userID string, from time.Time, to time.Time) (model.MealPlanEntries, error) {
+	var entries = make(model.MealPlanEntries, 0)
+
+	if err := repo.DB.Preload("FoodRecipe").
+		Preload("FoodRecipe.Ingredients", orderByPosition).
+		Preload("FoodRecipe.Ingredients.Ingredient").
+		Where("user_id = ? AND date BETWEEN ? AND ?", userID, from, to).
+		Order("date asc, id asc").
+		Find(&entries).Error; err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func orderByPosition(db *gorm.DB) *gorm.DB {
+	return db.Order("position asc")
+}
@@ -0,0 +1,188 @@
+package shoppinglist_test
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+	"wongnok/internal/model"
+	"wongnok/internal/shoppinglist"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+	driver "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestNewRepository(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		repo := shoppinglist.NewRepository(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(repo))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+type RepositoryTestSuite struct {
+	suite.Suite
+	ctx        context.Context
+	container  *postgres.PostgresContainer
+	db         *gorm.DB
+	repository shoppinglist.IRepository
+}
+
+// This will run once before all tests in the suite
+func (suite *RepositoryTestSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	container, err := postgres.Run(
+		suite.ctx,
+		"postgres:17-alpine",
+		postgres.WithInitScripts(filepath.Join("../..", "tests", "init-db.sql")),
+		postgres.WithDatabase("wongnok-test"),
+		postgres.WithUsername("postgres"),
+		postgres.WithPassword("postgres"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").WithOccurrence(2).WithStartupTimeout(
+				(5 * time.Second),
+			),
+		),
+	)
+	suite.NoError(err)
+	suite.container = container
+}
+
+// This will run once after all tests in the suite
+func (suite *RepositoryTestSuite) TearDownSuite() {
+	err := suite.container.Terminate(suite.ctx)
+	suite.NoError(err)
+}
+
+// This will run before each test
+func (suite *RepositoryTestSuite) SetupTest() {
+	conn, err := suite.container.ConnectionString(suite.ctx, "sslmode=disable")
+	suite.NoError(err)
+
+	db, err := gorm.Open(driver.Open(conn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Info),
+	})
+	suite.NoError(err)
+
+	suite.repository = &shoppinglist.Repository{
+		DB: db,
+	}
+
+	suite.db = db
+}
+
+// This will run after each test
+func (suite *RepositoryTestSuite) TearDownTest() {
+	sqldb, _ := suite.db.DB()
+	sqldb.Close()
+}
+
+const demoUserID = "38fa4e9e-27de-42d5-a70f-9f01d41f32c2"
+
+// Extend
+type RepositoryListTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositoryListTestSuite) TestCreateWithItems() {
+	list := model.ShoppingList{
+		UserID: demoUserID,
+		Name:   "Shopping list",
+		Items: model.ShoppingListItems{
+			{Position: 2, Name: "fish sauce", Unit: "tbsp", Category: "condiments"},
+			{Position: 1, Name: "egg", Quantity: quantity(3), Unit: "ฟอง", Category: "dairy-eggs"},
+		},
+	}
+	suite.NoError(suite.repository.Create(&list))
+
+	result, err := suite.repository.GetByID(int(list.ID))
+	suite.NoError(err)
+
+	// ของเรียงตาม position
+	suite.Len(result.Items, 2)
+	suite.Equal("egg", result.Items[0].Name)
+	suite.Equal(3.0, *result.Items[0].Quantity)
+	suite.Nil(result.Items[1].Quantity)
+
+	lists, err := suite.repository.GetByUser(demoUserID)
+	suite.NoError(err)
+	suite.Len(lists, 1)
+}
+
+func (suite *RepositoryListTestSuite) TestCheckItemOfList() {
+	list := model.ShoppingList{UserID: demoUserID, Name: "Party", Items: model.ShoppingListItems{{Position: 1, Name: "egg", Category: "dairy-eggs"}}}
+	suite.NoError(suite.repository.Create(&list))
+	other := model.ShoppingList{UserID: demoUserID, Name: "Other", Items: model.ShoppingListItems{{Position: 1, Name: "salt", Category: "condiments"}}}
+	suite.NoError(suite.repository.Create(&other))
+
+	// ของต้องอยู่ในรายการที่ระบุ
+	_, err := suite.repository.GetItem(int(list.ID), int(other.Items[0].ID))
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	item, err := suite.repository.GetItem(int(list.ID), int(list.Items[0].ID))
+	suite.NoError(err)
+
+	item.Checked = true
+	suite.NoError(suite.repository.UpdateItem(&item))
+
+	result, err := suite.repository.GetByID(int(list.ID))
+	suite.NoError(err)
+	suite.True(result.Items[0].Checked)
+
+	suite.NoError(suite.repository.Delete(list.ID))
+
+	_, err = suite.repository.GetByID(int(list.ID))
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func TestRepositoryList(t *testing.T) {
+	suite.Run(t, new(RepositoryListTestSuite))
+}
+
+// Extend
+type RepositorySourceTestSuite struct {
+	RepositoryTestSuite
+}
+
+func (suite *RepositorySourceTestSuite) TestSkipMissingRecipes() {
+	recipes, err := suite.repository.GetRecipes([]uint{1, 99})
+	suite.NoError(err)
+
+	suite.Len(recipes, 1)
+	suite.Len(recipes[0].Ingredients, 1)
+	suite.Equal("egg", recipes[0].Ingredients[0].Ingredient.Name)
+}
+
+func (suite *RepositorySourceTestSuite) TestGetPlannedEntriesIncludingLastDay() {
+	monday := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	for _, date := range []time.Time{monday, monday.AddDate(0, 0, 6), monday.AddDate(0, 0, 7)} {
+		entry := model.MealPlanEntry{UserID: demoUserID, Date: date, Slot: model.MealSlotDinner, FoodRecipeID: 1}
+		suite.NoError(suite.db.Omit("FoodRecipe").Create(&entry).Error)
+	}
+
+	entries, err := suite.repository.GetPlannedEntries(demoUserID, monday, monday.AddDate(0, 0, 6))
+	suite.NoError(err)
+
+	suite.Len(entries, 2)
+	suite.Equal("Omlet", entries[0].FoodRecipe.Name)
+	suite.Len(entries[0].FoodRecipe.Ingredients, 1)
+}
+
+func TestRepositorySource(t *testing.T) {
+	suite.Run(t, new(RepositorySourceTestSuite))
+}
@@ -0,0 +1,163 @@
+package shoppinglist
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// MaxPlanDays ช่วงวันที่ยาวที่สุดที่สร้างรายการจากแผนมื้ออาหารได้
+const MaxPlanDays = 31
+
+type IService interface {
+	GetByUser(claims model.Claims) (model.ShoppingLists, error)
+	GetByID(id int, claims model.Claims) (model.ShoppingList, error)
+	Create(request dto.ShoppingListRequest, claims model.Claims) (model.ShoppingList, error)
+	Delete(id int, claims model.Claims) error
+	UpdateItem(request dto.ShoppingListItemRequest, listID int, itemID int, claims model.Claims) (model.ShoppingList, error)
+}
+
+type Service struct {
+	Repository IRepository
+}
+
+func NewService(db *gorm.DB) IService {
+	return &Service{
+		Repository: NewRepository(db),
+	}
+}
+
+func (service Service) GetByUser(claims model.Claims) (model.ShoppingLists, error) {
+	lists, err := service.Repository.GetByUser(claims.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "find shopping lists")
+	}
+
+	return lists, nil
+}
+
+// GetByID รายการซื้อของ (เฉพาะของตัวเอง)
+func (service Service) GetByID(id int, claims model.Claims) (model.ShoppingList, error) {
+	list, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.ShoppingList{}, errors.Wrap(err, "find shopping list")
+	}
+
+	if list.UserID != claims.ID {
+		return model.ShoppingList{}, global.ErrForbidden
+	}
+
+	return list, nil
+}
+
+// Create สร้างรายการซื้อของจากสูตรที่เลือก หรือจากมื้อที่วางแผนไว้ในช่วงวันที่
+// มื้อในแผนจะคิดปริมาณตามจำนวนที่เสิร์ฟของมื้อนั้น
+func (service Service) Create(request dto.ShoppingListRequest, claims model.Claims) (model.ShoppingList, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.ShoppingList{}, errors.Wrap(err, "request invalid")
+	}
+
+	recipes, name, err := service.sourceRecipes(request, claims)
+	if err != nil {
+		return model.ShoppingList{}, err
+	}
+
+	if trimmed := strings.TrimSpace(request.Name); trimmed != "" {
+		name = trimmed
+	}
+
+	list := model.ShoppingList{
+		UserID: claims.ID,
+		Name:   name,
+		Items:  model.NewShoppingListItems(recipes),
+	}
+
+	if err := service.Repository.Create(&list); err != nil {
+		return model.ShoppingList{}, errors.Wrap(err, "create shopping list")
+	}
+
+	return list, nil
+}
+
+// sourceRecipes สูตรที่ใช้สร้างรายการ และชื่อเริ่มต้นของรายการ
+func (service Service) sourceRecipes(request dto.ShoppingListRequest, claims model.Claims) (model.FoodRecipes, string, error) {
+	if len(request.RecipeIDs) > 0 {
+		recipes, err := service.Repository.GetRecipes(request.RecipeIDs)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "find food recipes")
+		}
+
+		if len(recipes) != countUnique(request.RecipeIDs) {
+			return nil, "", errors.Wrap(gorm.ErrRecordNotFound, "find food recipes")
+		}
+
+		return recipes, "Shopping list", nil
+	}
+
+	from, _ := time.Parse(model.MealPlanDateLayout, request.From)
+	to, _ := time.Parse(model.MealPlanDateLayout, request.To)
+	if to.Before(from) || to.Sub(from).Hours()/24 >= MaxPlanDays {
+		return nil, "", global.ErrInvalidDateRange
+	}
+
+	entries, err := service.Repository.GetPlannedEntries(claims.ID, from, to)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "find meal plan")
+	}
+
+	recipes := make(model.FoodRecipes, 0, len(entries))
+	for _, entry := range entries {
+		recipes = append(recipes, entry.FoodRecipe.ScaleServings(entry.EffectiveServings()))
+	}
+
+	return recipes, fmt.Sprintf("Meal plan %s - %s", request.From, request.To), nil
+}
+
+func (service Service) Delete(id int, claims model.Claims) error {
+	list, err := service.GetByID(id, claims)
+	if err != nil {
+		return err
+	}
+
+	return service.Repository.Delete(list.ID)
+}
+
+// UpdateItem ติ๊ก/ยกเลิกติ๊กของในรายการ แล้วคืนรายการทั้งหมด
+func (service Service) UpdateItem(request dto.ShoppingListItemRequest, listID int, itemID int, claims model.Claims) (model.ShoppingList, error) {
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.ShoppingList{}, errors.Wrap(err, "request invalid")
+	}
+
+	if _, err := service.GetByID(listID, claims); err != nil {
+		return model.ShoppingList{}, err
+	}
+
+	item, err := service.Repository.GetItem(listID, itemID)
+	if err != nil {
+		return model.ShoppingList{}, errors.Wrap(err, "find shopping list item")
+	}
+
+	item.Checked = *request.Checked
+	if err := service.Repository.UpdateItem(&item); err != nil {
+		return model.ShoppingList{}, errors.Wrap(err, "update shopping list item")
+	}
+
+	return service.GetByID(listID, claims)
+}
+
+func countUnique(ids []uint) int {
+	seen := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		seen[id] = true
+	}
+	return len(seen)
+}
@@ -0,0 +1,243 @@
+package shoppinglist_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+	"wongnok/internal/shoppinglist"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestNewService(t *testing.T) {
+
+	t.Run("ShouldFillProperties", func(t *testing.T) {
+		service := shoppinglist.NewService(&gorm.DB{})
+
+		value := reflect.Indirect(reflect.ValueOf(service))
+
+		for index := 0; index < value.NumField(); index++ {
+			field := value.Field(index)
+			assert.False(t, field.IsZero(), "Field %s is zero value", field.Type().Name())
+		}
+	})
+
+}
+
+func quantity(value float64) *float64 {
+	return &value
+}
+
+func checked(value bool) *bool {
+	return &value
+}
+
+type ServiceTestSuite struct {
+	suite.Suite
+
+	// Dependencies
+	service shoppinglist.IService
+	repo    *MockIRepository
+
+	// Mock data
+	omelet      model.FoodRecipe
+	list        model.ShoppingList
+	respRecipes model.FoodRecipes
+	respEntries model.MealPlanEntries
+	errGetItem  error
+}
+
+// This will run before each test
+func (suite *ServiceTestSuite) SetupTest() {
+	suite.repo = new(MockIRepository)
+	suite.service = &shoppinglist.Service{
+		Repository: suite.repo,
+	}
+
+	suite.omelet = model.FoodRecipe{
+		Model:    gorm.Model{ID: 1},
+		Name:     "Omelet",
+		Servings: 2,
+		Ingredients: model.RecipeIngredients{
+			{Name: "egg", Quantity: quantity(3), Unit: "ฟอง"},
+			{Name: "fish sauce", Quantity: quantity(1), Unit: "tbsp"},
+		},
+	}
+	suite.list = model.ShoppingList{
+		Model:  gorm.Model{ID: 7},
+		UserID: "UID",
+		Name:   "Shopping list",
+		Items:  model.ShoppingListItems{{Model: gorm.Model{ID: 70}, ShoppingListID: 7, Name: "egg"}},
+	}
+	suite.respRecipes = model.FoodRecipes{suite.omelet}
+	suite.respEntries = model.MealPlanEntries{}
+	suite.errGetItem = nil
+
+	suite.repo.On("GetRecipes", mock.Anything).Return(func([]uint) (model.FoodRecipes, error) {
+		return suite.respRecipes, nil
+	})
+	suite.repo.On("GetPlannedEntries", mock.Anything, mock.Anything, mock.Anything).Return(func(string, time.Time, time.Time) (model.MealPlanEntries, error) {
+		return suite.respEntries, nil
+	})
+	suite.repo.On("Create", mock.Anything).Return(nil)
+	suite.repo.On("GetByID", 7).Return(func(int) (model.ShoppingList, error) {
+		return suite.list, nil
+	})
+	suite.repo.On("GetByID", 99).Return(model.ShoppingList{}, gorm.ErrRecordNotFound)
+	suite.repo.On("Delete", mock.Anything).Return(nil)
+	suite.repo.On("GetItem", 7, mock.Anything).Return(func(int, int) (model.ShoppingListItem, error) {
+		return suite.list.Items[0], suite.errGetItem
+	})
+	suite.repo.On("UpdateItem", mock.Anything).Return(nil)
+}
+
+// Extend
+type ServiceCreateTestSuite struct {
+	ServiceTestSuite
+}
+
+func (suite *ServiceCreateTestSuite) TestCreateFromRecipes() {
+	list, err := suite.service.Create(dto.ShoppingListRequest{RecipeIDs: []uint{1, 1}}, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	// id ซ้ำนับเป็นสูตรเดียว
+	suite.Equal("UID", list.UserID)
+	suite.Equal("Shopping list", list.Name)
+	suite.Len(list.Items, 2)
+	suite.repo.AssertNotCalled(suite.T(), "GetPlannedEntries", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestUseGivenName() {
+	list, err := suite.service.Create(dto.ShoppingListRequest{Name: "  Party  ", RecipeIDs: []uint{1}}, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.Equal("Party", list.Name)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenRecipeNotFound() {
+	_, err := suite.service.Create(dto.ShoppingListRequest{RecipeIDs: []uint{1, 99}}, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "Create", mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestCreateFromMealPlanWithServings() {
+	suite.respEntries = model.MealPlanEntries{
+		{FoodRecipeID: 1, FoodRecipe: suite.omelet, Servings: 4},
+		{FoodRecipeID: 1, FoodRecipe: suite.omelet},
+	}
+
+	list, err := suite.service.Create(dto.ShoppingListRequest{From: "2026-10-12", To: "2026-10-18"}, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	// มื้อแรกเสิร์ฟ 4 ที่ (ไข่ 6 ฟอง) มื้อที่สองตามสูตร (ไข่ 3 ฟอง)
+	suite.Equal("Meal plan 2026-10-12 - 2026-10-18", list.Name)
+	suite.repo.AssertCalled(suite.T(), "GetPlannedEntries", "UID", time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC))
+	quantities := make(map[string]float64)
+	for _, item := range list.Items {
+		quantities[item.Name] = *item.Quantity
+	}
+	suite.Equal(9.0, quantities["egg"])
+	suite.Equal(3.0, quantities["fish sauce"])
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenRangeReversed() {
+	_, err := suite.service.Create(dto.ShoppingListRequest{From: "2026-10-18", To: "2026-10-12"}, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, global.ErrInvalidDateRange)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenRangeTooLong() {
+	_, err := suite.service.Create(dto.ShoppingListRequest{From: "2026-10-01", To: "2026-11-01"}, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, global.ErrInvalidDateRange)
+
+	suite.repo.AssertNotCalled(suite.T(), "GetPlannedEntries", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *ServiceCreateTestSuite) TestErrorWhenNoSource() {
+	_, err := suite.service.Create(dto.ShoppingListRequest{}, model.Claims{ID: "UID"})
+	suite.ErrorAs(err, &validator.ValidationErrors{})
+}
+
+func TestServiceCreate(t *testing.T) {
+	suite.Run(t, new(ServiceCreateTestSuite))
+}
+
+// Extend
+type ServiceOwnershipTestSuite struct {
+	ServiceTestSuite
+}
+
+func (suite *ServiceOwnershipTestSuite) TestGetOwnList() {
+	list, err := suite.service.GetByID(7, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.Equal(suite.list, list)
+}
+
+func (suite *ServiceOwnershipTestSuite) TestErrorWhenGetOtherList() {
+	_, err := suite.service.GetByID(7, model.Claims{ID: "OTHER"})
+	suite.ErrorIs(err, global.ErrForbidden)
+}
+
+func (suite *ServiceOwnershipTestSuite) TestErrorWhenNotFound() {
+	_, err := suite.service.GetByID(99, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *ServiceOwnershipTestSuite) TestErrorWhenDeleteOtherList() {
+	err := suite.service.Delete(7, model.Claims{ID: "OTHER"})
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
+
+func (suite *ServiceOwnershipTestSuite) TestErrorWhenCheckOtherList() {
+	_, err := suite.service.UpdateItem(dto.ShoppingListItemRequest{Checked: checked(true)}, 7, 70, model.Claims{ID: "OTHER"})
+	suite.ErrorIs(err, global.ErrForbidden)
+
+	suite.repo.AssertNotCalled(suite.T(), "GetItem", mock.Anything, mock.Anything)
+}
+
+func TestServiceOwnership(t *testing.T) {
+	suite.Run(t, new(ServiceOwnershipTestSuite))
+}
+
+// Extend
+type ServiceUpdateItemTestSuite struct {
+	ServiceTestSuite
+}
+
+func (suite *ServiceUpdateItemTestSuite) TestCheckItem() {
+	_, err := suite.service.UpdateItem(dto.ShoppingListItemRequest{Checked: checked(true)}, 7, 70, model.Claims{ID: "UID"})
+	suite.NoError(err)
+
+	suite.repo.AssertCalled(suite.T(), "GetItem", 7, 70)
+	suite.repo.AssertCalled(suite.T(), "UpdateItem", mock.MatchedBy(func(item *model.ShoppingListItem) bool {
+		return item.ID == 70 && item.Checked
+	}))
+}
+
+func (suite *ServiceUpdateItemTestSuite) TestErrorWhenItemNotInList() {
+	suite.errGetItem = gorm.ErrRecordNotFound
+
+	_, err := suite.service.UpdateItem(dto.ShoppingListItemRequest{Checked: checked(true)}, 7, 80, model.Claims{ID: "UID"})
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repo.AssertNotCalled(suite.T(), "UpdateItem", mock.Anything)
+}
+
+func (suite *ServiceUpdateItemTestSuite) TestErrorWhenCheckedMissing() {
+	_, err := suite.service.UpdateItem(dto.ShoppingListItemRequest{}, 7, 70, model.Claims{ID: "UID"})
+	suite.ErrorAs(err, &validator.ValidationErrors{})
+}
+
+func TestServiceUpdateItem(t *testing.T) {
+	suite.Run(t, new(ServiceUpdateItemTestSuite))
+}
@@ -0,0 +1,80 @@
+package tag
+
+import (
+	"wongnok/internal/model"
+
+	"gorm.io/gorm"
+)
+
+type IRepository interface {
+	Get(query model.TagQuery) (model.Tags, error)
+	GetByID(id int) (model.Tag, error)
+	GetBySlug(slug string) (model.Tag, error)
+	Create(tag *model.Tag) error
+	Update(tag *model.Tag) error
+	Delete(id int) error
+}
+
+type Repository struct {
+	DB *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) IRepository {
+	return &Repository{
+		DB: db,
+	}
+}
+
+func (repo Repository) Get(query model.TagQuery) (model.Tags, error) {
+	var tags = make(model.Tags, 0)
+
+	db := repo.DB
+	if query.Category != "" {
+		db = db.Where("category = ?", query.Category)
+	}
+
+	if err := db.Order("category asc, name asc").Find(&tags).Error; err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (repo Repository) GetByID(id int) (model.Tag, error) {
+	var tag model.Tag
+
+	if err := repo.DB.First(&tag, id).Error; err != nil {
+		return model.Tag{}, err
+	}
+
+	return tag, nil
+}
+
+func (repo Repository) GetBySlug(slug string) (model.Tag, error) {
+	var tag model.Tag
+
+	if err := repo.DB.Where("slug = ?", slug).First(&tag).Error; err != nil {
+		return model.Tag{}, err
+	}
+
+	return tag, nil
+}
+
+func (repo Repository) Create(tag *model.Tag) error {
+	return repo.DB.Create(tag).Error
+}
+
+func (repo Repository) Update(tag *model.Tag) error {
+	return repo.DB.Model(tag).Where("id = ?", tag.ID).Updates(tag).Error
+}
+
+// ลบ tag พร้อมความสัมพันธ์กับสูตรอาหาร เพื่อไม่ให้ tag ที่ถูกลบยังติดอยู่กับสูตร
+func (repo Repository) Delete(id int) error {
+	return repo.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tag_id = ?", id).Delete(&model.FoodRecipeTag{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&model.Tag{}, id).Error
+	})
+}
@@ -0,0 +1,111 @@
+package tag
+
+import (
+	"wongnok/internal/global"
+	"wongnok/internal/model"
+	"wongnok/internal/model/dto"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type IService interface {
+	Get(query model.TagQuery) (model.Tags, error)
+	Create(request dto.TagRequest, claims model.Claims) (model.Tag, error)
+	Update(request dto.TagRequest, id int, claims model.Claims) (model.Tag, error)
+	Delete(id int, claims model.Claims) error
+}
+
+type Service struct {
+	Repository IRepository
+}
+
+func NewService(db *gorm.DB) IService {
+	return &Service{
+		Repository: NewRepository(db),
+	}
+}
+
+func (service Service) Get(query model.TagQuery) (model.Tags, error) {
+	return service.Repository.Get(query)
+}
+
+func (service Service) Create(request dto.TagRequest, claims model.Claims) (model.Tag, error) {
+	if !claims.IsAdmin() {
+		// จัดการ tag ได้เฉพาะ admin
+		return model.Tag{}, global.ErrForbidden
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Tag{}, errors.Wrap(err, "request invalid")
+	}
+
+	var tag model.Tag
+	tag = tag.FromRequest(request)
+
+	if err := service.ensureUniqueSlug(tag); err != nil {
+		return model.Tag{}, err
+	}
+
+	if err := service.Repository.Create(&tag); err != nil {
+		return model.Tag{}, errors.Wrap(err, "create tag")
+	}
+
+	return tag, nil
+}
+
+func (service Service) Update(request dto.TagRequest, id int, claims model.Claims) (model.Tag, error) {
+	if !claims.IsAdmin() {
+		return model.Tag{}, global.ErrForbidden
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		return model.Tag{}, errors.Wrap(err, "request invalid")
+	}
+
+	tag, err := service.Repository.GetByID(id)
+	if err != nil {
+		return model.Tag{}, errors.Wrap(err, "find tag")
+	}
+
+	tag = tag.FromRequest(request)
+
+	if err := service.ensureUniqueSlug(tag); err != nil {
+		return model.Tag{}, err
+	}
+
+	if err := service.Repository.Update(&tag); err != nil {
+		return model.Tag{}, errors.Wrap(err, "update tag")
+	}
+
+	return tag, nil
+}
+
+func (service Service) Delete(id int, claims model.Claims) error {
+	if !claims.IsAdmin() {
+		return global.ErrForbidden
+	}
+
+	if _, err := service.Repository.GetByID(id); err != nil {
+		return errors.Wrap(err, "find tag")
+	}
+
+	return service.Repository.Delete(id)
+}
+
+// slug ต้องไม่ซ้ำกับ tag อื่น
+func (service Service) ensureUniqueSlug(tag model.Tag) error {
+	existing, err := service.Repository.GetBySlug(tag.Slug)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return errors.Wrap(err, "find tag")
+	}
+
+	if err == nil && existing.ID != tag.ID {
+		return errors.Wrapf(global.ErrConflict, "tag %q already exists", tag.Slug)
+	}
+
+	return nil
+}
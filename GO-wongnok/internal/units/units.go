@@ -0,0 +1,186 @@
+package units
+
+import (
+	"math"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type Kind string
+
+const (
+	Mass   Kind = "mass"
+	Volume Kind = "volume"
+	Count  Kind = "count"
+)
+
+type System string
+
+const (
+	Metric   System = "metric"
+	Imperial System = "imperial"
+	// Kitchen หน่วยช้อน/ถ้วยตวงและหน่วยนับที่ใช้ได้ทั้งสองระบบ ช้อน/ถ้วยจะถูกแปลงเป็น ml หรือ fl oz ตอน normalise
+	Kitchen System = "kitchen"
+)
+
+var (
+	ErrUnknownUnit       = errors.New("unknown unit")
+	ErrIncompatibleUnits = errors.New("incompatible units")
+)
+
+// Unit หน่วยวัด โดย Factor คือค่าเมื่อแปลงเป็นหน่วยฐาน (กรัม สำหรับ mass, มิลลิลิตร สำหรับ volume)
+type Unit struct {
+	Name   string
+	Kind   Kind
+	System System
+	Factor float64
+}
+
+var (
+	gram       = Unit{Name: "g", Kind: Mass, System: Metric, Factor: 1}
+	kilogram   = Unit{Name: "kg", Kind: Mass, System: Metric, Factor: 1000}
+	ounce      = Unit{Name: "oz", Kind: Mass, System: Imperial, Factor: 28.349523125}
+	pound      = Unit{Name: "lb", Kind: Mass, System: Imperial, Factor: 453.59237}
+	milliliter = Unit{Name: "ml", Kind: Volume, System: Metric, Factor: 1}
+	liter      = Unit{Name: "l", Kind: Volume, System: Metric, Factor: 1000}
+	fluidOunce = Unit{Name: "fl oz", Kind: Volume, System: Imperial, Factor: 29.5735295625}
+	quart      = Unit{Name: "qt", Kind: Volume, System: Imperial, Factor: 946.352946}
+	teaspoon   = Unit{Name: "tsp", Kind: Volume, System: Kitchen, Factor: 4.92892159375}
+	tablespoon = Unit{Name: "tbsp", Kind: Volume, System: Kitchen, Factor: 14.78676478125}
+	cup        = Unit{Name: "cup", Kind: Volume, System: Kitchen, Factor: 236.5882365}
+	// ช้อนตวงและถ้วยตวงแบบไทยใช้ค่าปัดแบบเมตริก
+	thaiTeaspoon   = Unit{Name: "ช้อนชา", Kind: Volume, System: Kitchen, Factor: 5}
+	thaiTablespoon = Unit{Name: "ช้อนโต๊ะ", Kind: Volume, System: Kitchen, Factor: 15}
+	thaiCup        = Unit{Name: "ถ้วย", Kind: Volume, System: Kitchen, Factor: 240}
+)
+
+// หน่วยนับแต่ละแบบบวกกันไม่ได้ (กระเทียม 2 กลีบ กับ 1 หัว ไม่ใช่ 3 อะไรสักอย่าง)
+// ชื่อภาษาไทยและอังกฤษที่หมายถึงหน่วยเดียวกันใช้ Unit เดียวกัน เช่น ฟอง กับ eggs
+var (
+	piece = Unit{Name: "piece", Kind: Count, System: Kitchen, Factor: 1}
+	clove = Unit{Name: "clove", Kind: Count, System: Kitchen, Factor: 1}
+	head  = Unit{Name: "head", Kind: Count, System: Kitchen, Factor: 1}
+	leaf  = Unit{Name: "leaf", Kind: Count, System: Kitchen, Factor: 1}
+	slice = Unit{Name: "slice", Kind: Count, System: Kitchen, Factor: 1}
+	egg   = Unit{Name: "egg", Kind: Count, System: Kitchen, Factor: 1}
+	stalk = Unit{Name: "stalk", Kind: Count, System: Kitchen, Factor: 1}
+	whole = Unit{Name: "ลูก", Kind: Count, System: Kitchen, Factor: 1}
+)
+
+var aliases = map[string]Unit{
+	"g": gram, "gram": gram, "grams": gram, "กรัม": gram, "ก.": gram,
+	"kg": kilogram, "kilogram": kilogram, "kilograms": kilogram, "กิโลกรัม": kilogram, "กก.": kilogram, "โล": kilogram,
+	"oz": ounce, "ounce": ounce, "ounces": ounce,
+	"lb": pound, "lbs": pound, "pound": pound, "pounds": pound, "ปอนด์": pound,
+	"ml": milliliter, "milliliter": milliliter, "milliliters": milliliter, "millilitre": milliliter, "มล.": milliliter, "มิลลิลิตร": milliliter,
+	"l": liter, "liter": liter, "liters": liter, "litre": liter, "ลิตร": liter,
+	"fl oz": fluidOunce, "fl. oz": fluidOunce, "fluid ounce": fluidOunce, "fluid ounces": fluidOunce,
+	"qt": quart, "quart": quart, "quarts": quart,
+	"tsp": teaspoon, "teaspoon": teaspoon, "teaspoons": teaspoon,
+	"tbsp": tablespoon, "tablespoon": tablespoon, "tablespoons": tablespoon,
+	"cup": cup, "cups": cup,
+	"ช้อนชา": thaiTeaspoon, "ชช.": thaiTeaspoon,
+	"ช้อนโต๊ะ": thaiTablespoon, "ชต.": thaiTablespoon,
+	"ถ้วย": thaiCup, "ถ้วยตวง": thaiCup,
+	"": piece, "piece": piece, "pieces": piece, "pc": piece, "pcs": piece, "ชิ้น": piece,
+	"clove": clove, "cloves": clove, "กลีบ": clove,
+	"head": head, "heads": head, "bulb": head, "bulbs": head, "หัว": head,
+	"leaf": leaf, "leaves": leaf, "ใบ": leaf,
+	"slice": slice, "slices": slice, "แผ่น": slice,
+	"egg": egg, "eggs": egg, "ฟอง": egg,
+	"stalk": stalk, "stalks": stalk, "ต้น": stalk,
+	"ลูก": whole,
+}
+
+// Lookup หาหน่วยจากชื่อที่ผู้ใช้พิมพ์ (ไม่สนตัวพิมพ์เล็ก/ใหญ่) ชื่อว่างถือเป็นการนับจำนวน
+func Lookup(name string) (Unit, bool) {
+	unit, ok := aliases[strings.ToLower(strings.TrimSpace(name))]
+	return unit, ok
+}
+
+// ParseSystem ตรวจชื่อระบบหน่วยจาก query string
+func ParseSystem(name string) (System, bool) {
+	switch System(strings.ToLower(name)) {
+	case Metric:
+		return Metric, true
+	case Imperial:
+		return Imperial, true
+	}
+	return "", false
+}
+
+// Convert แปลงปริมาณระหว่างหน่วยชนิดเดียวกัน เช่น ถ้วย -> ml หรือ oz -> g
+func Convert(quantity float64, from string, to string) (float64, error) {
+	fromUnit, ok := Lookup(from)
+	if !ok {
+		return 0, errors.Wrapf(ErrUnknownUnit, "%q", from)
+	}
+
+	toUnit, ok := Lookup(to)
+	if !ok {
+		return 0, errors.Wrapf(ErrUnknownUnit, "%q", to)
+	}
+
+	if fromUnit.Kind != toUnit.Kind || (fromUnit.Kind == Count && fromUnit != toUnit) {
+		return 0, errors.Wrapf(ErrIncompatibleUnits, "%q to %q", from, to)
+	}
+
+	return quantity * fromUnit.Factor / toUnit.Factor, nil
+}
+
+// Normalize แปลงปริมาณให้อยู่ในระบบหน่วยที่ต้องการ โดยเลือกหน่วยที่อ่านง่าย (เช่น 1500 g -> 1.5 kg)
+// ช้อน/ถ้วยแปลงเป็น ml/l หรือ fl oz/qt ตามระบบ หน่วยนับจะคงเดิม
+// ส่วนหน่วยที่ไม่รู้จัก เช่น "a pinch" จะคืน ErrUnknownUnit
+func Normalize(quantity float64, unit string, system System) (float64, string, error) {
+	from, ok := Lookup(unit)
+	if !ok {
+		return quantity, unit, errors.Wrapf(ErrUnknownUnit, "%q", unit)
+	}
+
+	if from.Kind == Count || from.System == system {
+		return quantity, unit, nil
+	}
+
+	base := quantity * from.Factor
+	to := target(base, from.Kind, system)
+
+	return Round(base / to.Factor), to.Name, nil
+}
+
+func target(base float64, kind Kind, system System) Unit {
+	switch {
+	case kind == Mass && system == Metric:
+		if base >= kilogram.Factor {
+			return kilogram
+		}
+		return gram
+	case kind == Mass && system == Imperial:
+		if base >= pound.Factor {
+			return pound
+		}
+		return ounce
+	case kind == Volume && system == Metric:
+		if base >= liter.Factor {
+			return liter
+		}
+		return milliliter
+	default:
+		if base >= quart.Factor {
+			return quart
+		}
+		return fluidOunce
+	}
+}
+
+// Round ปัดเศษตามขนาดของตัวเลข เพื่อไม่ให้แสดงทศนิยมยาวเกินจำเป็น
+func Round(quantity float64) float64 {
+	switch {
+	case quantity >= 100:
+		return math.Round(quantity)
+	case quantity >= 10:
+		return math.Round(quantity*10) / 10
+	default:
+		return math.Round(quantity*100) / 100
+	}
+}
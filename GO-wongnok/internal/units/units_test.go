@@ -0,0 +1,162 @@
+package units_test
+
+import (
+	"testing"
+
+	"wongnok/internal/units"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	t.Run("ShouldFindUnitByAlias", func(t *testing.T) {
+		unit, ok := units.Lookup(" Tablespoons ")
+
+		assert.True(t, ok)
+		assert.Equal(t, "tbsp", unit.Name)
+		assert.Equal(t, units.Volume, unit.Kind)
+	})
+
+	t.Run("ShouldFindThaiUnits", func(t *testing.T) {
+		for _, name := range []string{"ช้อนโต๊ะ", "ช้อนชา", "ถ้วย", "กรัม", "ฟอง"} {
+			_, ok := units.Lookup(name)
+			assert.True(t, ok, name)
+		}
+	})
+
+	t.Run("ShouldTreatEmptyUnitAsCount", func(t *testing.T) {
+		unit, ok := units.Lookup("")
+
+		assert.True(t, ok)
+		assert.Equal(t, units.Count, unit.Kind)
+	})
+
+	t.Run("ShouldKeepCountUnitsApart", func(t *testing.T) {
+		clove, _ := units.Lookup("cloves")
+		head, _ := units.Lookup("หัว")
+		leaf, _ := units.Lookup("ใบ")
+		slice, _ := units.Lookup("แผ่น")
+		egg, _ := units.Lookup("eggs")
+		piece, _ := units.Lookup("piece")
+
+		names := map[string]bool{}
+		for _, unit := range []units.Unit{clove, head, leaf, slice, egg, piece} {
+			assert.Equal(t, units.Count, unit.Kind)
+			names[unit.Name] = true
+		}
+		assert.Len(t, names, 6)
+	})
+
+	t.Run("ShouldShareCountUnitBetweenLanguages", func(t *testing.T) {
+		for thai, english := range map[string]string{"ฟอง": "eggs", "กลีบ": "clove", "แผ่น": "slices", "ชิ้น": "pcs"} {
+			thaiUnit, _ := units.Lookup(thai)
+			englishUnit, _ := units.Lookup(english)
+			assert.Equal(t, englishUnit, thaiUnit, thai)
+		}
+	})
+
+	t.Run("ShouldNotFindUnknownUnit", func(t *testing.T) {
+		_, ok := units.Lookup("a pinch")
+
+		assert.False(t, ok)
+	})
+}
+
+func TestConvert(t *testing.T) {
+	testCases := []struct {
+		name     string
+		quantity float64
+		from     string
+		to       string
+		expected float64
+	}{
+		{"KilogramToGram", 1.5, "kg", "g", 1500},
+		{"PoundToOunce", 1, "lb", "oz", 16},
+		{"ThaiTablespoonToThaiTeaspoon", 1, "ช้อนโต๊ะ", "ช้อนชา", 3},
+		{"ThaiCupToMilliliter", 2, "ถ้วย", "ml", 480},
+		{"LiterToMilliliter", 0.25, "ลิตร", "มล.", 250},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := units.Convert(tc.quantity, tc.from, tc.to)
+
+			assert.NoError(t, err)
+			assert.InDelta(t, tc.expected, result, 0.0001)
+		})
+	}
+
+	t.Run("ShouldFailForDifferentKinds", func(t *testing.T) {
+		_, err := units.Convert(1, "cup", "g")
+
+		assert.ErrorIs(t, err, units.ErrIncompatibleUnits)
+	})
+
+	t.Run("ShouldFailForDifferentCountUnits", func(t *testing.T) {
+		_, err := units.Convert(2, "cloves", "หัว")
+
+		assert.ErrorIs(t, err, units.ErrIncompatibleUnits)
+	})
+
+	t.Run("ShouldFailForUnknownUnit", func(t *testing.T) {
+		_, err := units.Convert(1, "a pinch", "g")
+
+		assert.ErrorIs(t, err, units.ErrUnknownUnit)
+	})
+}
+
+func TestNormalize(t *testing.T) {
+	testCases := []struct {
+		name         string
+		quantity     float64
+		unit         string
+		system       units.System
+		expected     float64
+		expectedUnit string
+	}{
+		{"OunceToGram", 8, "oz", units.Metric, 227, "g"},
+		{"PoundToKilogram", 3, "lb", units.Metric, 1.36, "kg"},
+		{"GramToOunce", 200, "g", units.Imperial, 7.05, "oz"},
+		{"KilogramToPound", 1, "kg", units.Imperial, 2.2, "lb"},
+		{"MilliliterToFluidOunce", 250, "ml", units.Imperial, 8.45, "fl oz"},
+		{"LiterToQuart", 2, "l", units.Imperial, 2.11, "qt"},
+		{"FluidOunceToMilliliter", 4, "fl oz", units.Metric, 118, "ml"},
+		{"KeepSameSystem", 200, "g", units.Metric, 200, "g"},
+		{"TeaspoonToMilliliter", 2, "tsp", units.Metric, 9.86, "ml"},
+		{"TablespoonToMilliliter", 3, "tbsp", units.Metric, 44.4, "ml"},
+		{"CupToMilliliter", 2, "cups", units.Metric, 473, "ml"},
+		{"ThaiCupToLiter", 5, "ถ้วย", units.Metric, 1.2, "l"},
+		{"ThaiTeaspoonToMilliliter", 1, "ช้อนชา", units.Metric, 5, "ml"},
+		{"ThaiTablespoonToFluidOunce", 2, "ช้อนโต๊ะ", units.Imperial, 1.01, "fl oz"},
+		{"CupToFluidOunce", 1, "cup", units.Imperial, 8, "fl oz"},
+		{"CupToQuart", 4, "cups", units.Imperial, 1, "qt"},
+		{"KeepCountUnits", 3, "ฟอง", units.Imperial, 3, "ฟอง"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			quantity, unit, err := units.Normalize(tc.quantity, tc.unit, tc.system)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, quantity)
+			assert.Equal(t, tc.expectedUnit, unit)
+		})
+	}
+
+	t.Run("ShouldReturnOriginalValueForUnknownUnit", func(t *testing.T) {
+		quantity, unit, err := units.Normalize(1, "a pinch", units.Metric)
+
+		assert.ErrorIs(t, err, units.ErrUnknownUnit)
+		assert.Equal(t, 1.0, quantity)
+		assert.Equal(t, "a pinch", unit)
+	})
+}
+
+func TestParseSystem(t *testing.T) {
+	system, ok := units.ParseSystem("Imperial")
+	assert.True(t, ok)
+	assert.Equal(t, units.Imperial, system)
+
+	_, ok = units.ParseSystem("")
+	assert.False(t, ok)
+}
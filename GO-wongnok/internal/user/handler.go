@@ -34,6 +34,7 @@ func NewHandler(db *gorm.DB) *Handler {
 // @Accept json
 // @Produce json
 // @Param id path string true "User ID"
+// @Param sort query string false "Sort order (newest, oldest, highest-rated, top-rated, most-rated, most-favorited, name)"
 // @Success 200 {object} dto.UserResponse
 // @Failure 400 {object} map[string]interface{}
 // @Failure 401 {object} map[string]interface{}
@@ -50,7 +51,13 @@ func (handler Handler) GetRecipes(ctx *gin.Context) {
 		return
 	}
 
-	recipes, err := handler.Service.GetRecipes(userID, claims)
+	var query model.UserRecipesQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	recipes, err := handler.Service.GetRecipes(userID, query, claims)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
 		return
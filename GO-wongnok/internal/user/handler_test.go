@@ -9,7 +9,6 @@ import (
 	"testing"
 	"time"
 	"wongnok/internal/model"
-	"wongnok/internal/model/dto"
 	"wongnok/internal/user"
 
 	"github.com/gin-gonic/gin"
@@ -42,6 +41,7 @@ type HandlerGetRecipesTestSuite struct {
 	service *MockIService
 
 	// Mock data
+	query                 string
 	mockTime              time.Time
 	respServiceGetRecipes model.FoodRecipes
 	errServiceGetRecipes  error
@@ -82,7 +82,7 @@ func (suite *HandlerGetRecipesTestSuite) SetupTest() {
 		// Create request
 		request, err := http.NewRequest(
 			http.MethodGet,
-			"/api/v1/users/1/food-recipes",
+			"/api/v1/users/1/food-recipes"+suite.query,
 			payload,
 		)
 
@@ -94,6 +94,7 @@ func (suite *HandlerGetRecipesTestSuite) SetupTest() {
 		return recorder
 	}
 
+	suite.query = ""
 	suite.respServiceGetRecipes = model.FoodRecipes{
 		{
 			Model:       gorm.Model{ID: 1, CreatedAt: suite.mockTime, UpdatedAt: suite.mockTime},
@@ -119,8 +120,9 @@ func (suite *HandlerGetRecipesTestSuite) SetupTest() {
 	suite.errServiceGetRecipes = nil
 	suite.service.On("GetRecipes",
 		mock.AnythingOfType("string"),
+		mock.AnythingOfType("model.UserRecipesQuery"),
 		mock.AnythingOfType("model.Claims"),
-	).Return(func(userID string, claim model.Claims) (model.FoodRecipes, error) {
+	).Return(func(userID string, query model.UserRecipesQuery, claim model.Claims) (model.FoodRecipes, error) {
 		if userID == "1" {
 			return suite.respServiceGetRecipes, suite.errServiceGetRecipes
 		}
@@ -136,38 +138,39 @@ func (suite *HandlerGetRecipesTestSuite) TestResponseFoodRecipesWithStatusCode20
 	body := response.Result().Body
 	defer body.Close()
 
-	expectedResponse := dto.FoodRecipesResponse{
-		Total: 1,
-		Results: []dto.FoodRecipeResponse{
-			{
-				ID:          1,
-				Name:        "Name",
-				Description: "Description",
-				Ingredient:  "Ingredient",
-				Instruction: "Instruction",
-				ImageURL:    nil,
-				CookingDuration: dto.CookingDurationResponse{
-					ID:   1,
-					Name: "CookingDuration",
-				},
-				Difficulty: dto.DifficultyResponse{
-					ID:   2,
-					Name: "DifficultyName",
-				},
-				User: dto.UserResponse{
-					ID: "UID",
-				},
-				CreatedAt: suite.mockTime,
-				UpdatedAt: suite.mockTime,
-			},
-		},
-	}
+	expectedResponse := suite.respServiceGetRecipes.ToResponse(1)
 
 	expectedJson, _ := json.Marshal(expectedResponse)
 
 	suite.Equal(http.StatusOK, response.Code)
 	suite.Equal(string(expectedJson), response.Body.String())
-	suite.service.AssertCalled(suite.T(), "GetRecipes", "1", model.Claims{ID: "UID"})
+	suite.service.AssertCalled(suite.T(), "GetRecipes", "1", model.UserRecipesQuery{}, model.Claims{ID: "UID"})
+}
+
+func (suite *HandlerGetRecipesTestSuite) TestPassSortToService() {
+	suite.query = "?sort=top-rated"
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(nil, &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusOK, response.Code)
+	suite.service.AssertCalled(suite.T(), "GetRecipes", "1", model.UserRecipesQuery{Sort: "top-rated"}, model.Claims{ID: "UID"})
+}
+
+func (suite *HandlerGetRecipesTestSuite) TestErrorWhenSortInvalid() {
+	suite.query = "?sort=random"
+	claims := model.Claims{ID: "UID"}
+
+	response := suite.server(nil, &claims)
+
+	body := response.Result().Body
+	defer body.Close()
+
+	suite.Equal(http.StatusBadRequest, response.Code)
+	suite.service.AssertNotCalled(suite.T(), "GetRecipes", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func (suite *HandlerGetRecipesTestSuite) TestResponseFoodRecipesWithStatusCode400() {
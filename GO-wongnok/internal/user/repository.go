@@ -4,7 +4,6 @@ import (
 	"wongnok/internal/model"
 
 	"gorm.io/gorm"
-	"gorm.io/gorm/clause"
 )
 
 type IRepository interface {
@@ -12,7 +11,7 @@ type IRepository interface {
 	Upsert(user *model.User) error
 	Create(user *model.User) (model.User, error)
 	Update(user *model.User) (model.User, error)
-	GetRecipes(userID string) (model.FoodRecipes, error)
+	GetRecipes(userID string, query model.UserRecipesQuery, claimsID string) (model.FoodRecipes, error)
 }
 
 type Repository struct {
@@ -41,10 +40,23 @@ func (repo Repository) Upsert(user *model.User) error {
 }
 
 // ดึงสูตรอาหารทั้งหมดของผู้ใช้คนหนึ่ง (ใช้ทำหน้า "สูตรของฉัน")
-func (repo Repository) GetRecipes(userID string) (model.FoodRecipes, error) {
+// Favorite/Rating เป็นของผู้ที่กำลังดู (claimsID) ไม่ใช่เจ้าของสูตร
+func (repo Repository) GetRecipes(userID string, query model.UserRecipesQuery, claimsID string) (model.FoodRecipes, error) {
 	var recipes model.FoodRecipes
 
-	if err := repo.DB.Preload(clause.Associations).Find(&recipes, "user_id = ?", userID).Error; err != nil {
+	// ไม่ preload Ratings ทั้งหมด คะแนนเฉลี่ยใช้คอลัมน์สรุปใน food_recipes
+	if err := repo.DB.Preload("Favorite", "user_id = ?", claimsID).
+		Preload("Rating", "user_id = ?", claimsID).
+		Preload("CookingDuration").
+		Preload("Difficulty").
+		Preload("User").
+		Preload("Tags").
+		Preload("Ingredients", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position asc")
+		}).
+		Preload("Steps", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position asc")
+		}).Order(model.RecipeOrder(query.Sort, query.Prior)).Find(&recipes, "food_recipes.user_id = ?", userID).Error; err != nil {
 		return model.FoodRecipes{}, err
 	}
 
@@ -231,7 +231,7 @@ func (suite *RepositoryGetRecipesTestSuite) TestGetRecipesReturnFoodRecipes() {
 		},
 	}
 
-	foodRecipes, err := suite.repo.GetRecipes("38fa4e9e-27de-42d5-a70f-9f01d41f32c2")
+	foodRecipes, err := suite.repo.GetRecipes("38fa4e9e-27de-42d5-a70f-9f01d41f32c2", model.UserRecipesQuery{}, "38fa4e9e-27de-42d5-a70f-9f01d41f32c2")
 	suite.NoError(err)
 
 	for i := range foodRecipes {
@@ -252,6 +252,16 @@ func (suite *RepositoryGetRecipesTestSuite) TestGetRecipesReturnFoodRecipes() {
 	suite.Equal(expectedFoodRecipes, foodRecipes)
 }
 
+func (suite *RepositoryGetRecipesTestSuite) TestPreloadRatingOfViewer() {
+	// ผู้ใช้อีกคนดูหน้าของเจ้าของสูตร ต้องเห็นคะแนนที่ตัวเองให้ (3) ไม่ใช่ของเจ้าของ (5)
+	foodRecipes, err := suite.repo.GetRecipes("38fa4e9e-27de-42d5-a70f-9f01d41f32c2", model.UserRecipesQuery{}, "7c6d2f0a-5e3b-4c1d-9a8e-2f4b6d8c0e1a")
+	suite.NoError(err)
+
+	suite.NotEmpty(foodRecipes)
+	suite.Equal("7c6d2f0a-5e3b-4c1d-9a8e-2f4b6d8c0e1a", foodRecipes[0].Rating.UserID)
+	suite.Equal(3.0, foodRecipes[0].Rating.Score)
+}
+
 func TestRepositoryGetRecipes(t *testing.T) {
 	suite.Run(t, new(RepositoryGetRecipesTestSuite))
 }
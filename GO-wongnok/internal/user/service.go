@@ -2,6 +2,7 @@ package user
 
 import (
 	"strings"
+	"wongnok/internal/global"
 	"wongnok/internal/model"
 
 	"github.com/go-playground/validator/v10"
@@ -12,7 +13,7 @@ import (
 type IService interface {
 	UpsertWithClaims(claims model.Claims) (model.User, error)
 	GetByID(claims model.Claims) (model.User, error)
-	GetRecipes(userID string, claims model.Claims) (model.FoodRecipes, error)
+	GetRecipes(userID string, query model.UserRecipesQuery, claims model.Claims) (model.FoodRecipes, error)
 	Create(claims model.Claims) (model.User, error)
 	Update(user *model.User) (model.User, error)
 }
@@ -62,7 +63,7 @@ func (service Service) GetByID(claims model.Claims) (model.User, error) {
 	return user, nil
 }
 
-func (service Service) GetRecipes(userID string, claims model.Claims) (model.FoodRecipes, error) {
+func (service Service) GetRecipes(userID string, query model.UserRecipesQuery, claims model.Claims) (model.FoodRecipes, error) {
 
 	if strings.ToLower(userID) == "self" {
 		userID = claims.ID
@@ -72,7 +73,10 @@ func (service Service) GetRecipes(userID string, claims model.Claims) (model.Foo
 		return model.FoodRecipes{}, errors.Wrap(err, "find user")
 	}
 
-	foodRecipes, err := service.Repository.GetRecipes(userID)
+	// ไม่ได้แบ่งหน้า จึงใช้ค่าจาก config ตรง ๆ (Mean เป็น 0 จะคำนวณค่าเฉลี่ยใน SQL)
+	query.Prior = model.BayesianPrior{MinVotes: global.Ranking.MinVotes, Mean: global.Ranking.GlobalMean}
+
+	foodRecipes, err := service.Repository.GetRecipes(userID, query, claims.ID)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return model.FoodRecipes{}, errors.Wrap(err, "get recipes")
 	}
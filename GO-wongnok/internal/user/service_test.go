@@ -66,10 +66,14 @@ func (suite *ServiceUpsertWithClaimsTestSuite) TestReturnUserUpdated() {
 		LastName:  "LastName",
 	}
 
+	// ผู้ใช้ใหม่ได้ชื่อเล่นและรูปเริ่มต้นจาก FromClaims
+	imageURL := "https://avatar.iran.liara.run/public/boy"
 	expectedUser := model.User{
 		ID:        "ID",
 		FirstName: "FirstName",
 		LastName:  "LastName",
+		NickName:  "FirstName LastName",
+		ImageUrl:  &imageURL,
 	}
 
 	user, err := suite.service.UpsertWithClaims(claims)
@@ -231,7 +235,7 @@ func (suite *ServiceGetRecipesTestSuite) SetupTest() {
 		return suite.respGetByID, suite.errGetByID
 	})
 
-	suite.repo.On("GetRecipes", mock.Anything).Return(func(string) (model.FoodRecipes, error) {
+	suite.repo.On("GetRecipes", mock.Anything, mock.Anything, mock.Anything).Return(func(string, model.UserRecipesQuery, string) (model.FoodRecipes, error) {
 		return suite.respGetRecipes, suite.errGetRecipes
 	})
 }
@@ -259,13 +263,23 @@ func (suite *ServiceGetRecipesTestSuite) TestGetRecipesResponseFoodRecipes() {
 	}
 	suite.respGetRecipes = expectedFoodRecipes
 
-	foodRecipes, err := suite.service.GetRecipes("1", claims)
+	foodRecipes, err := suite.service.GetRecipes("1", model.UserRecipesQuery{}, claims)
 
 	suite.NoError(err)
 	suite.Equal(expectedFoodRecipes, foodRecipes)
 	suite.Equal(float64(4), foodRecipes[0].AverageRating)
 	suite.repo.AssertCalled(suite.T(), "GetByID", "ID")
-	suite.repo.AssertCalled(suite.T(), "GetRecipes", "1")
+	suite.repo.AssertCalled(suite.T(), "GetRecipes", "1", mock.AnythingOfType("model.UserRecipesQuery"), "ID")
+}
+
+func (suite *ServiceGetRecipesTestSuite) TestPassViewerIDToRepository() {
+	claims := model.Claims{ID: "VIEWER"}
+
+	_, err := suite.service.GetRecipes("OWNER", model.UserRecipesQuery{}, claims)
+	suite.NoError(err)
+
+	// สูตรของ OWNER แต่ favorite/rating ที่ preload เป็นของคนที่กำลังดู
+	suite.repo.AssertCalled(suite.T(), "GetRecipes", "OWNER", mock.AnythingOfType("model.UserRecipesQuery"), "VIEWER")
 }
 
 func (suite *ServiceGetRecipesTestSuite) TestGetRecipesResponseErrorGetByID() {
@@ -275,7 +289,7 @@ func (suite *ServiceGetRecipesTestSuite) TestGetRecipesResponseErrorGetByID() {
 		LastName:  "LastName",
 	}
 	suite.errGetByID = assert.AnError
-	foodRecipes, err := suite.service.GetRecipes("1", claims)
+	foodRecipes, err := suite.service.GetRecipes("1", model.UserRecipesQuery{}, claims)
 	suite.ErrorIs(err, assert.AnError)
 	suite.True(strings.HasPrefix(err.Error(), "find user"))
 
@@ -288,7 +302,7 @@ func (suite *ServiceGetRecipesTestSuite) TestGetRecipesResponseErrorGetRecipes()
 		LastName:  "LastName",
 	}
 	suite.errGetRecipes = assert.AnError
-	foodRecipes, err := suite.service.GetRecipes("1", claims)
+	foodRecipes, err := suite.service.GetRecipes("1", model.UserRecipesQuery{}, claims)
 	suite.ErrorIs(err, assert.AnError)
 	suite.True(strings.HasPrefix(err.Error(), "get recipes"))
 
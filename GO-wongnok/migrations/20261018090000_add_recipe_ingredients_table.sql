@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS recipe_ingredients (
+    id SERIAL PRIMARY KEY,
+    food_recipe_id INT NOT NULL REFERENCES food_recipes,
+    position INT NOT NULL DEFAULT 0,
+    name VARCHAR(255) NOT NULL,
+    quantity NUMERIC(10, 3) NULL,
+    unit VARCHAR(50) NOT NULL DEFAULT '',
+    note TEXT NOT NULL DEFAULT '',
+    group_name VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_recipe_ingredients_food_recipe_id ON recipe_ingredients (food_recipe_id);
+
+-- แยกข้อความวัตถุดิบเดิม (คั่นด้วย comma หรือขึ้นบรรทัดใหม่) ออกเป็นรายการ
+-- ถ้าขึ้นต้นด้วยตัวเลข เช่น "2 eggs" จะเก็บตัวเลขเป็น quantity
+INSERT INTO recipe_ingredients (food_recipe_id, position, name, quantity, created_at, updated_at)
+SELECT
+    item.food_recipe_id,
+    item.position,
+    CASE
+        WHEN item.raw ~ '^\d+(\.\d+)?\s+\S' THEN regexp_replace(item.raw, '^\d+(\.\d+)?\s+', '')
+        ELSE item.raw
+    END,
+    CASE
+        WHEN item.raw ~ '^\d+(\.\d+)?\s+\S' THEN substring(item.raw FROM '^(\d+(?:\.\d+)?)')::NUMERIC
+    END,
+    CURRENT_TIMESTAMP,
+    CURRENT_TIMESTAMP
+FROM (
+    SELECT
+        fr.id AS food_recipe_id,
+        btrim(part.raw) AS raw,
+        ROW_NUMBER() OVER (PARTITION BY fr.id ORDER BY part.ordinal)::INT AS position
+    FROM food_recipes fr
+    CROSS JOIN LATERAL regexp_split_to_table(fr.ingredient, '\s*[,\n]\s*') WITH ORDINALITY AS part(raw, ordinal)
+    WHERE btrim(part.raw) <> ''
+) item
+WHERE NOT EXISTS (
+    SELECT 1 FROM recipe_ingredients ri WHERE ri.food_recipe_id = item.food_recipe_id
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS recipe_ingredients;
+
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS recipe_steps (
+    id SERIAL PRIMARY KEY,
+    food_recipe_id INT NOT NULL REFERENCES food_recipes,
+    position INT NOT NULL DEFAULT 0,
+    text TEXT NOT NULL,
+    duration_seconds INT NULL CHECK (duration_seconds > 0),
+    image_url TEXT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_recipe_steps_food_recipe_id ON recipe_steps (food_recipe_id, position);
+
+-- แยกข้อความวิธีทำเดิมเป็นขั้นตอนทีละบรรทัด และตัดเลขลำดับนำหน้า เช่น "1. "
+INSERT INTO recipe_steps (food_recipe_id, position, text, created_at, updated_at)
+SELECT
+    item.food_recipe_id,
+    ROW_NUMBER() OVER (PARTITION BY item.food_recipe_id ORDER BY item.ordinal)::INT,
+    item.text,
+    CURRENT_TIMESTAMP,
+    CURRENT_TIMESTAMP
+FROM (
+    SELECT
+        fr.id AS food_recipe_id,
+        part.ordinal,
+        btrim(regexp_replace(btrim(part.raw), '^\d+\s*[.)]\s*', '')) AS text
+    FROM food_recipes fr
+    CROSS JOIN LATERAL regexp_split_to_table(fr.instruction, '\s*\n\s*') WITH ORDINALITY AS part(raw, ordinal)
+) item
+WHERE item.text <> ''
+AND NOT EXISTS (
+    SELECT 1 FROM recipe_steps rs WHERE rs.food_recipe_id = item.food_recipe_id
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS recipe_steps;
+
+-- +goose StatementEnd
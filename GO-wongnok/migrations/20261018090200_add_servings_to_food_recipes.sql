@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE food_recipes ADD COLUMN IF NOT EXISTS servings INT NOT NULL DEFAULT 1;
+ALTER TABLE food_recipes ADD CONSTRAINT food_recipes_servings_check CHECK (servings > 0);
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE food_recipes DROP CONSTRAINT IF EXISTS food_recipes_servings_check;
+ALTER TABLE food_recipes DROP COLUMN IF EXISTS servings;
+
+-- +goose StatementEnd
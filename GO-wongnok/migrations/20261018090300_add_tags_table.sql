@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS tags (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    slug VARCHAR(100) NOT NULL,
+    category VARCHAR(20) NOT NULL CHECK (category IN ('cuisine', 'meal_type', 'diet', 'occasion')),
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    deleted_at TIMESTAMP
+);
+
+-- slug ซ้ำได้เฉพาะ tag ที่ถูกลบไปแล้ว
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tags_slug ON tags (slug) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS food_recipe_tags (
+    food_recipe_id INT NOT NULL REFERENCES food_recipes ON DELETE CASCADE,
+    tag_id INT NOT NULL REFERENCES tags ON DELETE CASCADE,
+    PRIMARY KEY (food_recipe_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_food_recipe_tags_tag_id ON food_recipe_tags (tag_id);
+
+INSERT INTO tags (name, slug, category, created_at, updated_at)
+VALUES
+    ('Thai', 'thai', 'cuisine', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('Japanese', 'japanese', 'cuisine', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('Italian', 'italian', 'cuisine', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('Breakfast', 'breakfast', 'meal_type', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('Lunch', 'lunch', 'meal_type', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('Dinner', 'dinner', 'meal_type', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('Dessert', 'dessert', 'meal_type', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('Vegetarian', 'vegetarian', 'diet', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('Vegan', 'vegan', 'diet', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('Halal', 'halal', 'diet', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('Party', 'party', 'occasion', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+    ('Festival', 'festival', 'occasion', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+ON CONFLICT DO NOTHING;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS food_recipe_tags;
+
+DROP TABLE IF EXISTS tags;
+
+-- +goose StatementEnd
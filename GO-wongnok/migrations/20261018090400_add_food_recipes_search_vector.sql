@@ -0,0 +1,84 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ภาษาไทยไม่มีการเว้นวรรคระหว่างคำ และ PostgreSQL ไม่มี dictionary ภาษาไทย
+-- จึงตัดข้อความไทยเป็น bigram (ทีละ 2 ตัวอักษรแบบเหลื่อมกัน) ส่วนภาษาอังกฤษ/ตัวเลขตัดตามคำปกติ
+-- ทั้งตอนสร้าง search_vector และตอนค้นหาใช้ฟังก์ชันเดียวกัน ผลจึงสอดคล้องกัน
+CREATE OR REPLACE FUNCTION search_tokens(value TEXT) RETURNS TEXT
+LANGUAGE plpgsql IMMUTABLE AS $$
+DECLARE
+    word TEXT;
+    tokens TEXT[] := '{}';
+    i INT;
+BEGIN
+    FOR word IN
+        SELECT m[1] FROM regexp_matches(lower(COALESCE(value, '')), '([a-z0-9]+|[฀-๿]+)', 'g') AS m
+    LOOP
+        IF word ~ '^[a-z0-9]+$' OR char_length(word) <= 2 THEN
+            tokens := tokens || word;
+        ELSE
+            FOR i IN 1 .. char_length(word) - 1 LOOP
+                tokens := tokens || substr(word, i, 2);
+            END LOOP;
+        END IF;
+    END LOOP;
+
+    RETURN array_to_string(tokens, ' ');
+END;
+$$;
+
+-- คำค้นทุกคำต้องพบ (AND) และจับคู่แบบ prefix เพื่อให้พิมพ์ไม่ครบคำก็ยังเจอ
+CREATE OR REPLACE FUNCTION search_query(value TEXT) RETURNS tsquery
+LANGUAGE sql IMMUTABLE AS $$
+    SELECT to_tsquery('simple', COALESCE(string_agg(quote_literal(token) || ':*', ' & '), ''))
+    FROM unnest(string_to_array(search_tokens(value), ' ')) AS token
+    WHERE token <> ''
+$$;
+
+-- น้ำหนัก: ชื่อ > คำอธิบาย > วัตถุดิบ > วิธีทำ
+CREATE OR REPLACE FUNCTION food_recipe_search_vector(name TEXT, description TEXT, ingredient TEXT, instruction TEXT) RETURNS tsvector
+LANGUAGE sql IMMUTABLE AS $$
+    SELECT setweight(to_tsvector('simple', search_tokens(name)), 'A') ||
+        setweight(to_tsvector('simple', search_tokens(description)), 'B') ||
+        setweight(to_tsvector('simple', search_tokens(ingredient)), 'C') ||
+        setweight(to_tsvector('simple', search_tokens(instruction)), 'D')
+$$;
+
+ALTER TABLE food_recipes ADD COLUMN IF NOT EXISTS search_vector tsvector;
+
+UPDATE food_recipes
+SET search_vector = food_recipe_search_vector(name, description, ingredient, instruction);
+
+CREATE INDEX IF NOT EXISTS idx_food_recipes_search_vector ON food_recipes USING GIN (search_vector);
+
+CREATE OR REPLACE FUNCTION food_recipes_search_vector_update() RETURNS TRIGGER
+LANGUAGE plpgsql AS $$
+BEGIN
+    NEW.search_vector := food_recipe_search_vector(NEW.name, NEW.description, NEW.ingredient, NEW.instruction);
+    RETURN NEW;
+END;
+$$;
+
+DROP TRIGGER IF EXISTS food_recipes_search_vector_trigger ON food_recipes;
+
+CREATE TRIGGER food_recipes_search_vector_trigger
+BEFORE INSERT OR UPDATE OF name, description, ingredient, instruction ON food_recipes
+FOR EACH ROW EXECUTE FUNCTION food_recipes_search_vector_update();
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS food_recipes_search_vector_trigger ON food_recipes;
+
+DROP FUNCTION IF EXISTS food_recipes_search_vector_update();
+
+DROP INDEX IF EXISTS idx_food_recipes_search_vector;
+
+ALTER TABLE food_recipes DROP COLUMN IF EXISTS search_vector;
+
+DROP FUNCTION IF EXISTS food_recipe_search_vector(TEXT, TEXT, TEXT, TEXT);
+
+DROP FUNCTION IF EXISTS search_query(TEXT);
+
+DROP FUNCTION IF EXISTS search_tokens(TEXT);
+
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- ใช้กับ autocomplete (operator <% และ ILIKE) ใน /food-recipes/suggest
+CREATE INDEX IF NOT EXISTS idx_food_recipes_name_trgm ON food_recipes USING GIN (name gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_tags_name_trgm ON tags USING GIN (name gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_recipe_ingredients_name_trgm ON recipe_ingredients USING GIN (name gin_trgm_ops);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_recipe_ingredients_name_trgm;
+
+DROP INDEX IF EXISTS idx_tags_name_trgm;
+
+DROP INDEX IF EXISTS idx_food_recipes_name_trgm;
+
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- เก็บเฉพาะคะแนนล่าสุดของผู้ใช้แต่ละคนต่อสูตร
+DELETE FROM ratings r
+USING (
+    SELECT
+        id,
+        ROW_NUMBER() OVER (
+            PARTITION BY food_recipe_id, user_id
+            ORDER BY updated_at DESC, id DESC
+        ) AS row_number
+    FROM ratings
+    WHERE deleted_at IS NULL
+) duplicate
+WHERE r.id = duplicate.id
+AND duplicate.row_number > 1;
+
+-- นับเฉพาะแถวที่ยังไม่ถูกลบ เพื่อให้ลบแล้วให้คะแนนใหม่ได้
+CREATE UNIQUE INDEX IF NOT EXISTS idx_ratings_food_recipe_user ON ratings (food_recipe_id, user_id) WHERE deleted_at IS NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_ratings_food_recipe_user;
+
+-- +goose StatementEnd
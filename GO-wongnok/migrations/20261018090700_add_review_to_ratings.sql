@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE ratings
+    ADD COLUMN IF NOT EXISTS title VARCHAR(200) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS body TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS photo_urls JSONB NOT NULL DEFAULT '[]';
+
+CREATE INDEX IF NOT EXISTS idx_ratings_food_recipe_created_at ON ratings (food_recipe_id, created_at DESC);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_ratings_food_recipe_created_at;
+
+ALTER TABLE ratings
+    DROP COLUMN IF EXISTS photo_urls,
+    DROP COLUMN IF EXISTS body,
+    DROP COLUMN IF EXISTS title;
+
+-- +goose StatementEnd
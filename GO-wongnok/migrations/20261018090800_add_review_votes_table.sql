@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS review_votes (
+    rating_id INT NOT NULL REFERENCES ratings ON DELETE CASCADE,
+    user_id VARCHAR(100) NOT NULL REFERENCES users,
+    helpful BOOLEAN NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (rating_id, user_id)
+);
+
+-- นับ helpfulCount ต่อรีวิว
+CREATE INDEX IF NOT EXISTS idx_review_votes_helpful ON review_votes (rating_id) WHERE helpful;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS review_votes;
+
+-- +goose StatementEnd
@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+-- เก็บค่าสรุปคะแนนไว้ใน food_recipes เพื่อไม่ต้องโหลด ratings ทั้งหมดทุกครั้งที่แสดงรายการ
+-- ค่าเหล่านี้ถูกคำนวณใหม่ทุกครั้งที่มีการเพิ่ม/แก้/ลบคะแนน (ดู rating.refreshRecipeAggregates)
+ALTER TABLE food_recipes
+    ADD COLUMN IF NOT EXISTS rating_count INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rating_sum NUMERIC NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rating_star1 INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rating_star2 INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rating_star3 INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rating_star4 INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rating_star5 INT NOT NULL DEFAULT 0;
+
+-- คะแนนที่เป็นทศนิยมนับเข้าดาวตามจำนวนเต็มด้านล่าง (เช่น 4.5 นับเป็น 4 ดาว)
+UPDATE food_recipes SET
+    rating_count = agg.count,
+    rating_sum = agg.sum,
+    rating_star1 = agg.star1,
+    rating_star2 = agg.star2,
+    rating_star3 = agg.star3,
+    rating_star4 = agg.star4,
+    rating_star5 = agg.star5
+FROM (
+    SELECT
+        food_recipe_id,
+        COUNT(*) AS count,
+        COALESCE(SUM(score), 0) AS sum,
+        COUNT(*) FILTER (WHERE LEAST(GREATEST(FLOOR(score), 1), 5) = 1) AS star1,
+        COUNT(*) FILTER (WHERE LEAST(GREATEST(FLOOR(score), 1), 5) = 2) AS star2,
+        COUNT(*) FILTER (WHERE LEAST(GREATEST(FLOOR(score), 1), 5) = 3) AS star3,
+        COUNT(*) FILTER (WHERE LEAST(GREATEST(FLOOR(score), 1), 5) = 4) AS star4,
+        COUNT(*) FILTER (WHERE LEAST(GREATEST(FLOOR(score), 1), 5) = 5) AS star5
+    FROM ratings
+    WHERE deleted_at IS NULL
+    GROUP BY food_recipe_id
+) AS agg
+WHERE food_recipes.id = agg.food_recipe_id;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE food_recipes
+    DROP COLUMN IF EXISTS rating_star5,
+    DROP COLUMN IF EXISTS rating_star4,
+    DROP COLUMN IF EXISTS rating_star3,
+    DROP COLUMN IF EXISTS rating_star2,
+    DROP COLUMN IF EXISTS rating_star1,
+    DROP COLUMN IF EXISTS rating_sum,
+    DROP COLUMN IF EXISTS rating_count;
+
+-- +goose StatementEnd
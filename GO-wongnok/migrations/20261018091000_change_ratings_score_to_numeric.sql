@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+-- คะแนนเป็น 1-5 เพิ่มทีละครึ่งดาว (เดิมเป็น INT จึงเก็บ 3.5 ไม่ได้และรับค่านอกช่วงได้)
+-- คะแนนเดิมที่อยู่นอกช่วงให้ปัดเข้ามาในช่วงตอนแปลงชนิด เพราะ NUMERIC(2, 1) เก็บได้ไม่เกิน 9.9 (เช่น 1000 จะ overflow)
+ALTER TABLE ratings ALTER COLUMN score TYPE NUMERIC(2, 1) USING LEAST(GREATEST(score, 1), 5)::NUMERIC(2, 1);
+
+ALTER TABLE ratings
+    ADD CONSTRAINT chk_ratings_score CHECK (score BETWEEN 1 AND 5 AND score * 2 = FLOOR(score * 2));
+
+-- คำนวณค่าสรุปคะแนนใหม่เพราะคะแนนบางรายการอาจถูกปัด
+UPDATE food_recipes SET
+    rating_count = agg.count,
+    rating_sum = agg.sum,
+    rating_star1 = agg.star1,
+    rating_star2 = agg.star2,
+    rating_star3 = agg.star3,
+    rating_star4 = agg.star4,
+    rating_star5 = agg.star5
+FROM (
+    SELECT
+        food_recipe_id,
+        COUNT(*) AS count,
+        COALESCE(SUM(score), 0) AS sum,
+        COUNT(*) FILTER (WHERE FLOOR(score) = 1) AS star1,
+        COUNT(*) FILTER (WHERE FLOOR(score) = 2) AS star2,
+        COUNT(*) FILTER (WHERE FLOOR(score) = 3) AS star3,
+        COUNT(*) FILTER (WHERE FLOOR(score) = 4) AS star4,
+        COUNT(*) FILTER (WHERE FLOOR(score) = 5) AS star5
+    FROM ratings
+    WHERE deleted_at IS NULL
+    GROUP BY food_recipe_id
+) AS agg
+WHERE food_recipes.id = agg.food_recipe_id;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE ratings DROP CONSTRAINT IF EXISTS chk_ratings_score;
+
+ALTER TABLE ratings ALTER COLUMN score TYPE INT USING FLOOR(score)::INT;
+
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS comments (
+    id SERIAL PRIMARY KEY,
+    food_recipe_id INT NOT NULL REFERENCES food_recipes,
+    user_id VARCHAR(100) NOT NULL REFERENCES users,
+    parent_id INT NULL REFERENCES comments,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    deleted_at TIMESTAMP
+);
+
+-- ความคิดเห็นระดับบนสุดของสูตร เรียงใหม่สุดก่อน
+CREATE INDEX IF NOT EXISTS idx_comments_food_recipe_created_at ON comments (food_recipe_id, created_at DESC) WHERE parent_id IS NULL;
+CREATE INDEX IF NOT EXISTS idx_comments_parent_id ON comments (parent_id) WHERE parent_id IS NOT NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS comments;
+
+-- +goose StatementEnd
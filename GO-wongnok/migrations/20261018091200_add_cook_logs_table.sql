@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS cook_logs (
+    id SERIAL PRIMARY KEY,
+    food_recipe_id INT NOT NULL REFERENCES food_recipes,
+    user_id VARCHAR(100) NOT NULL REFERENCES users,
+    cooked_on DATE NOT NULL,
+    photo_urls JSONB NOT NULL DEFAULT '[]',
+    notes TEXT NOT NULL DEFAULT '',
+    modifications TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_cook_logs_food_recipe_cooked_on ON cook_logs (food_recipe_id, cooked_on DESC) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_cook_logs_user_cooked_on ON cook_logs (user_id, cooked_on DESC) WHERE deleted_at IS NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS cook_logs;
+
+-- +goose StatementEnd
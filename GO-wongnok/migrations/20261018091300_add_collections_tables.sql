@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS collections (
+    id SERIAL PRIMARY KEY,
+    user_id VARCHAR(100) NOT NULL REFERENCES users,
+    name VARCHAR(100) NOT NULL,
+    position INT NOT NULL DEFAULT 0,
+    is_public BOOLEAN NOT NULL DEFAULT FALSE,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_collections_user_position ON collections (user_id, position) WHERE deleted_at IS NULL;
+
+-- ผู้ใช้หนึ่งคนมี collection เริ่มต้น (ที่ผูกกับปุ่ม favorite) ได้อันเดียว
+CREATE UNIQUE INDEX IF NOT EXISTS idx_collections_user_default ON collections (user_id) WHERE is_default AND deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS collection_items (
+    collection_id INT NOT NULL REFERENCES collections ON DELETE CASCADE,
+    food_recipe_id INT NOT NULL REFERENCES food_recipes,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (collection_id, food_recipe_id)
+);
+
+-- ย้าย favorite เดิมเข้า collection เริ่มต้นของผู้ใช้แต่ละคน
+INSERT INTO collections (user_id, name, position, is_public, is_default, created_at, updated_at)
+SELECT DISTINCT favorites.user_id, 'Favorites', 1, FALSE, TRUE, NOW(), NOW()
+FROM favorites
+JOIN users ON users.id = favorites.user_id
+WHERE favorites.deleted_at IS NULL;
+
+INSERT INTO collection_items (collection_id, food_recipe_id, created_at)
+SELECT DISTINCT ON (collections.id, favorites.food_recipe_id) collections.id, favorites.food_recipe_id, favorites.created_at
+FROM favorites
+JOIN collections ON collections.user_id = favorites.user_id AND collections.is_default AND collections.deleted_at IS NULL
+WHERE favorites.deleted_at IS NULL
+ORDER BY collections.id, favorites.food_recipe_id, favorites.created_at DESC
+ON CONFLICT DO NOTHING;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS collection_items;
+DROP TABLE IF EXISTS collections;
+
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS meal_plan_entries (
+    id SERIAL PRIMARY KEY,
+    user_id VARCHAR(100) NOT NULL REFERENCES users,
+    date DATE NOT NULL,
+    slot VARCHAR(20) NOT NULL CHECK (slot IN ('breakfast', 'lunch', 'dinner', 'snack')),
+    food_recipe_id INT NOT NULL REFERENCES food_recipes,
+    servings INT NOT NULL DEFAULT 0, -- 0 = ใช้จำนวนที่เสิร์ฟของสูตร
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_meal_plan_entries_user_date ON meal_plan_entries (user_id, date) WHERE deleted_at IS NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS meal_plan_entries;
+
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS pantry_items (
+    id SERIAL PRIMARY KEY,
+    user_id VARCHAR(100) NOT NULL REFERENCES users,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    deleted_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_pantry_items_user_name ON pantry_items (user_id, LOWER(name)) WHERE deleted_at IS NULL;
+
+-- match-pantry เทียบชื่อวัตถุดิบทั้งชื่อกับของในตู้ (ดู model.PantryItems.Covers)
+CREATE INDEX IF NOT EXISTS idx_recipe_ingredients_term ON recipe_ingredients (LOWER(BTRIM(name))) WHERE deleted_at IS NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_recipe_ingredients_term;
+
+DROP TABLE IF EXISTS pantry_items;
+
+-- +goose StatementEnd
@@ -0,0 +1,97 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS ingredients (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    name_th VARCHAR(100) NOT NULL DEFAULT '',
+    category VARCHAR(50) NOT NULL DEFAULT 'other',
+    synonyms JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    deleted_at TIMESTAMP
+);
+
+-- พจนานุกรมตั้งต้น admin แก้ไขและเพิ่มต่อได้ผ่าน API
+INSERT INTO ingredients (name, name_th, category, synonyms, created_at, updated_at) VALUES
+    ('egg', 'ไข่', 'dairy-eggs', '["ไข่ไก่", "chicken egg"]', NOW(), NOW()),
+    ('garlic', 'กระเทียม', 'produce', '["garlic clove", "กระเทียมไทย"]', NOW(), NOW()),
+    ('shallot', 'หอมแดง', 'produce', '[]', NOW(), NOW()),
+    ('onion', 'หอมใหญ่', 'produce', '["หัวหอม"]', NOW(), NOW()),
+    ('chili', 'พริก', 'produce', '["chilli", "พริกขี้หนู", "bird''s eye chili"]', NOW(), NOW()),
+    ('lime', 'มะนาว', 'produce', '[]', NOW(), NOW()),
+    ('holy basil', 'กะเพรา', 'produce', '["ใบกะเพรา"]', NOW(), NOW()),
+    ('thai basil', 'โหระพา', 'produce', '["ใบโหระพา"]', NOW(), NOW()),
+    ('lemongrass', 'ตะไคร้', 'produce', '[]', NOW(), NOW()),
+    ('tomato', 'มะเขือเทศ', 'produce', '[]', NOW(), NOW()),
+    ('chicken', 'ไก่', 'meat', '["เนื้อไก่", "chicken breast", "อกไก่"]', NOW(), NOW()),
+    ('pork', 'หมู', 'meat', '["เนื้อหมู"]', NOW(), NOW()),
+    ('minced pork', 'หมูสับ', 'meat', '["ground pork"]', NOW(), NOW()),
+    ('beef', 'เนื้อวัว', 'meat', '[]', NOW(), NOW()),
+    ('shrimp', 'กุ้ง', 'seafood', '["prawn"]', NOW(), NOW()),
+    ('milk', 'นม', 'dairy-eggs', '["นมสด", "fresh milk"]', NOW(), NOW()),
+    ('butter', 'เนย', 'dairy-eggs', '[]', NOW(), NOW()),
+    ('jasmine rice', 'ข้าวหอมมะลิ', 'pantry', '["rice", "ข้าวสาร"]', NOW(), NOW()),
+    ('coconut milk', 'กะทิ', 'pantry', '["หัวกะทิ"]', NOW(), NOW()),
+    ('vegetable oil', 'น้ำมันพืช', 'pantry', '["oil", "น้ำมัน"]', NOW(), NOW()),
+    ('fish sauce', 'น้ำปลา', 'spices-condiments', '[]', NOW(), NOW()),
+    ('oyster sauce', 'ซอสหอยนางรม', 'spices-condiments', '["น้ำมันหอย"]', NOW(), NOW()),
+    ('light soy sauce', 'ซีอิ๊วขาว', 'spices-condiments', '["soy sauce"]', NOW(), NOW()),
+    ('sugar', 'น้ำตาล', 'spices-condiments', '["น้ำตาลทราย"]', NOW(), NOW()),
+    ('palm sugar', 'น้ำตาลปี๊บ', 'spices-condiments', '["น้ำตาลมะพร้าว"]', NOW(), NOW()),
+    ('salt', 'เกลือ', 'spices-condiments', '[]', NOW(), NOW());
+
+ALTER TABLE recipe_ingredients ADD COLUMN IF NOT EXISTS ingredient_id INT NULL REFERENCES ingredients;
+
+CREATE INDEX IF NOT EXISTS idx_recipe_ingredients_ingredient ON recipe_ingredients (ingredient_id);
+
+-- ผูกวัตถุดิบเดิมที่ชื่อตรงกับพจนานุกรม (ตัวพิมพ์เล็ก/ใหญ่ และรูปพหูพจน์ -s)
+-- ชื่อที่ไม่ตรงจะถูกผูกตอนแก้สูตรครั้งถัดไป หรือเมื่อ admin เพิ่มคำพ้อง
+UPDATE recipe_ingredients
+SET ingredient_id = ingredients.id
+FROM ingredients
+WHERE recipe_ingredients.ingredient_id IS NULL
+    AND LOWER(TRIM(recipe_ingredients.name)) IN (
+        SELECT LOWER(term) FROM (
+            SELECT ingredients.name AS term
+            UNION SELECT ingredients.name || 's'
+            UNION SELECT ingredients.name_th
+            UNION SELECT jsonb_array_elements_text(ingredients.synonyms)
+        ) AS terms
+    );
+
+-- ของในตู้ผูกกับพจนานุกรมแบบเดียวกัน ใช้จับคู่กับวัตถุดิบในสูตรด้วย id (ดู model.PantryItems.Covers)
+ALTER TABLE pantry_items ADD COLUMN IF NOT EXISTS ingredient_id INT NULL REFERENCES ingredients;
+
+UPDATE pantry_items
+SET ingredient_id = ingredients.id
+FROM ingredients
+WHERE pantry_items.ingredient_id IS NULL
+    AND LOWER(TRIM(pantry_items.name)) IN (
+        SELECT LOWER(term) FROM (
+            SELECT ingredients.name AS term
+            UNION SELECT ingredients.name || 's'
+            UNION SELECT ingredients.name_th
+            UNION SELECT jsonb_array_elements_text(ingredients.synonyms)
+        ) AS terms
+    );
+
+CREATE TABLE IF NOT EXISTS unlinked_ingredients (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL UNIQUE,
+    occurrences INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMP NOT NULL,
+    last_seen_at TIMESTAMP NOT NULL
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS unlinked_ingredients;
+
+ALTER TABLE pantry_items DROP COLUMN IF EXISTS ingredient_id;
+
+ALTER TABLE recipe_ingredients DROP COLUMN IF EXISTS ingredient_id;
+
+DROP TABLE IF EXISTS ingredients;
+
+-- +goose StatementEnd
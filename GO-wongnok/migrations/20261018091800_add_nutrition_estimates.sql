@@ -0,0 +1,78 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ค่าโภชนาการอ้างอิงต่อ 100 กรัม (ค่าโดยประมาณจากตารางโภชนาการทั่วไป)
+-- grams_per_piece ใช้กับวัตถุดิบที่นับเป็นฟอง/กลีบ/ลูก, grams_per_ml ใช้กับหน่วยตวง
+CREATE TABLE IF NOT EXISTS ingredient_nutritions (
+    ingredient_id INT PRIMARY KEY REFERENCES ingredients,
+    calories NUMERIC NOT NULL DEFAULT 0,
+    protein NUMERIC NOT NULL DEFAULT 0,
+    fat NUMERIC NOT NULL DEFAULT 0,
+    carbs NUMERIC NOT NULL DEFAULT 0,
+    sodium NUMERIC NOT NULL DEFAULT 0,
+    grams_per_piece NUMERIC NULL,
+    grams_per_ml NUMERIC NOT NULL DEFAULT 1
+);
+
+INSERT INTO ingredient_nutritions (ingredient_id, calories, protein, fat, carbs, sodium, grams_per_piece, grams_per_ml)
+SELECT ingredients.id, reference.calories, reference.protein, reference.fat, reference.carbs, reference.sodium, reference.grams_per_piece, reference.grams_per_ml
+FROM ingredients
+JOIN (VALUES
+    ('egg', 143, 12.6, 9.5, 0.7, 142, 50, 1.03),
+    ('garlic', 149, 6.4, 0.5, 33.1, 17, 4, 1),
+    ('shallot', 72, 2.5, 0.1, 16.8, 12, 10, 1),
+    ('onion', 40, 1.1, 0.1, 9.3, 4, 150, 1),
+    ('chili', 40, 1.9, 0.4, 8.8, 9, 2, 1),
+    ('lime', 30, 0.7, 0.2, 10.5, 2, 45, 1),
+    ('holy basil', 23, 3.2, 0.6, 2.7, 4, 0.5, 0.2),
+    ('thai basil', 23, 3.2, 0.6, 2.7, 4, 0.5, 0.2),
+    ('lemongrass', 99, 1.8, 0.5, 25.3, 6, 20, 1),
+    ('tomato', 18, 0.9, 0.2, 3.9, 5, 120, 1),
+    ('chicken', 165, 31, 3.6, 0, 74, NULL, 1),
+    ('pork', 242, 27, 14, 0, 62, NULL, 1),
+    ('minced pork', 263, 16.9, 21.2, 0, 56, NULL, 1),
+    ('beef', 250, 26, 15, 0, 72, NULL, 1),
+    ('shrimp', 99, 24, 0.3, 0.2, 111, 15, 1),
+    ('milk', 61, 3.2, 3.3, 4.8, 43, NULL, 1.03),
+    ('butter', 717, 0.9, 81, 0.1, 11, NULL, 0.91),
+    ('jasmine rice', 356, 7, 0.6, 79, 5, NULL, 0.85),
+    ('coconut milk', 230, 2.3, 23.8, 6, 15, NULL, 1),
+    ('vegetable oil', 884, 0, 100, 0, 0, NULL, 0.92),
+    ('fish sauce', 35, 5.1, 0, 3.6, 7851, NULL, 1.2),
+    ('oyster sauce', 51, 1.4, 0.3, 11, 2733, NULL, 1.2),
+    ('light soy sauce', 53, 8.1, 0.6, 4.9, 5493, NULL, 1.15),
+    ('sugar', 387, 0, 0, 100, 1, NULL, 0.85),
+    ('palm sugar', 383, 0.4, 0.1, 98, 30, NULL, 0.9),
+    ('salt', 0, 0, 0, 0, 38758, NULL, 1.2)
+) AS reference (name, calories, protein, fat, carbs, sodium, grams_per_piece, grams_per_ml)
+    ON ingredients.name = reference.name
+WHERE ingredients.deleted_at IS NULL
+ON CONFLICT (ingredient_id) DO NOTHING;
+
+-- ค่าโภชนาการรวมของทั้งสูตร คำนวณใหม่ทุกครั้งที่สร้าง/แก้สูตร (ดู model.EstimateNutrition)
+ALTER TABLE food_recipes
+    ADD COLUMN IF NOT EXISTS nutrition_calories NUMERIC NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS nutrition_protein NUMERIC NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS nutrition_fat NUMERIC NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS nutrition_carbs NUMERIC NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS nutrition_sodium NUMERIC NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS nutrition_estimated_ingredients INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS nutrition_total_ingredients INT NOT NULL DEFAULT 0;
+
+-- สูตรเดิมคำนวณด้วย cmd/backfill-nutrition หลัง goose up (ดู Dockerfile)
+-- เพื่อใช้ตารางหน่วยใน internal/units ชุดเดียวกับตอนสร้าง/แก้สูตร แทนการเขียนซ้ำใน SQL
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE food_recipes
+    DROP COLUMN IF EXISTS nutrition_total_ingredients,
+    DROP COLUMN IF EXISTS nutrition_estimated_ingredients,
+    DROP COLUMN IF EXISTS nutrition_sodium,
+    DROP COLUMN IF EXISTS nutrition_carbs,
+    DROP COLUMN IF EXISTS nutrition_fat,
+    DROP COLUMN IF EXISTS nutrition_protein,
+    DROP COLUMN IF EXISTS nutrition_calories;
+
+DROP TABLE IF EXISTS ingredient_nutritions;
+
+-- +goose StatementEnd
@@ -0,0 +1,132 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ข้อมูลของวัตถุดิบในพจนานุกรม ใช้สรุปสารก่อภูมิแพ้และรูปแบบการกินของสูตร (ดู model.DeriveDietary)
+ALTER TABLE ingredients
+    ADD COLUMN IF NOT EXISTS allergens JSONB NOT NULL DEFAULT '[]',
+    ADD COLUMN IF NOT EXISTS excluded_diets JSONB NOT NULL DEFAULT '[]';
+
+UPDATE ingredients SET
+    allergens = reference.allergens::jsonb,
+    excluded_diets = reference.excluded_diets::jsonb
+FROM (VALUES
+    ('egg', '["egg"]', '["vegan"]'),
+    ('chicken', '[]', '["vegetarian", "vegan"]'),
+    ('beef', '[]', '["vegetarian", "vegan"]'),
+    ('pork', '[]', '["vegetarian", "vegan", "halal-friendly", "no-pork"]'),
+    ('minced pork', '[]', '["vegetarian", "vegan", "halal-friendly", "no-pork"]'),
+    ('shrimp', '["shellfish"]', '["vegetarian", "vegan"]'),
+    ('milk', '["dairy"]', '["vegan"]'),
+    ('butter', '["dairy"]', '["vegan"]'),
+    ('fish sauce', '["fish"]', '["vegetarian", "vegan"]'),
+    ('oyster sauce', '["shellfish"]', '["vegetarian", "vegan"]'),
+    ('light soy sauce', '["gluten", "soy"]', '[]')
+) AS reference (name, allergens, excluded_diets)
+WHERE ingredients.name = reference.name;
+
+-- allergens/diets คำนวณใหม่ทุกครั้งที่สร้าง/แก้สูตร เว้นแต่ผู้เขียนกำหนดเอง (dietary_override)
+ALTER TABLE food_recipes
+    ADD COLUMN IF NOT EXISTS allergens JSONB NOT NULL DEFAULT '[]',
+    ADD COLUMN IF NOT EXISTS diets JSONB NOT NULL DEFAULT '[]',
+    ADD COLUMN IF NOT EXISTS dietary_override BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- คำนวณให้สูตรเดิมแบบเดียวกับ model.DeriveDietary
+-- - วัตถุดิบที่ผูกแล้วใช้ข้อมูลจากพจนานุกรม ที่ยังไม่ผูกเดาจากชื่อแบบ model.GuessAllergens (ชุดคำเดียวกับ allergenKeywords)
+--   ไล่คำจากยาวไปสั้น รวมทุกกลุ่มที่พบ และแทนช่วงที่เจอด้วย '|' เพื่อไม่ให้คำที่สั้นกว่านับซ้ำ
+-- - ระบุรูปแบบการกินเฉพาะสูตรที่วัตถุดิบผูกกับพจนานุกรมครบทุกรายการ
+WITH RECURSIVE keywords (keyword, allergens) AS (
+    VALUES
+        ('coconut milk', '[]'::jsonb), ('coconut cream', '[]'), ('eggplant', '[]'), ('nutmeg', '[]'), ('กะทิ', '[]'), ('มะเขือ', '[]'),
+        ('peanut', '["peanut"]'), ('peanut butter', '["peanut"]'), ('ถั่วลิสง', '["peanut"]'), ('เนยถั่ว', '["peanut"]'),
+        ('cashew', '["tree-nut"]'), ('almond', '["tree-nut"]'), ('walnut', '["tree-nut"]'), ('pistachio', '["tree-nut"]'),
+        ('hazelnut', '["tree-nut"]'), ('pecan', '["tree-nut"]'), ('มะม่วงหิมพานต์', '["tree-nut"]'), ('อัลมอนด์', '["tree-nut"]'), ('วอลนัท', '["tree-nut"]'),
+        ('shrimp', '["shellfish"]'), ('prawn', '["shellfish"]'), ('crab', '["shellfish"]'), ('oyster', '["shellfish"]'), ('squid', '["shellfish"]'),
+        ('mussel', '["shellfish"]'), ('clam', '["shellfish"]'), ('scallop', '["shellfish"]'), ('lobster', '["shellfish"]'),
+        ('กุ้ง', '["shellfish"]'), ('ปู', '["shellfish"]'), ('หอย', '["shellfish"]'), ('หมึก', '["shellfish"]'), ('กะปิ', '["shellfish"]'),
+        ('fish', '["fish"]'), ('anchovy', '["fish"]'), ('salmon', '["fish"]'), ('tuna', '["fish"]'), ('ปลา', '["fish"]'), ('แซลมอน', '["fish"]'), ('ทูน่า', '["fish"]'),
+        ('wheat', '["gluten"]'), ('flour', '["gluten"]'), ('bread', '["gluten"]'), ('pasta', '["gluten"]'), ('spaghetti', '["gluten"]'),
+        ('breadcrumb', '["gluten"]'), ('beer', '["gluten"]'),
+        ('แป้งสาลี', '["gluten"]'), ('ขนมปัง', '["gluten"]'), ('บะหมี่', '["gluten"]'), ('เบียร์', '["gluten"]'),
+        ('egg noodle', '["gluten", "egg"]'),
+        ('soy sauce', '["gluten", "soy"]'), ('ซีอิ๊ว', '["gluten", "soy"]'), ('ซอสปรุงรส', '["gluten", "soy"]'),
+        ('milk', '["dairy"]'), ('butter', '["dairy"]'), ('cheese', '["dairy"]'), ('cream', '["dairy"]'), ('yogurt', '["dairy"]'),
+        ('นม', '["dairy"]'), ('เนย', '["dairy"]'), ('ชีส', '["dairy"]'), ('ครีม', '["dairy"]'), ('โยเกิร์ต', '["dairy"]'),
+        ('egg', '["egg"]'), ('mayonnaise', '["egg"]'), ('ไข่', '["egg"]'), ('มายองเนส', '["egg"]'),
+        ('tofu', '["soy"]'), ('soy', '["soy"]'), ('soybean', '["soy"]'), ('miso', '["soy"]'), ('edamame', '["soy"]'),
+        ('เต้าหู้', '["soy"]'), ('ถั่วเหลือง', '["soy"]'), ('เต้าเจี้ยว', '["soy"]'), ('นมถั่วเหลือง', '["soy"]'),
+        ('sesame', '["sesame"]'), ('tahini', '["sesame"]'), ('งา', '["sesame"]')
+),
+ranked AS (
+    SELECT keyword, allergens, ROW_NUMBER() OVER (ORDER BY OCTET_LENGTH(keyword) DESC, keyword) AS rank
+    FROM keywords
+),
+masked (food_recipe_id, name, rank, allergens) AS (
+    SELECT food_recipe_id, LOWER(name), 0::BIGINT, '[]'::jsonb
+    FROM recipe_ingredients
+    WHERE ingredient_id IS NULL AND deleted_at IS NULL
+    UNION ALL
+    SELECT
+        masked.food_recipe_id,
+        REPLACE(masked.name, ranked.keyword, '|'),
+        ranked.rank,
+        CASE WHEN STRPOS(masked.name, ranked.keyword) > 0 THEN masked.allergens || ranked.allergens ELSE masked.allergens END
+    FROM masked
+    JOIN ranked ON ranked.rank = masked.rank + 1
+),
+guessed AS (
+    SELECT food_recipe_id, allergens
+    FROM masked
+    WHERE rank = (SELECT MAX(rank) FROM ranked)
+),
+flagged AS (
+    SELECT recipe_ingredients.food_recipe_id, allergen.value AS allergen
+    FROM recipe_ingredients
+    JOIN ingredients ON ingredients.id = recipe_ingredients.ingredient_id
+    CROSS JOIN LATERAL jsonb_array_elements_text(ingredients.allergens) AS allergen (value)
+    WHERE recipe_ingredients.deleted_at IS NULL
+    UNION
+    SELECT guessed.food_recipe_id, allergen.value
+    FROM guessed
+    CROSS JOIN LATERAL jsonb_array_elements_text(guessed.allergens) AS allergen (value)
+),
+excluded AS (
+    SELECT recipe_ingredients.food_recipe_id, diet.value AS diet
+    FROM recipe_ingredients
+    JOIN ingredients ON ingredients.id = recipe_ingredients.ingredient_id
+    CROSS JOIN LATERAL jsonb_array_elements_text(ingredients.excluded_diets) AS diet (value)
+    WHERE recipe_ingredients.deleted_at IS NULL
+),
+complete AS (
+    SELECT food_recipe_id, BOOL_AND(ingredient_id IS NOT NULL) AS linked
+    FROM recipe_ingredients
+    WHERE deleted_at IS NULL
+    GROUP BY food_recipe_id
+)
+UPDATE food_recipes SET
+    allergens = COALESCE((
+        SELECT jsonb_agg(flagged.allergen ORDER BY array_position(
+            ARRAY['peanut', 'tree-nut', 'shellfish', 'fish', 'gluten', 'dairy', 'egg', 'soy', 'sesame'], flagged.allergen
+        ))
+        FROM flagged
+        WHERE flagged.food_recipe_id = food_recipes.id
+    ), '[]'),
+    diets = CASE WHEN complete.linked THEN COALESCE((
+        SELECT jsonb_agg(diets.diet ORDER BY diets.ordinal)
+        FROM unnest(ARRAY['vegetarian', 'vegan', 'halal-friendly', 'no-pork']) WITH ORDINALITY AS diets (diet, ordinal)
+        WHERE diets.diet NOT IN (SELECT excluded.diet FROM excluded WHERE excluded.food_recipe_id = food_recipes.id)
+    ), '[]') ELSE '[]' END
+FROM complete
+WHERE food_recipes.id = complete.food_recipe_id;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE food_recipes
+    DROP COLUMN IF EXISTS dietary_override,
+    DROP COLUMN IF EXISTS diets,
+    DROP COLUMN IF EXISTS allergens;
+
+ALTER TABLE ingredients
+    DROP COLUMN IF EXISTS excluded_diets,
+    DROP COLUMN IF EXISTS allergens;
+
+-- +goose StatementEnd
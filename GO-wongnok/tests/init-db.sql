@@ -18,6 +18,13 @@ VALUES
         'Tester',
         CURRENT_TIMESTAMP,
         CURRENT_TIMESTAMP
+    ),
+    (
+        '7c6d2f0a-5e3b-4c1d-9a8e-2f4b6d8c0e1a',
+        'Second',
+        'Tester',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP
     );
 
 -- difficulties table
@@ -64,6 +71,24 @@ CREATE TABLE
         ingredient TEXT NOT NULL,
         instruction TEXT NOT NULL,
         image_url TEXT NULL,
+        servings INT NOT NULL DEFAULT 1,
+        rating_count INT NOT NULL DEFAULT 0,
+        rating_sum NUMERIC NOT NULL DEFAULT 0,
+        rating_star1 INT NOT NULL DEFAULT 0,
+        rating_star2 INT NOT NULL DEFAULT 0,
+        rating_star3 INT NOT NULL DEFAULT 0,
+        rating_star4 INT NOT NULL DEFAULT 0,
+        rating_star5 INT NOT NULL DEFAULT 0,
+        nutrition_calories NUMERIC NOT NULL DEFAULT 0,
+        nutrition_protein NUMERIC NOT NULL DEFAULT 0,
+        nutrition_fat NUMERIC NOT NULL DEFAULT 0,
+        nutrition_carbs NUMERIC NOT NULL DEFAULT 0,
+        nutrition_sodium NUMERIC NOT NULL DEFAULT 0,
+        nutrition_estimated_ingredients INT NOT NULL DEFAULT 0,
+        nutrition_total_ingredients INT NOT NULL DEFAULT 0,
+        allergens JSONB NOT NULL DEFAULT '[]',
+        diets JSONB NOT NULL DEFAULT '[]',
+        dietary_override BOOLEAN NOT NULL DEFAULT FALSE,
         cooking_duration_id INT NOT NULL REFERENCES cooking_durations,
         difficulty_id INT NOT NULL REFERENCES difficulties,
         user_id VARCHAR(100) REFERENCES users,
@@ -97,13 +122,167 @@ VALUES
         CURRENT_TIMESTAMP
     );
 
+-- ingredients table
+CREATE TABLE
+    IF NOT EXISTS ingredients (
+        id SERIAL PRIMARY KEY,
+        name VARCHAR(100) NOT NULL,
+        name_th VARCHAR(100) NOT NULL DEFAULT '',
+        category VARCHAR(50) NOT NULL DEFAULT 'other',
+        synonyms JSONB NOT NULL DEFAULT '[]',
+        allergens JSONB NOT NULL DEFAULT '[]',
+        excluded_diets JSONB NOT NULL DEFAULT '[]',
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+INSERT INTO
+    ingredients (
+        name,
+        name_th,
+        category,
+        synonyms,
+        allergens,
+        excluded_diets,
+        created_at,
+        updated_at
+    )
+VALUES
+    (
+        'egg',
+        'ไข่',
+        'dairy-eggs',
+        '["ไข่ไก่", "chicken egg"]',
+        '["egg"]',
+        '["vegan"]',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP
+    );
+
+-- ingredient_nutritions table
+CREATE TABLE
+    IF NOT EXISTS ingredient_nutritions (
+        ingredient_id INT PRIMARY KEY REFERENCES ingredients,
+        calories NUMERIC NOT NULL DEFAULT 0,
+        protein NUMERIC NOT NULL DEFAULT 0,
+        fat NUMERIC NOT NULL DEFAULT 0,
+        carbs NUMERIC NOT NULL DEFAULT 0,
+        sodium NUMERIC NOT NULL DEFAULT 0,
+        grams_per_piece NUMERIC NULL,
+        grams_per_ml NUMERIC NOT NULL DEFAULT 1
+    );
+
+INSERT INTO
+    ingredient_nutritions (
+        ingredient_id,
+        calories,
+        protein,
+        fat,
+        carbs,
+        sodium,
+        grams_per_piece,
+        grams_per_ml
+    )
+VALUES
+    (1, 143, 12.6, 9.5, 0.7, 142, 50, 1.03);
+
+-- recipe_ingredients table
+CREATE TABLE
+    IF NOT EXISTS recipe_ingredients (
+        id SERIAL PRIMARY KEY,
+        food_recipe_id INT NOT NULL REFERENCES food_recipes,
+        position INT NOT NULL DEFAULT 0,
+        name VARCHAR(255) NOT NULL,
+        quantity NUMERIC(10, 3) NULL,
+        unit VARCHAR(50) NOT NULL DEFAULT '',
+        note TEXT NOT NULL DEFAULT '',
+        group_name VARCHAR(100) NOT NULL DEFAULT '',
+        ingredient_id INT NULL REFERENCES ingredients,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+INSERT INTO
+    recipe_ingredients (
+        food_recipe_id,
+        position,
+        name,
+        quantity,
+        ingredient_id,
+        created_at,
+        updated_at
+    )
+VALUES
+    (
+        1,
+        1,
+        'Eggs',
+        2,
+        1,
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP
+    );
+
+UPDATE food_recipes
+SET
+    nutrition_calories = 143,
+    nutrition_protein = 12.6,
+    nutrition_fat = 9.5,
+    nutrition_carbs = 0.7,
+    nutrition_sodium = 142,
+    nutrition_estimated_ingredients = 1,
+    nutrition_total_ingredients = 1,
+    allergens = '["egg"]',
+    diets = '["vegetarian", "halal-friendly", "no-pork"]'
+WHERE
+    id = 1;
+
+-- recipe_steps table
+CREATE TABLE
+    IF NOT EXISTS recipe_steps (
+        id SERIAL PRIMARY KEY,
+        food_recipe_id INT NOT NULL REFERENCES food_recipes,
+        position INT NOT NULL DEFAULT 0,
+        text TEXT NOT NULL,
+        duration_seconds INT NULL,
+        image_url TEXT NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+INSERT INTO
+    recipe_steps (
+        food_recipe_id,
+        position,
+        text,
+        created_at,
+        updated_at
+    )
+VALUES
+    (
+        1,
+        1,
+        'Cooking',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP
+    );
+
 -- ratings table
 CREATE TABLE
     IF NOT EXISTS ratings (
         id SERIAL PRIMARY KEY,
         food_recipe_id INT NOT NULL REFERENCES food_recipes,
-        score INT NOT NULL,
+        score NUMERIC(2, 1) NOT NULL CHECK (
+            score BETWEEN 1 AND 5
+            AND score * 2 = FLOOR(score * 2)
+        ),
         user_id VARCHAR(100) NOT NULL REFERENCES users,
+        title VARCHAR(200) NOT NULL DEFAULT '',
+        body TEXT NOT NULL DEFAULT '',
+        photo_urls JSONB NOT NULL DEFAULT '[]',
         created_at TIMESTAMP NOT NULL,
         updated_at TIMESTAMP NOT NULL,
         deleted_at TIMESTAMP
@@ -128,7 +307,272 @@ VALUES
     (
         1,
         3,
-        '38fa4e9e-27de-42d5-a70f-9f01d41f32c2',
+        '7c6d2f0a-5e3b-4c1d-9a8e-2f4b6d8c0e1a',
         CURRENT_TIMESTAMP,
         CURRENT_TIMESTAMP
-    );
\ No newline at end of file
+    );
+
+-- ผู้ใช้หนึ่งคนให้คะแนนสูตรหนึ่งได้ครั้งเดียว
+CREATE UNIQUE INDEX IF NOT EXISTS idx_ratings_food_recipe_user ON ratings (food_recipe_id, user_id)
+WHERE
+    deleted_at IS NULL;
+
+-- ค่าสรุปคะแนนของสูตรตาม ratings ด้านบน
+UPDATE food_recipes
+SET
+    rating_count = 2,
+    rating_sum = 8,
+    rating_star3 = 1,
+    rating_star5 = 1
+WHERE
+    id = 1;
+
+-- tags table
+CREATE TABLE
+    IF NOT EXISTS tags (
+        id SERIAL PRIMARY KEY,
+        name VARCHAR(100) NOT NULL,
+        slug VARCHAR(100) NOT NULL,
+        category VARCHAR(20) NOT NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+INSERT INTO
+    tags (name, slug, category, created_at, updated_at)
+VALUES
+    (
+        'Thai',
+        'thai',
+        'cuisine',
+        CURRENT_TIMESTAMP,
+        CURRENT_TIMESTAMP
+    );
+
+-- food_recipe_tags table
+CREATE TABLE
+    IF NOT EXISTS food_recipe_tags (
+        food_recipe_id INT NOT NULL REFERENCES food_recipes,
+        tag_id INT NOT NULL REFERENCES tags,
+        PRIMARY KEY (food_recipe_id, tag_id)
+    );
+
+INSERT INTO
+    food_recipe_tags (food_recipe_id, tag_id)
+VALUES
+    (1, 1);
+
+
+-- full-text search
+-- ภาษาไทยไม่มีการเว้นวรรคระหว่างคำ และ PostgreSQL ไม่มี dictionary ภาษาไทย
+-- จึงตัดข้อความไทยเป็น bigram (ทีละ 2 ตัวอักษรแบบเหลื่อมกัน) ส่วนภาษาอังกฤษ/ตัวเลขตัดตามคำปกติ
+-- ทั้งตอนสร้าง search_vector และตอนค้นหาใช้ฟังก์ชันเดียวกัน ผลจึงสอดคล้องกัน
+CREATE OR REPLACE FUNCTION search_tokens(value TEXT) RETURNS TEXT
+LANGUAGE plpgsql IMMUTABLE AS $$
+DECLARE
+    word TEXT;
+    tokens TEXT[] := '{}';
+    i INT;
+BEGIN
+    FOR word IN
+        SELECT m[1] FROM regexp_matches(lower(COALESCE(value, '')), '([a-z0-9]+|[฀-๿]+)', 'g') AS m
+    LOOP
+        IF word ~ '^[a-z0-9]+$' OR char_length(word) <= 2 THEN
+            tokens := tokens || word;
+        ELSE
+            FOR i IN 1 .. char_length(word) - 1 LOOP
+                tokens := tokens || substr(word, i, 2);
+            END LOOP;
+        END IF;
+    END LOOP;
+
+    RETURN array_to_string(tokens, ' ');
+END;
+$$;
+
+-- คำค้นทุกคำต้องพบ (AND) และจับคู่แบบ prefix เพื่อให้พิมพ์ไม่ครบคำก็ยังเจอ
+CREATE OR REPLACE FUNCTION search_query(value TEXT) RETURNS tsquery
+LANGUAGE sql IMMUTABLE AS $$
+    SELECT to_tsquery('simple', COALESCE(string_agg(quote_literal(token) || ':*', ' & '), ''))
+    FROM unnest(string_to_array(search_tokens(value), ' ')) AS token
+    WHERE token <> ''
+$$;
+
+-- น้ำหนัก: ชื่อ > คำอธิบาย > วัตถุดิบ > วิธีทำ
+CREATE OR REPLACE FUNCTION food_recipe_search_vector(name TEXT, description TEXT, ingredient TEXT, instruction TEXT) RETURNS tsvector
+LANGUAGE sql IMMUTABLE AS $$
+    SELECT setweight(to_tsvector('simple', search_tokens(name)), 'A') ||
+        setweight(to_tsvector('simple', search_tokens(description)), 'B') ||
+        setweight(to_tsvector('simple', search_tokens(ingredient)), 'C') ||
+        setweight(to_tsvector('simple', search_tokens(instruction)), 'D')
+$$;
+
+ALTER TABLE food_recipes ADD COLUMN IF NOT EXISTS search_vector tsvector;
+
+UPDATE food_recipes
+SET search_vector = food_recipe_search_vector(name, description, ingredient, instruction);
+
+CREATE INDEX IF NOT EXISTS idx_food_recipes_search_vector ON food_recipes USING GIN (search_vector);
+
+CREATE OR REPLACE FUNCTION food_recipes_search_vector_update() RETURNS TRIGGER
+LANGUAGE plpgsql AS $$
+BEGIN
+    NEW.search_vector := food_recipe_search_vector(NEW.name, NEW.description, NEW.ingredient, NEW.instruction);
+    RETURN NEW;
+END;
+$$;
+
+DROP TRIGGER IF EXISTS food_recipes_search_vector_trigger ON food_recipes;
+
+CREATE TRIGGER food_recipes_search_vector_trigger
+BEFORE INSERT OR UPDATE OF name, description, ingredient, instruction ON food_recipes
+FOR EACH ROW EXECUTE FUNCTION food_recipes_search_vector_update();
+
+-- autocomplete
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- review_votes table
+CREATE TABLE
+    IF NOT EXISTS review_votes (
+        rating_id INT NOT NULL REFERENCES ratings ON DELETE CASCADE,
+        user_id VARCHAR(100) NOT NULL REFERENCES users,
+        helpful BOOLEAN NOT NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        PRIMARY KEY (rating_id, user_id)
+    );
+
+-- comments table
+CREATE TABLE
+    IF NOT EXISTS comments (
+        id SERIAL PRIMARY KEY,
+        food_recipe_id INT NOT NULL REFERENCES food_recipes,
+        user_id VARCHAR(100) NOT NULL REFERENCES users,
+        parent_id INT NULL REFERENCES comments,
+        body TEXT NOT NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+-- cook_logs table
+CREATE TABLE
+    IF NOT EXISTS cook_logs (
+        id SERIAL PRIMARY KEY,
+        food_recipe_id INT NOT NULL REFERENCES food_recipes,
+        user_id VARCHAR(100) NOT NULL REFERENCES users,
+        cooked_on DATE NOT NULL,
+        photo_urls JSONB NOT NULL DEFAULT '[]',
+        notes TEXT NOT NULL DEFAULT '',
+        modifications TEXT NOT NULL DEFAULT '',
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+-- favorites table
+CREATE TABLE
+    IF NOT EXISTS favorites (
+        id SERIAL PRIMARY KEY,
+        food_recipe_id INT NOT NULL REFERENCES food_recipes,
+        user_id VARCHAR(255) NOT NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+-- collections table
+CREATE TABLE
+    IF NOT EXISTS collections (
+        id SERIAL PRIMARY KEY,
+        user_id VARCHAR(100) NOT NULL REFERENCES users,
+        name VARCHAR(100) NOT NULL,
+        position INT NOT NULL DEFAULT 0,
+        is_public BOOLEAN NOT NULL DEFAULT FALSE,
+        is_default BOOLEAN NOT NULL DEFAULT FALSE,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_collections_user_default ON collections (user_id)
+WHERE
+    is_default
+    AND deleted_at IS NULL;
+
+-- collection_items table
+CREATE TABLE
+    IF NOT EXISTS collection_items (
+        collection_id INT NOT NULL REFERENCES collections ON DELETE CASCADE,
+        food_recipe_id INT NOT NULL REFERENCES food_recipes,
+        created_at TIMESTAMP NOT NULL,
+        PRIMARY KEY (collection_id, food_recipe_id)
+    );
+
+-- meal_plan_entries table
+CREATE TABLE
+    IF NOT EXISTS meal_plan_entries (
+        id SERIAL PRIMARY KEY,
+        user_id VARCHAR(100) NOT NULL REFERENCES users,
+        date DATE NOT NULL,
+        slot VARCHAR(20) NOT NULL CHECK (slot IN ('breakfast', 'lunch', 'dinner', 'snack')),
+        food_recipe_id INT NOT NULL REFERENCES food_recipes,
+        servings INT NOT NULL DEFAULT 0,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+-- shopping_lists table
+CREATE TABLE
+    IF NOT EXISTS shopping_lists (
+        id SERIAL PRIMARY KEY,
+        user_id VARCHAR(100) NOT NULL REFERENCES users,
+        name VARCHAR(100) NOT NULL,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+-- shopping_list_items table
+CREATE TABLE
+    IF NOT EXISTS shopping_list_items (
+        id SERIAL PRIMARY KEY,
+        shopping_list_id INT NOT NULL REFERENCES shopping_lists ON DELETE CASCADE,
+        position INT NOT NULL DEFAULT 0,
+        name VARCHAR(255) NOT NULL,
+        quantity NUMERIC(10, 3) NULL,
+        unit VARCHAR(50) NOT NULL DEFAULT '',
+        category VARCHAR(50) NOT NULL DEFAULT 'other',
+        checked BOOLEAN NOT NULL DEFAULT FALSE,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+-- pantry_items table
+CREATE TABLE
+    IF NOT EXISTS pantry_items (
+        id SERIAL PRIMARY KEY,
+        user_id VARCHAR(100) NOT NULL REFERENCES users,
+        name VARCHAR(100) NOT NULL,
+        ingredient_id INT NULL REFERENCES ingredients,
+        created_at TIMESTAMP NOT NULL,
+        updated_at TIMESTAMP NOT NULL,
+        deleted_at TIMESTAMP
+    );
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_pantry_items_user_name ON pantry_items (user_id, LOWER(name))
+WHERE
+    deleted_at IS NULL;
+
+-- unlinked_ingredients table
+CREATE TABLE
+    IF NOT EXISTS unlinked_ingredients (
+        id SERIAL PRIMARY KEY,
+        name VARCHAR(255) NOT NULL UNIQUE,
+        occurrences INT NOT NULL DEFAULT 1,
+        created_at TIMESTAMP NOT NULL,
+        last_seen_at TIMESTAMP NOT NULL
+    );